@@ -0,0 +1,100 @@
+// Package credentials stores the credentials of Stardog servers so command line tools built on go-stardog
+// don't need to prompt for a password every time they run.
+//
+// Credentials are stored in the operating system's keychain when one is available, using the macOS
+// security command or the secret-tool command of the freedesktop.org Secret Service on Linux, and
+// otherwise in a file encrypted with a passphrase. A typical tool looks up the credentials of its
+// endpoint, prompting for them only the first time:
+//
+//	store, err := credentials.Open(passphrase)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	creds, err := store.Get(endpoint)
+//	if errors.Is(err, credentials.ErrNotFound) {
+//		creds = promptForCredentials(endpoint)
+//		err = store.Set(creds)
+//	}
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	client, err := creds.NewClient()
+package credentials
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/noahgorstein/go-stardog/stardog"
+)
+
+// ErrNotFound is returned by [Store.Get] and [Store.Delete] when no credentials are stored for an endpoint.
+var ErrNotFound = errors.New("credentials: not found")
+
+// Credentials are the credentials of a Stardog server.
+type Credentials struct {
+	// URL of the server
+	Endpoint string `json:"endpoint"`
+	// Username and password for HTTP Basic Authentication
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	// A token for Bearer Authentication, used instead of Username and Password if set
+	Token string `json:"token,omitempty"`
+}
+
+// Transport returns an http.RoundTripper that authenticates requests with the credentials: Bearer
+// Authentication with the Token if it's set, and otherwise Basic Authentication with the Username
+// and Password. Requests are made with base, or http.DefaultTransport if base is nil.
+func (c *Credentials) Transport(base http.RoundTripper) http.RoundTripper {
+	if c.Token != "" {
+		return &stardog.BearerAuthTransport{BearerToken: c.Token, Transport: base}
+	}
+	return &stardog.BasicAuthTransport{Username: c.Username, Password: c.Password, Transport: base}
+}
+
+// NewClient returns a client for the Endpoint authenticated with the credentials.
+func (c *Credentials) NewClient() (*stardog.Client, error) {
+	return stardog.NewClient(c.Endpoint, &http.Client{Transport: c.Transport(nil)})
+}
+
+// Store stores credentials by endpoint.
+type Store interface {
+	// Get returns the credentials stored for the endpoint, or ErrNotFound.
+	Get(endpoint string) (*Credentials, error)
+	// Set stores the credentials for their Endpoint, replacing any already stored.
+	Set(creds *Credentials) error
+	// Delete removes the credentials stored for the endpoint, or returns ErrNotFound.
+	Delete(endpoint string) error
+}
+
+// Open returns the operating system's keychain if one is available, and otherwise a [FileStore] in
+// the user's configuration directory encrypted with the passphrase. The keychain isn't available if its
+// command isn't installed or doesn't work, e.g. on a Linux server without a running Secret Service.
+func Open(passphrase string) (Store, error) {
+	if keychain := NewKeychain(); keychain.Available() {
+		return keychain, nil
+	}
+	path, err := DefaultFilePath()
+	if err != nil {
+		return nil, err
+	}
+	return &FileStore{Path: path, Passphrase: passphrase}, nil
+}
+
+// DefaultFilePath returns the path of the encrypted credentials file used by [Open], in the user's
+// configuration directory.
+func DefaultFilePath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "go-stardog", "credentials.enc"), nil
+}
+
+// normalizeEndpoint makes endpoints that differ only by a trailing slash the same key.
+func normalizeEndpoint(endpoint string) string {
+	return strings.TrimRight(endpoint, "/")
+}
@@ -0,0 +1,59 @@
+package credentials
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/noahgorstein/go-stardog/stardog"
+)
+
+func TestCredentials_Transport(t *testing.T) {
+	basic := (&Credentials{Username: "admin", Password: "secret"}).Transport(nil)
+	if got, ok := basic.(*stardog.BasicAuthTransport); !ok || got.Username != "admin" || got.Password != "secret" {
+		t.Errorf("Transport = %#v, want BasicAuthTransport for admin/secret", basic)
+	}
+	bearer := (&Credentials{Username: "admin", Password: "secret", Token: "abc"}).Transport(nil)
+	if got, ok := bearer.(*stardog.BearerAuthTransport); !ok || got.BearerToken != "abc" {
+		t.Errorf("Transport = %#v, want BearerAuthTransport with token abc", bearer)
+	}
+}
+
+func TestCredentials_NewClient(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if username, password, _ := r.BasicAuth(); username != "admin" || password != "secret" {
+			t.Errorf("basic auth = %q/%q, want admin/secret", username, password)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	creds := &Credentials{Endpoint: ts.URL, Username: "admin", Password: "secret"}
+	client, err := creds.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	if _, _, err := client.ServerAdmin.IsAlive(context.Background()); err != nil {
+		t.Errorf("IsAlive returned error: %v", err)
+	}
+}
+
+func TestOpen_fileFallback(t *testing.T) {
+	t.Setenv("PATH", "")
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	store, err := Open("passphrase")
+	if err != nil {
+		t.Fatalf("Open returned error: %v", err)
+	}
+	file, ok := store.(*FileStore)
+	if !ok {
+		t.Fatalf("Open = %T, want *FileStore when no keychain is installed", store)
+	}
+	want, _ := DefaultFilePath()
+	if file.Path != want || file.Passphrase != "passphrase" {
+		t.Errorf("Open = %+v, want path %s", file, want)
+	}
+}
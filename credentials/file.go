@@ -0,0 +1,206 @@
+package credentials
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	// keyIterations is the number of PBKDF2-HMAC-SHA256 iterations used to derive the file's key from the passphrase
+	keyIterations = 600000
+	// maxKeyIterations bounds the iterations read from a file, so a corrupted or malicious file can't make
+	// deriving its key take hours
+	maxKeyIterations = 10 * keyIterations
+	saltSize         = 16
+	keySize          = 32
+)
+
+// FileStore is a [Store] that keeps credentials in a file encrypted with AES-256-GCM, using a key derived
+// from Passphrase with PBKDF2. The file is only readable by its owner.
+type FileStore struct {
+	// Path of the file, which is created when credentials are first stored
+	Path string
+	// The passphrase the file is encrypted with. It must not be empty.
+	Passphrase string
+
+	mu sync.Mutex
+}
+
+// encryptedFile is the format of a FileStore's file.
+type encryptedFile struct {
+	Iterations int    `json:"iterations"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// Get returns the credentials stored for the endpoint, or ErrNotFound.
+func (f *FileStore) Get(endpoint string) (*Credentials, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	all, err := f.load()
+	if err != nil {
+		return nil, err
+	}
+	creds, ok := all[normalizeEndpoint(endpoint)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return creds, nil
+}
+
+// Set stores the credentials for their Endpoint, replacing any already stored.
+func (f *FileStore) Set(creds *Credentials) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	all, err := f.load()
+	if err != nil {
+		return err
+	}
+	stored := *creds
+	stored.Endpoint = normalizeEndpoint(stored.Endpoint)
+	all[stored.Endpoint] = &stored
+	return f.save(all)
+}
+
+// Delete removes the credentials stored for the endpoint, or returns ErrNotFound.
+func (f *FileStore) Delete(endpoint string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	all, err := f.load()
+	if err != nil {
+		return err
+	}
+	endpoint = normalizeEndpoint(endpoint)
+	if _, ok := all[endpoint]; !ok {
+		return ErrNotFound
+	}
+	delete(all, endpoint)
+	return f.save(all)
+}
+
+// load decrypts the file, returning no credentials if it doesn't exist.
+func (f *FileStore) load() (map[string]*Credentials, error) {
+	if f.Passphrase == "" {
+		return nil, errors.New("credentials: the passphrase of a FileStore must not be empty")
+	}
+	all := make(map[string]*Credentials)
+	data, err := os.ReadFile(f.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return all, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var file encryptedFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("credentials: invalid file %s: %w", f.Path, err)
+	}
+	aead, err := newAEAD(f.Passphrase, file.Salt, file.Iterations)
+	if err != nil {
+		return nil, err
+	}
+	if len(file.Nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("credentials: invalid file %s: bad nonce", f.Path)
+	}
+	plaintext, err := aead.Open(nil, file.Nonce, file.Ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("credentials: unable to decrypt %s: wrong passphrase or corrupted file", f.Path)
+	}
+	if err := json.Unmarshal(plaintext, &all); err != nil {
+		return nil, fmt.Errorf("credentials: invalid file %s: %w", f.Path, err)
+	}
+	return all, nil
+}
+
+// save encrypts the credentials with a new salt and nonce and replaces the file with them.
+func (f *FileStore) save(all map[string]*Credentials) error {
+	plaintext, err := json.Marshal(all)
+	if err != nil {
+		return err
+	}
+	file := encryptedFile{Iterations: keyIterations, Salt: make([]byte, saltSize)}
+	if _, err := rand.Read(file.Salt); err != nil {
+		return err
+	}
+	aead, err := newAEAD(f.Passphrase, file.Salt, file.Iterations)
+	if err != nil {
+		return err
+	}
+	file.Nonce = make([]byte, aead.NonceSize())
+	if _, err := rand.Read(file.Nonce); err != nil {
+		return err
+	}
+	file.Ciphertext = aead.Seal(nil, file.Nonce, plaintext, nil)
+	data, err := json.Marshal(file)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(f.Path), 0o700); err != nil {
+		return err
+	}
+	// write to a temporary file and rename it, so the file is never left partially written
+	tmp, err := os.CreateTemp(filepath.Dir(f.Path), filepath.Base(f.Path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), f.Path)
+}
+
+func newAEAD(passphrase string, salt []byte, iterations int) (cipher.AEAD, error) {
+	if len(salt) != saltSize || iterations <= 0 || iterations > maxKeyIterations {
+		return nil, errors.New("credentials: invalid key parameters")
+	}
+	block, err := aes.NewCipher(pbkdf2SHA256([]byte(passphrase), salt, iterations, keySize))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// pbkdf2SHA256 derives a key from the password with PBKDF2 (RFC 8018) using HMAC-SHA256.
+func pbkdf2SHA256(password, salt []byte, iterations, keyLen int) []byte {
+	prf := hmac.New(sha256.New, password)
+	key := make([]byte, 0, keyLen)
+	u := make([]byte, sha256.Size)
+	for block := uint32(1); len(key) < keyLen; block++ {
+		prf.Reset()
+		prf.Write(salt)
+		prf.Write(binary.BigEndian.AppendUint32(nil, block))
+		u = prf.Sum(u[:0])
+		t := append([]byte(nil), u...)
+		for i := 1; i < iterations; i++ {
+			prf.Reset()
+			prf.Write(u)
+			u = prf.Sum(u[:0])
+			for j := range t {
+				t[j] ^= u[j]
+			}
+		}
+		key = append(key, t...)
+	}
+	return key[:keyLen]
+}
@@ -0,0 +1,114 @@
+package credentials
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "credentials.enc")
+	store := &FileStore{Path: path, Passphrase: "correct horse"}
+
+	if _, err := store.Get("http://localhost:5820"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get err = %v, want ErrNotFound before anything is stored", err)
+	}
+
+	creds := &Credentials{Endpoint: "http://localhost:5820/", Username: "admin", Password: "secret"}
+	if err := store.Set(creds); err != nil {
+		t.Fatalf("Set returned error: %v", err)
+	}
+	if err := store.Set(&Credentials{Endpoint: "https://other:5820", Token: "abc"}); err != nil {
+		t.Fatalf("Set returned error: %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("unable to stat credentials file: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("file permissions = %o, want 600", perm)
+	}
+	data, _ := os.ReadFile(path)
+	if strings.Contains(string(data), "secret") {
+		t.Errorf("credentials file contains the plaintext password")
+	}
+
+	// a new store reads the file written by the first
+	reopened := &FileStore{Path: path, Passphrase: "correct horse"}
+	got, err := reopened.Get("http://localhost:5820")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	want := &Credentials{Endpoint: "http://localhost:5820", Username: "admin", Password: "secret"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Get = %+v, want %+v", got, want)
+	}
+
+	if err := reopened.Delete("http://localhost:5820"); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+	if _, err := reopened.Get("http://localhost:5820"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get err = %v, want ErrNotFound after Delete", err)
+	}
+	if err := reopened.Delete("http://localhost:5820"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Delete err = %v, want ErrNotFound", err)
+	}
+	if _, err := reopened.Get("https://other:5820"); err != nil {
+		t.Errorf("Get returned error for credentials that weren't deleted: %v", err)
+	}
+}
+
+func TestFileStore_wrongPassphrase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.enc")
+	if err := (&FileStore{Path: path, Passphrase: "right"}).Set(&Credentials{Endpoint: "http://localhost:5820"}); err != nil {
+		t.Fatalf("Set returned error: %v", err)
+	}
+	_, err := (&FileStore{Path: path, Passphrase: "wrong"}).Get("http://localhost:5820")
+	if err == nil || errors.Is(err, ErrNotFound) {
+		t.Errorf("Get err = %v, want a decryption error", err)
+	}
+}
+
+func TestFileStore_emptyPassphrase(t *testing.T) {
+	store := &FileStore{Path: filepath.Join(t.TempDir(), "credentials.enc")}
+	if err := store.Set(&Credentials{Endpoint: "http://localhost:5820"}); err == nil {
+		t.Errorf("Set err = nil, want error for an empty passphrase")
+	}
+}
+
+func TestPBKDF2SHA256(t *testing.T) {
+	// test vectors from RFC 7914, section 11
+	tests := []struct {
+		password, salt string
+		iterations     int
+		want           string
+	}{
+		{"passwd", "salt", 1, "55ac046e56e3089fec1691c22544b605f94185216dde0465e68b9d57c20dacbc49ca9cccf179b645991664b39d77ef317c71b845b1e30bd509112041d3a19783"},
+		{"Password", "NaCl", 80000, "4ddcd8f60b98be21830cee5ef22701f9641a4418d04c0414aeff08876b34ab56a1d425a1225833549adb841b51c9b3176a272bdebba1d078478f62b397f33c8d"},
+	}
+	for _, tt := range tests {
+		got := hex.EncodeToString(pbkdf2SHA256([]byte(tt.password), []byte(tt.salt), tt.iterations, 64))
+		if got != tt.want {
+			t.Errorf("pbkdf2SHA256(%q, %q, %d) = %s, want %s", tt.password, tt.salt, tt.iterations, got, tt.want)
+		}
+	}
+}
+
+func TestFileStore_tooManyIterations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.enc")
+	data, _ := json.Marshal(encryptedFile{Iterations: maxKeyIterations + 1, Salt: make([]byte, saltSize)})
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	store := &FileStore{Path: path, Passphrase: "secret"}
+	if _, err := store.Get("http://localhost:5820"); err == nil {
+		t.Errorf("Get err = nil, want error for a file with too many key iterations")
+	}
+}
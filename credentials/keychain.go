@@ -0,0 +1,193 @@
+package credentials
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// keychainService is the service name credentials are stored under in the keychain.
+const keychainService = "go-stardog"
+
+// keychainProbeEndpoint is the endpoint looked up by Available to check that the keychain can be used.
+const keychainProbeEndpoint = "go-stardog-probe"
+
+// securityItemNotFound is the exit status of security when there's no matching item (errSecItemNotFound).
+const securityItemNotFound = 44
+
+// commandError is the error of a command that ran but exited with a non-zero status.
+type commandError struct {
+	name     string
+	exitCode int
+	stderr   string
+}
+
+func (e *commandError) Error() string {
+	if e.stderr != "" {
+		return fmt.Sprintf("%s: exit status %d: %s", e.name, e.exitCode, e.stderr)
+	}
+	return fmt.Sprintf("%s: exit status %d", e.name, e.exitCode)
+}
+
+// Keychain is a [Store] backed by the operating system's keychain: the login keychain on macOS, accessed
+// with the security command, or the Secret Service (e.g. GNOME Keyring or KWallet) on Linux, accessed
+// with the secret-tool command. Other operating systems have no supported keychain.
+type Keychain struct {
+	goos string
+	// run runs a command with the input on stdin, returning its stdout
+	run func(input string, name string, args ...string) (string, error)
+	// lookPath reports whether a command is installed
+	lookPath func(name string) error
+}
+
+// NewKeychain returns the keychain of the operating system. Check [Keychain.Available] before using it.
+func NewKeychain() *Keychain {
+	return &Keychain{
+		goos: runtime.GOOS,
+		run:  runCommand,
+		lookPath: func(name string) error {
+			_, err := exec.LookPath(name)
+			return err
+		},
+	}
+}
+
+// Available reports whether the operating system has a supported keychain that can be used: its command is
+// installed and looking up an item doesn't fail, e.g. because the Secret Service isn't running.
+func (k *Keychain) Available() bool {
+	command := k.command()
+	if command == "" || k.lookPath(command) != nil {
+		return false
+	}
+	_, err := k.lookup(keychainProbeEndpoint)
+	return err == nil || errors.Is(err, ErrNotFound)
+}
+
+func (k *Keychain) command() string {
+	switch k.goos {
+	case "darwin":
+		return "security"
+	case "linux":
+		return "secret-tool"
+	default:
+		return ""
+	}
+}
+
+// Get returns the credentials stored for the endpoint, or ErrNotFound if there are none. Other failures, such
+// as a locked keychain or one the user denies access to, are returned as they are.
+func (k *Keychain) Get(endpoint string) (*Credentials, error) {
+	endpoint = normalizeEndpoint(endpoint)
+	out, err := k.lookup(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	var creds Credentials
+	if err := json.Unmarshal([]byte(strings.TrimSpace(out)), &creds); err != nil {
+		return nil, fmt.Errorf("credentials: invalid keychain item for %s: %w", endpoint, err)
+	}
+	return &creds, nil
+}
+
+// lookup returns the secret stored for the endpoint, or ErrNotFound.
+func (k *Keychain) lookup(endpoint string) (string, error) {
+	var out string
+	var err error
+	switch k.command() {
+	case "security":
+		out, err = k.run("", "security", "find-generic-password", "-s", keychainService, "-a", endpoint, "-w")
+	case "secret-tool":
+		out, err = k.run("", "secret-tool", "lookup", "service", keychainService, "endpoint", endpoint)
+	default:
+		return "", k.unavailable()
+	}
+	if k.isNotFound(err) || (err == nil && out == "") {
+		return "", ErrNotFound
+	}
+	return out, err
+}
+
+// isNotFound reports whether err is the error of the keychain command when there's no matching item.
+// security exits with errSecItemNotFound, and secret-tool exits with status 1 without an error message.
+func (k *Keychain) isNotFound(err error) bool {
+	var cmdErr *commandError
+	if !errors.As(err, &cmdErr) {
+		return false
+	}
+	switch k.command() {
+	case "security":
+		return cmdErr.exitCode == securityItemNotFound
+	case "secret-tool":
+		return cmdErr.exitCode == 1 && cmdErr.stderr == ""
+	}
+	return false
+}
+
+// Set stores the credentials for their Endpoint, replacing any already stored. The secret is passed to the
+// keychain command on stdin rather than as an argument, so other users can't see it in the process list.
+func (k *Keychain) Set(creds *Credentials) error {
+	stored := *creds
+	stored.Endpoint = normalizeEndpoint(stored.Endpoint)
+	secret, err := json.Marshal(stored)
+	if err != nil {
+		return err
+	}
+	label := "Stardog credentials for " + stored.Endpoint
+	switch k.command() {
+	case "security":
+		if strings.ContainsAny(stored.Endpoint, "\"\\\r\n") {
+			return fmt.Errorf("credentials: unable to store credentials in the keychain for endpoint %q", stored.Endpoint)
+		}
+		// security only prompts for a secret on a terminal, so the command is run in interactive mode, which
+		// reads it from stdin. -U updates an existing item, and -X takes the secret hex-encoded.
+		command := fmt.Sprintf("add-generic-password -U -s %s -a \"%s\" -l \"%s\" -X %s\n",
+			keychainService, stored.Endpoint, label, hex.EncodeToString(secret))
+		_, err = k.run(command, "security", "-i")
+	case "secret-tool":
+		_, err = k.run(string(secret), "secret-tool", "store", "--label="+label, "service", keychainService, "endpoint", stored.Endpoint)
+	default:
+		return k.unavailable()
+	}
+	return err
+}
+
+// Delete removes the credentials stored for the endpoint, or returns ErrNotFound.
+func (k *Keychain) Delete(endpoint string) error {
+	if _, err := k.Get(endpoint); err != nil {
+		return err
+	}
+	endpoint = normalizeEndpoint(endpoint)
+	var err error
+	switch k.command() {
+	case "security":
+		_, err = k.run("", "security", "delete-generic-password", "-s", keychainService, "-a", endpoint)
+	case "secret-tool":
+		_, err = k.run("", "secret-tool", "clear", "service", keychainService, "endpoint", endpoint)
+	}
+	return err
+}
+
+func (k *Keychain) unavailable() error {
+	return fmt.Errorf("credentials: no supported keychain on %s", k.goos)
+}
+
+func runCommand(input string, name string, args ...string) (string, error) {
+	cmd := exec.Command(name, args...)
+	cmd.Stdin = strings.NewReader(input)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return "", &commandError{name: name, exitCode: exitErr.ExitCode(), stderr: strings.TrimSpace(stderr.String())}
+		}
+		return "", fmt.Errorf("%s: %w", name, err)
+	}
+	return stdout.String(), nil
+}
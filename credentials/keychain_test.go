@@ -0,0 +1,185 @@
+package credentials
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// fakeKeychain returns a keychain for goos that runs commands against an in-memory item store,
+// recording the commands.
+func fakeKeychain(goos string, commands *[]string) *Keychain {
+	items := make(map[string]string)
+	return &Keychain{
+		goos:     goos,
+		lookPath: func(string) error { return nil },
+		run: func(input string, name string, args ...string) (string, error) {
+			if name == "security" && args[0] == "-i" {
+				// security -i reads the command line from stdin
+				args = splitSecurityCommand(input)
+			}
+			*commands = append(*commands, name+" "+strings.Join(args, " "))
+			account := args[len(args)-1]
+			switch args[0] {
+			case "find-generic-password":
+				account = args[len(args)-2]
+				fallthrough
+			case "lookup":
+				if item, ok := items[account]; ok {
+					return item + "\n", nil
+				}
+				if goos == "linux" {
+					return "", &commandError{name: name, exitCode: 1}
+				}
+				return "", &commandError{name: name, exitCode: securityItemNotFound,
+					stderr: "security: SecKeychainSearchCopyNext: The specified item could not be found in the keychain."}
+			case "add-generic-password":
+				secret, err := hex.DecodeString(args[len(args)-1])
+				if err != nil {
+					return "", err
+				}
+				items[args[5]] = string(secret)
+			case "store":
+				items[account] = input
+			case "delete-generic-password", "clear":
+				delete(items, account)
+			}
+			return "", nil
+		},
+	}
+}
+
+// splitSecurityCommand splits a command line read by security -i into its arguments.
+func splitSecurityCommand(line string) []string {
+	var args []string
+	var arg strings.Builder
+	quoted := false
+	for _, r := range strings.TrimSpace(line) {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == ' ' && !quoted:
+			args = append(args, arg.String())
+			arg.Reset()
+		default:
+			arg.WriteRune(r)
+		}
+	}
+	return append(args, arg.String())
+}
+
+func TestKeychain(t *testing.T) {
+	for _, goos := range []string{"darwin", "linux"} {
+		t.Run(goos, func(t *testing.T) {
+			var commands []string
+			keychain := fakeKeychain(goos, &commands)
+			if !keychain.Available() {
+				t.Fatalf("Available = false, want true")
+			}
+
+			creds := &Credentials{Endpoint: "http://localhost:5820/", Username: "admin", Password: "secret"}
+			if err := keychain.Set(creds); err != nil {
+				t.Fatalf("Set returned error: %v", err)
+			}
+			got, err := keychain.Get("http://localhost:5820")
+			if err != nil {
+				t.Fatalf("Get returned error: %v", err)
+			}
+			want := &Credentials{Endpoint: "http://localhost:5820", Username: "admin", Password: "secret"}
+			if !cmp.Equal(got, want) {
+				t.Errorf("Get = %+v, want %+v", got, want)
+			}
+			if err := keychain.Delete("http://localhost:5820"); err != nil {
+				t.Fatalf("Delete returned error: %v", err)
+			}
+			if _, err := keychain.Get("http://localhost:5820"); !errors.Is(err, ErrNotFound) {
+				t.Errorf("Get err = %v, want ErrNotFound after Delete", err)
+			}
+			if err := keychain.Delete("http://localhost:5820"); !errors.Is(err, ErrNotFound) {
+				t.Errorf("Delete err = %v, want ErrNotFound", err)
+			}
+
+			wantCommand := map[string]string{"darwin": "security", "linux": "secret-tool"}[goos]
+			for _, command := range commands {
+				if !strings.HasPrefix(command, wantCommand+" ") {
+					t.Errorf("ran %q, want only %s commands", command, wantCommand)
+				}
+			}
+		})
+	}
+}
+
+func TestKeychain_unavailable(t *testing.T) {
+	var commands []string
+	keychain := fakeKeychain("windows", &commands)
+	if keychain.Available() {
+		t.Errorf("Available = true, want false on windows")
+	}
+	if err := keychain.Set(&Credentials{Endpoint: "http://localhost:5820"}); err == nil {
+		t.Errorf("Set err = nil, want error")
+	}
+	if _, err := keychain.Get("http://localhost:5820"); err == nil || errors.Is(err, ErrNotFound) {
+		t.Errorf("Get err = %v, want unavailable error", err)
+	}
+
+	keychain = fakeKeychain("linux", &commands)
+	keychain.lookPath = func(string) error { return errors.New("not installed") }
+	if keychain.Available() {
+		t.Errorf("Available = true, want false when secret-tool isn't installed")
+	}
+}
+
+func TestKeychain_Set_secretNotInArguments(t *testing.T) {
+	for _, goos := range []string{"darwin", "linux"} {
+		t.Run(goos, func(t *testing.T) {
+			var args [][]string
+			keychain := fakeKeychain(goos, new([]string))
+			run := keychain.run
+			keychain.run = func(input string, name string, a ...string) (string, error) {
+				args = append(args, a)
+				return run(input, name, a...)
+			}
+			creds := &Credentials{Endpoint: "http://localhost:5820", Username: "admin", Password: "hunter2"}
+			if err := keychain.Set(creds); err != nil {
+				t.Fatalf("Set returned error: %v", err)
+			}
+			for _, a := range args {
+				if joined := strings.Join(a, " "); strings.Contains(joined, "hunter2") {
+					t.Errorf("ran %q, want the secret not to be passed as an argument", joined)
+				}
+			}
+			if _, err := keychain.Get("http://localhost:5820"); err != nil {
+				t.Errorf("Get returned error: %v", err)
+			}
+		})
+	}
+
+	keychain := fakeKeychain("darwin", new([]string))
+	if err := keychain.Set(&Credentials{Endpoint: "http://localhost:5820\" -w x"}); err == nil {
+		t.Errorf("Set err = nil, want error for an endpoint with a quote")
+	}
+}
+
+func TestKeychain_Get_failure(t *testing.T) {
+	tests := []struct {
+		goos string
+		err  error
+	}{
+		{"darwin", &commandError{name: "security", exitCode: 51, stderr: "User interaction is not allowed."}},
+		{"linux", &commandError{name: "secret-tool", exitCode: 1, stderr: "Cannot autolaunch D-Bus without X11 $DISPLAY"}},
+		{"linux", errors.New("secret-tool: signal: killed")},
+	}
+	for _, tc := range tests {
+		keychain := fakeKeychain(tc.goos, new([]string))
+		keychain.run = func(string, string, ...string) (string, error) { return "", tc.err }
+		if _, err := keychain.Get("http://localhost:5820"); err == nil || errors.Is(err, ErrNotFound) {
+			t.Errorf("%s: Get err = %v, want %v", tc.goos, err, tc.err)
+		}
+		if keychain.Available() {
+			t.Errorf("%s: Available = true, want false when the keychain fails with %v", tc.goos, tc.err)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+// Command gensupport generates SUPPORT.md, the table of the minimum Stardog version of every client
+// method, from stardog.Capabilities. It's run by go generate in the stardog package:
+//
+//	go generate ./stardog
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/noahgorstein/go-stardog/stardog"
+)
+
+func main() {
+	output := flag.String("o", "SUPPORT.md", "file to write the report to")
+	flag.Parse()
+	if err := os.WriteFile(*output, report(), 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// report returns the Markdown report of the capabilities.
+func report() []byte {
+	var buf bytes.Buffer
+	buf.WriteString("<!-- Code generated by internal/gensupport. DO NOT EDIT. -->\n\n")
+	buf.WriteString("# Stardog Version Support\n\n")
+	fmt.Fprintf(&buf, "go-stardog supports Stardog %s and later. The table lists the oldest Stardog version each\n", stardog.MinimumServerVersion)
+	buf.WriteString("method works with. Use `Client.RequireCapability` to get an `UnsupportedVersionError`, rather than\n")
+	buf.WriteString("a 404, when calling a method the server is too old for.\n\n")
+	fmt.Fprintf(&buf, "Methods listed as %s wrap endpoints whose minimum Stardog version hasn't been established yet,\n", stardog.VersionUnknown)
+	buf.WriteString("and aren't checked by `Client.RequireCapability`. Methods listed as ")
+	fmt.Fprintf(&buf, "%s don't send requests to the server.\n\n", stardog.VersionClientSide)
+	buf.WriteString("The RDF-star formats (`RDFFormatTurtleStar` and `RDFFormatTrigStar`) need a database with edge properties\n")
+	fmt.Fprintf(&buf, "enabled. Their minimum Stardog version is %s.\n\n", stardog.VersionUnknown)
+	buf.WriteString("Regenerate this file with `go generate ./stardog` after changing the capability table.\n\n")
+	buf.WriteString("| Method | Minimum Stardog version |\n")
+	buf.WriteString("| --- | --- |\n")
+	for _, capability := range stardog.Capabilities() {
+		fmt.Fprintf(&buf, "| `%s` | %s |\n", capability.Method, capability.MinVersion)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,17 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestReport_upToDate(t *testing.T) {
+	got, err := os.ReadFile("../../SUPPORT.md")
+	if err != nil {
+		t.Fatalf("reading SUPPORT.md: %v", err)
+	}
+	if !bytes.Equal(got, report()) {
+		t.Errorf("SUPPORT.md is out of date; run go generate ./stardog")
+	}
+}
@@ -0,0 +1,232 @@
+package stardog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// CacheService handles communication with the [cache] related methods of the Stardog API. Caches keep
+// a copy of a virtual graph or of the results of a query on a cache target, a Stardog server dedicated
+// to serving cached data.
+//
+// [cache]: https://docs.stardog.com/cluster/distributed-cache
+type CacheService service
+
+// CacheTarget is a Stardog server that caches are stored on.
+type CacheTarget struct {
+	// Name of the cache target
+	Name string `json:"name"`
+	// Hostname of the server
+	Hostname string `json:"hostname"`
+	// Port of the server
+	Port int `json:"port"`
+	// User the server is accessed as
+	Username string `json:"username"`
+}
+
+// NewCacheTarget specifies the cache target added by [CacheService.AddTarget].
+type NewCacheTarget struct {
+	// Name of the cache target
+	Name string `json:"name"`
+	// Hostname of the server
+	Hostname string `json:"hostname"`
+	// Port of the server
+	Port int `json:"port"`
+	// User to access the server as
+	Username string `json:"username"`
+	// Password of the user
+	Password string `json:"password"`
+	// Use the cache database that already exists on the server rather than creating it
+	UseExistingDatabase bool `json:"use_existing_db,omitempty"`
+}
+
+// RemoveCacheTargetOptions specifies the optional parameters to the [CacheService.RemoveTarget] method.
+type RemoveCacheTargetOptions struct {
+	// Leave the caches on the target's server rather than dropping them
+	Orphan bool `url:"orphan,omitempty"`
+}
+
+// Cache is a cached virtual graph or query.
+type Cache struct {
+	// Name of the cache
+	Name string `json:"name"`
+	// Name of the cache target the cache is stored on
+	Target string `json:"target"`
+	// Database the cache is for
+	Database string `json:"database"`
+	// IRI of the cached graph, such as a virtual graph (virtual://name). Empty for a query cache.
+	Graph string `json:"graph,omitempty"`
+	// The cached query. Empty for a graph cache.
+	Query string `json:"query,omitempty"`
+}
+
+// NewCache specifies the cache created by [CacheService.Create]. Exactly one of Graph and Query must be set.
+type NewCache struct {
+	// Name of the cache
+	Name string `json:"name"`
+	// Name of the cache target to store the cache on
+	Target string `json:"target"`
+	// Database the cache is for
+	Database string `json:"database"`
+	// IRI of the graph to cache, such as a virtual graph (virtual://name)
+	Graph string `json:"graph,omitempty"`
+	// Query whose results to cache
+	Query string `json:"query,omitempty"`
+	// SPARQL update run to refresh the cache, instead of reloading it entirely
+	RefreshScript string `json:"refresh_script,omitempty"`
+	// Cron expression of when to refresh the cache automatically (e.g. "0 0 * * * ?")
+	CronSchedule string `json:"cron_schedule,omitempty"`
+	// Register a cache that already exists on the target rather than loading it
+	RegisterOnly bool `json:"register_only,omitempty"`
+}
+
+// CacheStatus is the status of a cache, as returned by [CacheService.Status].
+type CacheStatus struct {
+	// Name of the cache
+	Name string `json:"name"`
+	// Status of the cache as reported by the server (e.g. "Ready" or "Refreshing")
+	Status string `json:"status"`
+}
+
+// request for Refresh
+type refreshCacheRequest struct {
+	Name string `json:"name"`
+}
+
+// request for Status
+type cacheStatusRequest struct {
+	Names []string `json:"names,omitempty"`
+}
+
+// ListTargets returns all cache targets.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Cache/operation/listCacheTargets
+func (s *CacheService) ListTargets(ctx context.Context) ([]CacheTarget, *Response, error) {
+	headerOpts := &requestHeaderOptions{
+		Accept: mediaTypeApplicationJSON,
+	}
+	req, err := s.client.NewRequest(http.MethodGet, "admin/cache/target", headerOpts, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var targets []CacheTarget
+	resp, err := s.client.Do(ctx, req, &targets)
+	if err != nil {
+		return nil, resp, err
+	}
+	return targets, resp, nil
+}
+
+// AddTarget adds a cache target.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Cache/operation/addCacheTarget
+func (s *CacheService) AddTarget(ctx context.Context, target *NewCacheTarget) (*Response, error) {
+	if err := target.validate(); err != nil {
+		return nil, err
+	}
+	headerOpts := &requestHeaderOptions{
+		ContentType: mediaTypeApplicationJSON,
+	}
+	req, err := s.client.NewRequest(http.MethodPost, "admin/cache/target", headerOpts, target)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// RemoveTarget removes a cache target, dropping its caches unless opts.Orphan is set.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Cache/operation/removeCacheTarget
+func (s *CacheService) RemoveTarget(ctx context.Context, target string, opts *RemoveCacheTargetOptions) (*Response, error) {
+	u, err := addOptions(fmt.Sprintf("admin/cache/target/%s", target), opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// List returns all caches.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Cache/operation/listCaches
+func (s *CacheService) List(ctx context.Context) ([]Cache, *Response, error) {
+	headerOpts := &requestHeaderOptions{
+		Accept: mediaTypeApplicationJSON,
+	}
+	req, err := s.client.NewRequest(http.MethodGet, "admin/cache", headerOpts, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var caches []Cache
+	resp, err := s.client.Do(ctx, req, &caches)
+	if err != nil {
+		return nil, resp, err
+	}
+	return caches, resp, nil
+}
+
+// Create creates a cache of a graph or of the results of a query, and loads it unless cache.RegisterOnly is set.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Cache/operation/createCache
+func (s *CacheService) Create(ctx context.Context, cache *NewCache) (*Response, error) {
+	if err := cache.validate(); err != nil {
+		return nil, err
+	}
+	headerOpts := &requestHeaderOptions{
+		ContentType: mediaTypeApplicationJSON,
+	}
+	req, err := s.client.NewRequest(http.MethodPost, "admin/cache", headerOpts, cache)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// Drop drops a cache.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Cache/operation/dropCache
+func (s *CacheService) Drop(ctx context.Context, cache string) (*Response, error) {
+	req, err := s.client.NewRequest(http.MethodDelete, fmt.Sprintf("admin/cache/%s", cache), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// Refresh refreshes a cache, with its refresh script if it has one and otherwise by reloading it.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Cache/operation/refreshCache
+func (s *CacheService) Refresh(ctx context.Context, cache string) (*Response, error) {
+	headerOpts := &requestHeaderOptions{
+		ContentType: mediaTypeApplicationJSON,
+	}
+	req, err := s.client.NewRequest(http.MethodPost, "admin/cache/refresh", headerOpts, &refreshCacheRequest{Name: cache})
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// Status returns the status of the named caches, or of all caches if no names are provided.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Cache/operation/cacheStatus
+func (s *CacheService) Status(ctx context.Context, caches ...string) ([]CacheStatus, *Response, error) {
+	headerOpts := &requestHeaderOptions{
+		ContentType: mediaTypeApplicationJSON,
+		Accept:      mediaTypeApplicationJSON,
+	}
+	req, err := s.client.NewRequest(http.MethodPost, "admin/cache/status", headerOpts, &cacheStatusRequest{Names: caches})
+	if err != nil {
+		return nil, nil, err
+	}
+	var statuses []CacheStatus
+	resp, err := s.client.Do(ctx, req, &statuses)
+	if err != nil {
+		return nil, resp, err
+	}
+	return statuses, resp, nil
+}
@@ -0,0 +1,232 @@
+package stardog
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCacheService_ListTargets(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/cache/target", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeApplicationJSON)
+		w.Write([]byte(`[{"name": "cache1", "hostname": "cache.example.com", "port": 5820, "username": "admin"}]`))
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Cache.ListTargets(ctx)
+	if err != nil {
+		t.Errorf("Cache.ListTargets returned error: %v", err)
+	}
+	want := []CacheTarget{{Name: "cache1", Hostname: "cache.example.com", Port: 5820, Username: "admin"}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Cache.ListTargets = %+v, want %+v", got, want)
+	}
+
+	const methodName = "ListTargets"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Cache.ListTargets(nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCacheService_AddTarget(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/cache/target", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", mediaTypeApplicationJSON)
+		testBody(t, r, `{"name":"cache1","hostname":"cache.example.com","port":5820,"username":"admin","password":"admin","use_existing_db":true}`+"\n")
+	})
+
+	ctx := context.Background()
+	target := &NewCacheTarget{Name: "cache1", Hostname: "cache.example.com", Port: 5820, Username: "admin", Password: "admin", UseExistingDatabase: true}
+	if _, err := client.Cache.AddTarget(ctx, target); err != nil {
+		t.Errorf("Cache.AddTarget returned error: %v", err)
+	}
+
+	var validationErr *ValidationError
+	if _, err := client.Cache.AddTarget(ctx, &NewCacheTarget{Name: "cache1", Hostname: "cache.example.com"}); !errors.As(err, &validationErr) {
+		t.Errorf("Cache.AddTarget error = %v, want *ValidationError for a missing port", err)
+	}
+
+	const methodName = "AddTarget"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Cache.AddTarget(nil, target)
+	})
+}
+
+func TestCacheService_RemoveTarget(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/cache/target/cache1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		if got, want := r.URL.Query().Get("orphan"), "true"; got != want {
+			t.Errorf("orphan parameter = %q, want %q", got, want)
+		}
+	})
+
+	ctx := context.Background()
+	if _, err := client.Cache.RemoveTarget(ctx, "cache1", &RemoveCacheTargetOptions{Orphan: true}); err != nil {
+		t.Errorf("Cache.RemoveTarget returned error: %v", err)
+	}
+
+	const methodName = "RemoveTarget"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Cache.RemoveTarget(nil, "cache1", nil)
+	})
+}
+
+func TestCacheService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/cache", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`[{"name": "vgCache", "target": "cache1", "database": "db1", "graph": "virtual://vg"}]`))
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Cache.List(ctx)
+	if err != nil {
+		t.Errorf("Cache.List returned error: %v", err)
+	}
+	want := []Cache{{Name: "vgCache", Target: "cache1", Database: "db1", Graph: "virtual://vg"}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Cache.List = %+v, want %+v", got, want)
+	}
+
+	const methodName = "List"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Cache.List(nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCacheService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/cache", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", mediaTypeApplicationJSON)
+		testBody(t, r, `{"name":"vgCache","target":"cache1","database":"db1","graph":"virtual://vg","cron_schedule":"0 0 * * * ?"}`+"\n")
+	})
+
+	ctx := context.Background()
+	cache := &NewCache{Name: "vgCache", Target: "cache1", Database: "db1", Graph: "virtual://vg", CronSchedule: "0 0 * * * ?"}
+	if _, err := client.Cache.Create(ctx, cache); err != nil {
+		t.Errorf("Cache.Create returned error: %v", err)
+	}
+
+	const methodName = "Create"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Cache.Create(nil, cache)
+	})
+}
+
+func TestCacheService_Create_invalid(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	tests := map[string]*NewCache{
+		"nil":            nil,
+		"no name":        {Target: "cache1", Database: "db1", Graph: "virtual://vg"},
+		"no target":      {Name: "c", Database: "db1", Graph: "virtual://vg"},
+		"no database":    {Name: "c", Target: "cache1", Graph: "virtual://vg"},
+		"graph or query": {Name: "c", Target: "cache1", Database: "db1"},
+		"graph and query": {
+			Name: "c", Target: "cache1", Database: "db1", Graph: "virtual://vg", Query: "SELECT * { ?s ?p ?o }",
+		},
+	}
+	for name, cache := range tests {
+		t.Run(name, func(t *testing.T) {
+			var validationErr *ValidationError
+			if _, err := client.Cache.Create(context.Background(), cache); !errors.As(err, &validationErr) {
+				t.Errorf("Cache.Create error = %v, want *ValidationError", err)
+			}
+		})
+	}
+}
+
+func TestCacheService_Drop(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/cache/vgCache", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	if _, err := client.Cache.Drop(ctx, "vgCache"); err != nil {
+		t.Errorf("Cache.Drop returned error: %v", err)
+	}
+
+	const methodName = "Drop"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Cache.Drop(nil, "vgCache")
+	})
+}
+
+func TestCacheService_Refresh(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/cache/refresh", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"vgCache"}`+"\n")
+	})
+
+	ctx := context.Background()
+	if _, err := client.Cache.Refresh(ctx, "vgCache"); err != nil {
+		t.Errorf("Cache.Refresh returned error: %v", err)
+	}
+
+	const methodName = "Refresh"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Cache.Refresh(nil, "vgCache")
+	})
+}
+
+func TestCacheService_Status(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/cache/status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"names":["vgCache"]}`+"\n")
+		w.Write([]byte(`[{"name": "vgCache", "status": "Ready"}]`))
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Cache.Status(ctx, "vgCache")
+	if err != nil {
+		t.Errorf("Cache.Status returned error: %v", err)
+	}
+	if want := []CacheStatus{{Name: "vgCache", Status: "Ready"}}; !cmp.Equal(got, want) {
+		t.Errorf("Cache.Status = %+v, want %+v", got, want)
+	}
+
+	const methodName = "Status"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Cache.Status(nil, "vgCache")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
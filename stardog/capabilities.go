@@ -0,0 +1,259 @@
+package stardog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:generate go run ../internal/gensupport -o ../SUPPORT.md
+
+// MinimumServerVersion is the oldest Stardog version the client supports. Every method works with it
+// unless its [Capability] says otherwise.
+const MinimumServerVersion = "7.0.0"
+
+// The MinVersion of a [Capability] that isn't a Stardog version.
+const (
+	// VersionUnknown is the MinVersion of methods wrapping endpoints whose minimum Stardog version hasn't been
+	// established. They aren't checked by [Client.RequireCapability].
+	VersionUnknown = "unknown"
+	// VersionClientSide is the MinVersion of methods that don't send requests to the server.
+	VersionClientSide = "client-side"
+)
+
+// minimumVersions are the minimum Stardog versions of the methods that need a newer server than
+// MinimumServerVersion, or whose minimum version is VersionUnknown or VersionClientSide, keyed by
+// Capability.Method. Add an entry when wrapping an endpoint introduced after MinimumServerVersion, or one
+// whose introduction hasn't been checked against the support matrix.
+var minimumVersions = map[string]string{
+	"DataSourceService.OptionsDocumentation": VersionClientSide,
+	"DataSourceService.RefreshCounts":        "8.0.0",
+
+	"CacheService.AddTarget":                    VersionUnknown,
+	"CacheService.Create":                       VersionUnknown,
+	"CacheService.Drop":                         VersionUnknown,
+	"CacheService.List":                         VersionUnknown,
+	"CacheService.ListTargets":                  VersionUnknown,
+	"CacheService.Refresh":                      VersionUnknown,
+	"CacheService.RemoveTarget":                 VersionUnknown,
+	"CacheService.Status":                       VersionUnknown,
+	"DatabaseAdminService.Copy":                 VersionUnknown,
+	"DatabaseAdminService.Rename":               VersionUnknown,
+	"ICVService.Report":                         VersionUnknown,
+	"ReasoningService.AddSchema":                VersionUnknown,
+	"ReasoningService.ListSchemas":              VersionUnknown,
+	"ReasoningService.RemoveSchema":             VersionUnknown,
+	"SecurityService.AddSensitiveProperties":    VersionUnknown,
+	"SecurityService.GetToken":                  VersionUnknown,
+	"SecurityService.MaskingFunction":           VersionUnknown,
+	"SecurityService.PasswordPolicy":            VersionUnknown,
+	"SecurityService.RemoveSensitiveProperties": VersionUnknown,
+	"SecurityService.SensitiveProperties":       VersionUnknown,
+	"SecurityService.SetMaskingFunction":        VersionUnknown,
+	"SecurityService.SetSensitiveProperties":    VersionUnknown,
+	"ServerAdminService.DiagnosticsReport":      VersionUnknown,
+	"ServerAdminService.GetProperties":          VersionUnknown,
+	"ServerAdminService.ServerLog":              VersionUnknown,
+	"ServerAdminService.SetProperties":          VersionUnknown,
+	"ServerAdminService.SetProperty":            VersionUnknown,
+	"SimilarityService.CreateModel":             VersionUnknown,
+	"SimilarityService.DeleteModel":             VersionUnknown,
+	"SimilarityService.FindSimilar":             VersionUnknown,
+	"SimilarityService.ListModels":              VersionUnknown,
+}
+
+// Capability describes the Stardog versions a client method works with.
+type Capability struct {
+	// The method, such as "DatabaseAdminService.Create"
+	Method string
+	// The oldest Stardog version the method works with, or VersionUnknown or VersionClientSide
+	MinVersion string
+}
+
+// Capabilities returns the capability of every method of the client's services, sorted by method.
+func Capabilities() []Capability {
+	clientType := reflect.TypeOf(Client{})
+	var capabilities []Capability
+	for i := 0; i < clientType.NumField(); i++ {
+		field := clientType.Field(i)
+		if !field.IsExported() || field.Type.Kind() != reflect.Pointer || !strings.HasSuffix(field.Type.Elem().Name(), "Service") {
+			continue
+		}
+		service := field.Type.Elem().Name()
+		for j := 0; j < field.Type.NumMethod(); j++ {
+			method := service + "." + field.Type.Method(j).Name
+			capabilities = append(capabilities, Capability{Method: method, MinVersion: minimumVersion(method)})
+		}
+	}
+	sort.Slice(capabilities, func(i, j int) bool { return capabilities[i].Method < capabilities[j].Method })
+	return capabilities
+}
+
+// minimumVersion returns the minimum Stardog version of the method.
+func minimumVersion(method string) string {
+	if version, ok := minimumVersions[method]; ok {
+		return version
+	}
+	return MinimumServerVersion
+}
+
+// UnsupportedVersionError is returned by [Client.RequireCapability] when the server's version is older than
+// the minimum version of a method.
+type UnsupportedVersionError struct {
+	// The method, such as "DatabaseAdminService.Create"
+	Method string
+	// The oldest Stardog version the method works with
+	MinVersion string
+	// The version of the server
+	ServerVersion string
+}
+
+func (e *UnsupportedVersionError) Error() string {
+	return fmt.Sprintf("stardog: %s requires Stardog %s or later, but the server is running %s", e.Method, e.MinVersion, e.ServerVersion)
+}
+
+// Supports reports whether the server's version is at least the minimum version of the method, such as
+// "DatabaseAdminService.Create". The server's version is requested once and cached by the Client. If the
+// server doesn't report its version, the method is assumed to be supported.
+func (c *Client) Supports(ctx context.Context, method string) (bool, error) {
+	err := c.RequireCapability(ctx, method)
+	if _, ok := err.(*UnsupportedVersionError); ok {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// RequireCapability returns an [UnsupportedVersionError] if the server's version is older than the minimum
+// version of the method, such as "DatabaseAdminService.Create", so callers can fail with a clear error
+// rather than the 404 an older server responds to an unknown endpoint with. The server's version is
+// requested once and cached by the Client. If the server doesn't report its version, or the user isn't
+// permitted to read it (403 Forbidden), nil is returned, as it is for methods whose minimum version is
+// VersionUnknown or VersionClientSide.
+func (c *Client) RequireCapability(ctx context.Context, method string) error {
+	minVersion, err := ParseServerVersion(minimumVersion(method))
+	if err != nil {
+		return nil
+	}
+	serverVersion, err := c.cachedServerVersion(ctx)
+	if err != nil || serverVersion == nil {
+		return err
+	}
+	if serverVersion.Compare(minVersion) < 0 {
+		return &UnsupportedVersionError{Method: method, MinVersion: minimumVersion(method), ServerVersion: serverVersion.String()}
+	}
+	return nil
+}
+
+// checkCapability returns the error of RequireCapability for the method if Client.CheckCapabilities is set,
+// and nil otherwise.
+func (c *Client) checkCapability(ctx context.Context, method string) error {
+	if !c.CheckCapabilities {
+		return nil
+	}
+	return c.RequireCapability(ctx, method)
+}
+
+// cachedServerVersion returns the server's version, requesting it the first time. Like [Connect], it treats
+// a version the user isn't permitted to read as unknown.
+func (c *Client) cachedServerVersion(ctx context.Context) (*ServerVersion, error) {
+	c.serverVersionMu.Lock()
+	defer c.serverVersionMu.Unlock()
+	if c.serverVersionFetched {
+		return c.serverVersion, nil
+	}
+	version, _, err := c.ServerAdmin.Version(ctx)
+	if err != nil && statusCode(err) != http.StatusForbidden {
+		return nil, err
+	}
+	c.serverVersion, c.serverVersionFetched = version, true
+	return version, nil
+}
+
+// ServerVersion is a Stardog version, such as 9.2.1 or 10.0.0-SNAPSHOT.
+type ServerVersion struct {
+	Major int
+	Minor int
+	Patch int
+	// Anything after the numeric components, without its leading separator, e.g. "SNAPSHOT"
+	Suffix string
+}
+
+// ParseServerVersion parses a version such as "9.2.1" or "10.0.0-SNAPSHOT". Missing minor and patch components
+// count as zero.
+func ParseServerVersion(s string) (ServerVersion, error) {
+	components := versionComponents(s)
+	if len(components) == 0 || len(components) > 3 {
+		return ServerVersion{}, fmt.Errorf("stardog: invalid version %q", s)
+	}
+	var v ServerVersion
+	fields := []*int{&v.Major, &v.Minor, &v.Patch}
+	for i, n := range components {
+		*fields[i] = n
+	}
+	rest := s
+	for i := range components {
+		rest = strings.TrimPrefix(rest, strconv.Itoa(components[i]))
+		if i < len(components)-1 {
+			rest = strings.TrimPrefix(rest, ".")
+		}
+	}
+	if rest != "" {
+		if rest[0] != '-' && rest[0] != '+' && rest[0] != '.' {
+			return ServerVersion{}, fmt.Errorf("stardog: invalid version %q", s)
+		}
+		v.Suffix = rest[1:]
+	}
+	return v, nil
+}
+
+// String returns the version as major.minor.patch, followed by -suffix if it has one.
+func (v ServerVersion) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Suffix != "" {
+		s += "-" + v.Suffix
+	}
+	return s
+}
+
+// Compare compares the version to other, returning -1, 0 or 1. Suffixes are ignored, so e.g. a snapshot of
+// a release counts as the release.
+func (v ServerVersion) Compare(other ServerVersion) int {
+	as := []int{v.Major, v.Minor, v.Patch}
+	bs := []int{other.Major, other.Minor, other.Patch}
+	for i := range as {
+		if as[i] != bs[i] {
+			if as[i] < bs[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// versionComponents returns the leading numeric components of a dotted version number such as "9.2.1".
+func versionComponents(version string) []int {
+	var components []int
+	for _, part := range strings.Split(version, ".") {
+		end := strings.IndexFunc(part, func(r rune) bool { return r < '0' || r > '9' })
+		if end == 0 {
+			break
+		}
+		if end > 0 {
+			part = part[:end]
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			break
+		}
+		components = append(components, n)
+		if end > 0 {
+			break
+		}
+	}
+	return components
+}
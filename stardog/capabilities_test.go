@@ -0,0 +1,225 @@
+package stardog
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"testing"
+)
+
+func TestCapabilities(t *testing.T) {
+	capabilities := Capabilities()
+	methods := make(map[string]string, len(capabilities))
+	for _, capability := range capabilities {
+		methods[capability.Method] = capability.MinVersion
+	}
+	for _, method := range []string{"DatabaseAdminService.Create", "SPARQLService.Select", "ServerAdminService.IsAlive"} {
+		if got, ok := methods[method]; !ok || got != MinimumServerVersion {
+			t.Errorf("Capabilities()[%s] = %q, %v, want %q", method, got, ok, MinimumServerVersion)
+		}
+	}
+	if !sort.SliceIsSorted(capabilities, func(i, j int) bool { return capabilities[i].Method < capabilities[j].Method }) {
+		t.Errorf("Capabilities() isn't sorted by method")
+	}
+	for method := range minimumVersions {
+		if _, ok := methods[method]; !ok {
+			t.Errorf("minimumVersions has an entry for %s, which isn't a method of a service", method)
+		}
+	}
+}
+
+func TestClient_RequireCapability(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/admin/status", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"dbms.version": {"value": "6.2.3"}}`))
+	})
+	minimumVersions["ServerAdminService.Jobs"] = "6.0.0"
+	defer delete(minimumVersions, "ServerAdminService.Jobs")
+
+	ctx := context.Background()
+	err := client.RequireCapability(ctx, "DatabaseAdminService.Create")
+	var unsupported *UnsupportedVersionError
+	if !errors.As(err, &unsupported) {
+		t.Fatalf("Client.RequireCapability error = %v, want *UnsupportedVersionError", err)
+	}
+	want := UnsupportedVersionError{Method: "DatabaseAdminService.Create", MinVersion: MinimumServerVersion, ServerVersion: "6.2.3"}
+	if *unsupported != want {
+		t.Errorf("Client.RequireCapability error = %+v, want %+v", *unsupported, want)
+	}
+
+	supported, err := client.Supports(ctx, "ServerAdminService.Jobs")
+	if err != nil || !supported {
+		t.Errorf("Client.Supports = %v, %v, want true", supported, err)
+	}
+	supported, err = client.Supports(ctx, "DatabaseAdminService.Create")
+	if err != nil || supported {
+		t.Errorf("Client.Supports = %v, %v, want false", supported, err)
+	}
+	if requests != 1 {
+		t.Errorf("server version requested %d times, want once", requests)
+	}
+}
+
+func TestClient_RequireCapability_unknownMinimum(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/status", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the server's version should not be requested for a method without a known minimum version")
+	})
+
+	ctx := context.Background()
+	for _, method := range []string{"CacheService.Create", "DataSourceService.OptionsDocumentation"} {
+		if supported, err := client.Supports(ctx, method); err != nil || !supported {
+			t.Errorf("Client.Supports(%s) = %v, %v, want true", method, supported, err)
+		}
+	}
+	if got := minimumVersion("DataSourceService.OptionsDocumentation"); got != VersionClientSide {
+		t.Errorf("minimum version of DataSourceService.OptionsDocumentation = %q, want %q", got, VersionClientSide)
+	}
+}
+
+func TestClient_RequireCapability_unknownVersion(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/status", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+
+	if err := client.RequireCapability(context.Background(), "DatabaseAdminService.Create"); err != nil {
+		t.Errorf("Client.RequireCapability returned error for a server that doesn't report its version: %v", err)
+	}
+}
+
+func TestClient_RequireCapability_forbidden(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/admin/status", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	// like Connect, a user who can't read the server's version doesn't learn it rather than failing
+	for i := 0; i < 2; i++ {
+		if err := client.RequireCapability(context.Background(), "DataSourceService.RefreshCounts"); err != nil {
+			t.Errorf("Client.RequireCapability returned error for a version the user can't read: %v", err)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("server version requested %d times, want once", requests)
+	}
+}
+
+func TestClient_RequireCapability_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/status", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	supported, err := client.Supports(context.Background(), "DatabaseAdminService.Create")
+	if err == nil || supported {
+		t.Errorf("Client.Supports = %v, %v, want an error", supported, err)
+	}
+}
+
+func TestParseServerVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		want    ServerVersion
+		wantErr bool
+	}{
+		{"9.2.1", ServerVersion{Major: 9, Minor: 2, Patch: 1}, false},
+		{"10.0.0-SNAPSHOT", ServerVersion{Major: 10, Suffix: "SNAPSHOT"}, false},
+		{"8.1", ServerVersion{Major: 8, Minor: 1}, false},
+		{"7", ServerVersion{Major: 7}, false},
+		{"", ServerVersion{}, true},
+		{"latest", ServerVersion{}, true},
+		{"9.2.1.4", ServerVersion{}, true},
+		{"9.2.1rc1", ServerVersion{}, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseServerVersion(tt.version)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseServerVersion(%q) error = %v, wantErr %v", tt.version, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseServerVersion(%q) = %+v, want %+v", tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestServerVersion_String(t *testing.T) {
+	for _, version := range []string{"9.2.1", "10.0.0-SNAPSHOT"} {
+		v, err := ParseServerVersion(version)
+		if err != nil {
+			t.Fatalf("ParseServerVersion(%q) returned error: %v", version, err)
+		}
+		if got := v.String(); got != version {
+			t.Errorf("ServerVersion.String() = %q, want %q", got, version)
+		}
+	}
+}
+
+func TestServerVersion_Compare(t *testing.T) {
+	tests := []struct {
+		a, b ServerVersion
+		want int
+	}{
+		{ServerVersion{Major: 9, Minor: 2, Patch: 1}, ServerVersion{Major: 9, Minor: 2, Patch: 1}, 0},
+		{ServerVersion{Major: 8}, ServerVersion{Major: 9}, -1},
+		{ServerVersion{Major: 9, Minor: 10}, ServerVersion{Major: 9, Minor: 2, Patch: 5}, 1},
+		{ServerVersion{Major: 10, Suffix: "SNAPSHOT"}, ServerVersion{Major: 10}, 0},
+		{ServerVersion{Major: 7, Minor: 10}, ServerVersion{Major: 7, Minor: 9, Patch: 2}, 1},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Compare(tt.b); got != tt.want {
+			t.Errorf("%v.Compare(%v) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestClient_CheckCapabilities(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/status", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"dbms.version": {"value": "7.9.1"}}`))
+	})
+	refreshed := false
+	mux.HandleFunc("/admin/data_sources/postgres/refresh_counts", func(w http.ResponseWriter, r *http.Request) {
+		refreshed = true
+	})
+
+	ctx := context.Background()
+	if _, err := client.DataSource.RefreshCounts(ctx, "postgres", nil); err != nil {
+		t.Fatalf("DataSource.RefreshCounts returned error without CheckCapabilities: %v", err)
+	}
+	if !refreshed {
+		t.Error("DataSource.RefreshCounts should be sent without CheckCapabilities")
+	}
+
+	refreshed = false
+	client.CheckCapabilities = true
+	_, err := client.DataSource.RefreshCounts(ctx, "postgres", nil)
+	var unsupported *UnsupportedVersionError
+	if !errors.As(err, &unsupported) {
+		t.Fatalf("DataSource.RefreshCounts error = %v, want *UnsupportedVersionError", err)
+	}
+	if unsupported.MinVersion != "8.0.0" {
+		t.Errorf("UnsupportedVersionError.MinVersion = %q, want %q", unsupported.MinVersion, "8.0.0")
+	}
+	if refreshed {
+		t.Error("DataSource.RefreshCounts should not be sent to a server that doesn't support it")
+	}
+}
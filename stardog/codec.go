@@ -0,0 +1,105 @@
+package stardog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// Codec encodes JSON request bodies and decodes JSON response bodies for a [Client].
+//
+// The default Codec uses encoding/json, which encodes map keys in sorted order so request bodies are
+// deterministic. Users with very high request throughput can provide a Codec backed by a faster JSON library
+// (e.g. jsoniter or segmentio/encoding) through the Client.Codec field; wrap it in a [CanonicalCodec] if
+// request bodies need to stay deterministic, e.g. for recording or hashing requests.
+type Codec interface {
+	// Marshal returns the JSON encoding of v.
+	Marshal(v any) ([]byte, error)
+	// Unmarshal parses the JSON-encoded data and stores the result in the value pointed to by v.
+	Unmarshal(data []byte, v any) error
+}
+
+// stdCodec is the default Codec, backed by encoding/json.
+type stdCodec struct{}
+
+// Marshal encodes v without escaping HTML characters, so that SPARQL and RDF content is sent as-is.
+func (stdCodec) Marshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal decodes the first JSON value in data, ignoring empty data.
+func (stdCodec) Unmarshal(data []byte, v any) error {
+	err := json.NewDecoder(bytes.NewReader(data)).Decode(v)
+	if err == io.EOF {
+		return nil
+	}
+	return err
+}
+
+// codec returns the Codec used by the client.
+func (c *Client) codec() Codec {
+	if c.Codec == nil {
+		return stdCodec{}
+	}
+	return c.Codec
+}
+
+// CanonicalCodec wraps a Codec so that the JSON it encodes is canonical (see [CanonicalJSON]), making request
+// bodies deterministic whatever order the wrapped Codec writes map keys in. Decoding is left to the wrapped
+// Codec, or encoding/json if Codec is nil.
+type CanonicalCodec struct {
+	Codec Codec
+}
+
+// Marshal encodes v with the wrapped Codec and canonicalizes the result, followed by a newline like the
+// default Codec.
+func (c CanonicalCodec) Marshal(v any) ([]byte, error) {
+	data, err := c.codec().Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	canonical, err := CanonicalJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	return append(canonical, '\n'), nil
+}
+
+// Unmarshal decodes data with the wrapped Codec.
+func (c CanonicalCodec) Unmarshal(data []byte, v any) error {
+	return c.codec().Unmarshal(data, v)
+}
+
+func (c CanonicalCodec) codec() Codec {
+	if c.Codec == nil {
+		return stdCodec{}
+	}
+	return c.Codec
+}
+
+// CanonicalJSON rewrites a JSON document in a canonical form, so equal documents have identical bytes and can be
+// compared or hashed: object keys are sorted, insignificant whitespace is removed, and HTML characters aren't
+// escaped. Numbers are kept exactly as written.
+func CanonicalJSON(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("canonicalizing JSON: unexpected data after the top-level value")
+	}
+	canonical, err := stdCodec{}.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(canonical, []byte("\n")), nil
+}
@@ -0,0 +1,158 @@
+package stardog
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// countingCodec wraps encoding/json and records how many times it was used.
+type countingCodec struct {
+	marshals   int
+	unmarshals int
+}
+
+func (c *countingCodec) Marshal(v any) ([]byte, error) {
+	c.marshals++
+	return json.Marshal(v)
+}
+
+func (c *countingCodec) Unmarshal(data []byte, v any) error {
+	c.unmarshals++
+	return json.Unmarshal(data, v)
+}
+
+func TestClient_Codec(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	codec := &countingCodec{}
+	client.Codec = codec
+
+	mux.HandleFunc("/admin/users/frodo/enabled", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"enabled":true}`)
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/admin/users", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"users":["admin","frodo"]}`))
+	})
+
+	ctx := context.Background()
+	if _, err := client.User.Enable(ctx, "frodo"); err != nil {
+		t.Errorf("User.Enable returned error: %v", err)
+	}
+	got, _, err := client.User.ListNames(ctx)
+	if err != nil {
+		t.Errorf("User.ListNames returned error: %v", err)
+	}
+	if want := []string{"admin", "frodo"}; !cmp.Equal(got, want) {
+		t.Errorf("User.ListNames = %+v, want %+v", got, want)
+	}
+
+	if codec.marshals != 1 {
+		t.Errorf("Codec.Marshal called %d times, want 1", codec.marshals)
+	}
+	if codec.unmarshals != 1 {
+		t.Errorf("Codec.Unmarshal called %d times, want 1", codec.unmarshals)
+	}
+}
+
+func TestStdCodec(t *testing.T) {
+	codec := stdCodec{}
+
+	got, err := codec.Marshal(map[string]string{"query": "SELECT * { ?s <urn:p> ?o }"})
+	if err != nil {
+		t.Errorf("stdCodec.Marshal returned error: %v", err)
+	}
+	if want := `{"query":"SELECT * { ?s <urn:p> ?o }"}` + "\n"; string(got) != want {
+		t.Errorf("stdCodec.Marshal = %s, want %s", got, want)
+	}
+
+	var v map[string]string
+	if err := codec.Unmarshal(nil, &v); err != nil {
+		t.Errorf("stdCodec.Unmarshal of empty data returned error: %v", err)
+	}
+	if err := codec.Unmarshal([]byte(`{"a":"b"}`), &v); err != nil {
+		t.Errorf("stdCodec.Unmarshal returned error: %v", err)
+	}
+	if want := map[string]string{"a": "b"}; !cmp.Equal(v, want) {
+		t.Errorf("stdCodec.Unmarshal = %+v, want %+v", v, want)
+	}
+}
+
+// unsortedCodec writes object keys in reverse order, like JSON libraries that don't sort map keys.
+type unsortedCodec struct{}
+
+func (unsortedCodec) Marshal(v any) ([]byte, error) {
+	return []byte(`{"z": 1, "a": {"y": [true, null], "b": "<x>"}}`), nil
+}
+
+func (unsortedCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+func TestCanonicalCodec(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	client.Codec = CanonicalCodec{Codec: unsortedCodec{}}
+	mux.HandleFunc("/db1/options", func(w http.ResponseWriter, r *http.Request) {
+		testBody(t, r, `{"a":{"b":"<x>","y":[true,null]},"z":1}`+"\n")
+	})
+
+	req, err := client.NewRequest(http.MethodPost, "db1/options", &requestHeaderOptions{ContentType: mediaTypeApplicationJSON}, map[string]any{})
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	if _, err := client.Do(context.Background(), req, nil); err != nil {
+		t.Errorf("Do returned error: %v", err)
+	}
+
+	var got map[string]int
+	if err := (CanonicalCodec{}).Unmarshal([]byte(`{"a": 1}`), &got); err != nil || got["a"] != 1 {
+		t.Errorf("CanonicalCodec.Unmarshal = %v, %v, want map[a:1]", got, err)
+	}
+}
+
+func TestCanonicalJSON(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{`{"b": 1, "a": 2}`, `{"a":2,"b":1}`},
+		{` [ {"y": {"d": 1, "c": 2}}, "x & y" ] `, `[{"y":{"c":2,"d":1}},"x & y"]`},
+		{`{"big": 12345678901234567890, "exp": 1.50e+10}`, `{"big":12345678901234567890,"exp":1.50e+10}`},
+		{`"plain"`, `"plain"`},
+	}
+	for _, tt := range tests {
+		got, err := CanonicalJSON([]byte(tt.in))
+		if err != nil {
+			t.Errorf("CanonicalJSON(%s) returned error: %v", tt.in, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("CanonicalJSON(%s) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+
+	for _, invalid := range []string{`{"a":`, `{"a": 1} {"b": 2}`, ``} {
+		if _, err := CanonicalJSON([]byte(invalid)); err == nil {
+			t.Errorf("CanonicalJSON(%q) err = nil, want error", invalid)
+		}
+	}
+}
+
+func TestStdCodec_sortedKeys(t *testing.T) {
+	got, err := stdCodec{}.Marshal(map[string]any{"search.enabled": true, "database.online": false, "query.timeout": "1m"})
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	if want := `{"database.online":false,"query.timeout":"1m","search.enabled":true}` + "\n"; string(got) != want {
+		t.Errorf("Marshal = %s, want %s", got, want)
+	}
+}
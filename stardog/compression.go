@@ -1,5 +1,18 @@
 package stardog
 
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
 // Data compression formats available in Stardog.
 // The zero-value for Compression is CompressionUnknown
 type Compression int
@@ -31,3 +44,108 @@ func (c Compression) String() string {
 	}
 	return compressionValues[c]
 }
+
+// compressionExtensions maps the file extensions of compressed files to their Compression
+var compressionExtensions = map[string]Compression{
+	"gz":   CompressionGZIP,
+	"gzip": CompressionGZIP,
+	"bz2":  CompressionBZ2,
+	"zip":  CompressionZIP,
+}
+
+// GetCompressionFromExtension returns the Compression indicated by a filepath's extension,
+// e.g. CompressionGZIP for "data.ttl.gz", or CompressionUnknown if it's not a compressed file.
+func GetCompressionFromExtension(path string) Compression {
+	return compressionExtensions[strings.ToLower(strings.TrimPrefix(filepath.Ext(path), "."))]
+}
+
+// detectCompression returns the Compression of data starting with header, identified by its magic number,
+// or CompressionUnknown if it's not compressed.
+func detectCompression(header []byte) Compression {
+	switch {
+	case bytes.HasPrefix(header, []byte{0x1f, 0x8b}):
+		return CompressionGZIP
+	case bytes.HasPrefix(header, []byte("BZh")):
+		return CompressionBZ2
+	case bytes.HasPrefix(header, []byte("PK\x03\x04")):
+		return CompressionZIP
+	default:
+		return CompressionUnknown
+	}
+}
+
+// extension returns the file extension, without a leading dot, for files compressed with c.
+func (c Compression) extension() string {
+	switch c {
+	case CompressionGZIP:
+		return "gz"
+	case CompressionBZ2:
+		return "bz2"
+	case CompressionZIP:
+		return "zip"
+	default:
+		return ""
+	}
+}
+
+// contentEncoding returns the HTTP Content-Encoding of data compressed with c, or "" if the compression
+// has no registered content coding.
+func (c Compression) contentEncoding() string {
+	if c == CompressionGZIP {
+		return "gzip"
+	}
+	return ""
+}
+
+// Decompress returns a reader of the data read from r, decompressed, and the compression it detected from
+// the data's magic number: gzip, bzip2, or a ZIP archive of a single file, which is read into memory. Data
+// that isn't compressed is returned as is, with CompressionUnknown. Stardog doesn't compress with zstd.
+func Decompress(r io.Reader) (io.Reader, Compression, error) {
+	buffered := bufio.NewReader(r)
+	// the header is shorter than a magic number if the data is, which detectCompression handles
+	header, _ := buffered.Peek(4)
+	compression := detectCompression(header)
+	switch compression {
+	case CompressionGZIP:
+		decompressed, err := gzip.NewReader(buffered)
+		return decompressed, compression, err
+	case CompressionBZ2:
+		return bzip2.NewReader(buffered), compression, nil
+	case CompressionZIP:
+		data, err := io.ReadAll(buffered)
+		if err != nil {
+			return nil, compression, err
+		}
+		archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			return nil, compression, err
+		}
+		if len(archive.File) != 1 {
+			return nil, compression, fmt.Errorf("unable to decompress a ZIP archive of %d files", len(archive.File))
+		}
+		decompressed, err := archive.File[0].Open()
+		return decompressed, compression, err
+	default:
+		return buffered, compression, nil
+	}
+}
+
+// decompressReadCloser returns rc decompressed as it's read, closing rc when it's closed. Unlike Decompress, it
+// returns an error for a ZIP archive rather than reading the whole archive into memory.
+func decompressReadCloser(rc io.ReadCloser) (io.ReadCloser, error) {
+	buffered := bufio.NewReader(rc)
+	header, _ := buffered.Peek(4)
+	if detectCompression(header) == CompressionZIP {
+		rc.Close()
+		return nil, errors.New("unable to decompress a ZIP archive as it's streamed")
+	}
+	decompressed, _, err := Decompress(buffered)
+	if err != nil {
+		rc.Close()
+		return nil, err
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{decompressed, rc}, nil
+}
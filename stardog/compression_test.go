@@ -1,6 +1,12 @@
 package stardog
 
-import "testing"
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+)
 
 func TestCompression_Valid(t *testing.T) {
 	c := Compression(100)
@@ -11,3 +17,96 @@ func TestCompression_Valid(t *testing.T) {
 		t.Errorf("Compression string value should be empty string")
 	}
 }
+
+func TestGetCompressionFromExtension(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Compression
+	}{
+		{input: "file.ttl.gz", want: CompressionGZIP},
+		{input: "file.nq.GZ", want: CompressionGZIP},
+		{input: "file.trig.gzip", want: CompressionGZIP},
+		{input: "file.nq.bz2", want: CompressionBZ2},
+		{input: "file.rdf.zip", want: CompressionZIP},
+		{input: "file.ttl", want: CompressionUnknown},
+		{input: "file", want: CompressionUnknown},
+	}
+	for _, tc := range tests {
+		if got := GetCompressionFromExtension(tc.input); got != tc.want {
+			t.Errorf("GetCompressionFromExtension(%q) = %v, want %v", tc.input, got, tc.want)
+		}
+	}
+}
+
+func TestDetectCompression(t *testing.T) {
+	tests := []struct {
+		header []byte
+		want   Compression
+	}{
+		{header: []byte{0x1f, 0x8b, 0x08, 0x00}, want: CompressionGZIP},
+		{header: []byte("BZh9"), want: CompressionBZ2},
+		{header: []byte("PK\x03\x04"), want: CompressionZIP},
+		{header: []byte("@pre"), want: CompressionUnknown},
+		{header: []byte{0x1f}, want: CompressionUnknown},
+		{header: nil, want: CompressionUnknown},
+	}
+	for _, tc := range tests {
+		if got := detectCompression(tc.header); got != tc.want {
+			t.Errorf("detectCompression(%q) = %v, want %v", tc.header, got, tc.want)
+		}
+	}
+}
+
+// compressed returns data compressed with c.
+func compressed(t *testing.T, c Compression, data string) []byte {
+	t.Helper()
+	var b bytes.Buffer
+	switch c {
+	case CompressionGZIP:
+		w := gzip.NewWriter(&b)
+		w.Write([]byte(data))
+		w.Close()
+	case CompressionZIP:
+		w := zip.NewWriter(&b)
+		f, _ := w.Create("export.ttl")
+		f.Write([]byte(data))
+		w.Close()
+	case CompressionBZ2:
+		// "hello" compressed with bzip2, since compress/bzip2 can't compress
+		if data != "hello" {
+			t.Fatalf("compressed: only %q can be bzip2 compressed", "hello")
+		}
+		b.Write([]byte("BZh91AY&SY\x19\x31\x65\x3d\x00\x00\x00\x81\x00\x02\x44\xa0\x00\x21\x9a\x68\x33\x4d\x07\x33\x8b\xb9\x22\x9c\x28\x48\x0c\x98\xb2\x9e\x80"))
+	default:
+		b.WriteString(data)
+	}
+	return b.Bytes()
+}
+
+func TestDecompress(t *testing.T) {
+	for _, c := range []Compression{CompressionUnknown, CompressionGZIP, CompressionBZ2, CompressionZIP} {
+		r, got, err := Decompress(bytes.NewReader(compressed(t, c, "hello")))
+		if err != nil {
+			t.Fatalf("Decompress(%v) returned error: %v", c, err)
+		}
+		if got != c {
+			t.Errorf("Decompress detected %v, want %v", got, c)
+		}
+		data, err := io.ReadAll(r)
+		if err != nil {
+			t.Errorf("reading Decompress(%v) returned error: %v", c, err)
+		}
+		if string(data) != "hello" {
+			t.Errorf("Decompress(%v) = %q, want %q", c, data, "hello")
+		}
+	}
+
+	var b bytes.Buffer
+	w := zip.NewWriter(&b)
+	w.Create("a.ttl")
+	w.Create("b.ttl")
+	w.Close()
+	if _, _, err := Decompress(&b); err == nil {
+		t.Errorf("Decompress of a ZIP archive of 2 files returned no error")
+	}
+}
@@ -0,0 +1,91 @@
+package stardog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ConnectOptions specifies the optional parameters to [Connect].
+type ConnectOptions struct {
+	// How long the connectivity, authentication and version checks may take together (default 30 seconds).
+	// Requests made with the returned Client aren't limited by it.
+	Timeout time.Duration
+}
+
+// ServerInfo describes the server and user a Client returned by [Connect] is connected as.
+type ServerInfo struct {
+	// The username the client is authenticated as
+	Username string
+	// The version of Stardog the server is running, or nil if the server doesn't report it to the user
+	Version *ServerVersion
+}
+
+// The steps of [Connect] reported by a ConnectError.
+const (
+	ConnectStepClient       = "create client"
+	ConnectStepAlive        = "check server is alive"
+	ConnectStepAuthenticate = "authenticate"
+	ConnectStepVersion      = "detect server version"
+)
+
+// ConnectError is returned by [Connect] when one of its steps fails.
+type ConnectError struct {
+	// The server being connected to
+	ServerURL string
+	// The step that failed, one of the ConnectStep constants
+	Step string
+	// The error the step failed with
+	Err error
+}
+
+func (e *ConnectError) Error() string {
+	return fmt.Sprintf("connecting to %s: %s: %v", e.ServerURL, e.Step, e.Err)
+}
+
+func (e *ConnectError) Unwrap() error {
+	return e.Err
+}
+
+// defaultConnectTimeout is how long the checks of Connect may take by default.
+const defaultConnectTimeout = 30 * time.Second
+
+// Connect returns a Client for the server that's ready to use: it checks that the server is alive, that
+// the transport's credentials are accepted, and detects the server's version. The transport authenticates
+// requests, typically a [BasicAuthTransport], [BearerAuthTransport] or [TokenRefreshTransport].
+//
+// If a step fails, a *ConnectError reporting it is returned, wrapping the error it failed with, so
+// for example an *ErrorResponse with a 401 status means the credentials were rejected.
+func Connect(ctx context.Context, serverURL string, transport http.RoundTripper, opts *ConnectOptions) (*Client, *ServerInfo, error) {
+	if ctx == nil {
+		return nil, nil, errNonNilContext
+	}
+	timeout := defaultConnectTimeout
+	if opts != nil && opts.Timeout > 0 {
+		timeout = opts.Timeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	connectErr := func(step string, err error) error {
+		return &ConnectError{ServerURL: serverURL, Step: step, Err: err}
+	}
+	client, err := NewClient(serverURL, &http.Client{Transport: transport})
+	if err != nil {
+		return nil, nil, connectErr(ConnectStepClient, err)
+	}
+	if _, _, err := client.ServerAdmin.IsAlive(ctx); err != nil {
+		return nil, nil, connectErr(ConnectStepAlive, err)
+	}
+	username, _, err := client.User.WhoAmI(ctx)
+	if err != nil {
+		return nil, nil, connectErr(ConnectStepAuthenticate, err)
+	}
+	// the server's metrics are only readable by some users, so a user without access just doesn't learn the version
+	version, _, err := client.ServerAdmin.Version(ctx)
+	if err != nil && statusCode(err) != http.StatusForbidden {
+		return nil, nil, connectErr(ConnectStepVersion, err)
+	}
+	return client, &ServerInfo{Username: *username, Version: version}, nil
+}
@@ -0,0 +1,95 @@
+package stardog
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestConnect(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/admin/alive", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/admin/status/whoami", func(w http.ResponseWriter, r *http.Request) {
+		username, password, _ := r.BasicAuth()
+		if password != "admin" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(username))
+	})
+	mux.HandleFunc("/admin/status", func(w http.ResponseWriter, r *http.Request) {
+		if username, _, _ := r.BasicAuth(); username != "admin" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Write([]byte(`{"dbms.version": {"value": "9.2.1"}}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		username string
+		want     *ServerInfo
+	}{
+		{"admin", "admin", &ServerInfo{Username: "admin", Version: &ServerVersion{Major: 9, Minor: 2, Patch: 1}}},
+		{"user without access to metrics", "reader", &ServerInfo{Username: "reader"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := &BasicAuthTransport{Username: tt.username, Password: "admin"}
+			client, info, err := Connect(context.Background(), server.URL, transport, nil)
+			if err != nil {
+				t.Fatalf("Connect returned error: %v", err)
+			}
+			if client == nil {
+				t.Fatalf("Connect returned a nil client")
+			}
+			if !cmp.Equal(info, tt.want) {
+				t.Errorf("Connect = %+v, want %+v", info, tt.want)
+			}
+		})
+	}
+
+	// rejected credentials
+	_, _, err := Connect(context.Background(), server.URL, &BasicAuthTransport{Username: "admin", Password: "wrong"}, nil)
+	var connectErr *ConnectError
+	if !errors.As(err, &connectErr) || connectErr.Step != ConnectStepAuthenticate {
+		t.Fatalf("Connect err = %v, want a ConnectError for the authenticate step", err)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Connect err = %v, want it to wrap a 401 ErrorResponse", err)
+	}
+}
+
+func TestConnect_notAlive(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	_, _, err := Connect(context.Background(), server.URL, nil, &ConnectOptions{Timeout: time.Second})
+	var connectErr *ConnectError
+	if !errors.As(err, &connectErr) || connectErr.Step != ConnectStepAlive || connectErr.ServerURL != server.URL {
+		t.Errorf("Connect err = %v, want a ConnectError for the alive step", err)
+	}
+}
+
+func TestConnect_errors(t *testing.T) {
+	if _, _, err := Connect(nil, "http://localhost:5820", nil, nil); !errors.Is(err, errNonNilContext) {
+		t.Errorf("Connect err = %v, want errNonNilContext", err)
+	}
+	_, _, err := Connect(context.Background(), "://bad", nil, nil)
+	var connectErr *ConnectError
+	if !errors.As(err, &connectErr) || connectErr.Step != ConnectStepClient {
+		t.Errorf("Connect err = %v, want a ConnectError for the create client step", err)
+	}
+}
@@ -3,9 +3,12 @@ package stardog
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"sort"
 	"strconv"
+	"strings"
 )
 
 // DataSourceService handles communication with the [data source] related methods of the Stardog API.
@@ -13,12 +16,15 @@ import (
 // [data source]: https://docs.stardog.com/virtual-graphs/data-sources/
 type DataSourceService service
 
+// DataSource is a data source registered in the server, as returned by [DataSourceService.List].
 type DataSource struct {
 	// Name of data source
 	Name string `json:"entityName"`
 	// Whether the data source can be shared amongst virtual graphs
 	Shareable bool `json:"sharable"`
-	// Whether the data source is available or not
+	// Whether the data source is online. Data sources that couldn't be loaded when Stardog started are
+	// offline until brought online with [DataSourceService.Online]. Stardog has no way to take a data
+	// source offline.
 	Available bool `json:"available"`
 }
 
@@ -161,6 +167,32 @@ func (s *DataSourceService) Options(ctx context.Context, datasource string) (map
 	return dataSourceOptionsResponse.Options, resp, nil
 }
 
+// VirtualGraphs returns the virtual graphs that use a data source, e.g. to find those affected by updating or
+// deleting it. The data source may be named with or without its data-source:// prefix.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Virtual-Graphs/operation/virtualGraphInfos
+func (s *DataSourceService) VirtualGraphs(ctx context.Context, datasource string) ([]VirtualGraph, *Response, error) {
+	virtualGraphs, resp, err := s.client.Virtual.List(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	var dependents []VirtualGraph
+	for _, virtualGraph := range virtualGraphs {
+		if sameDataSource(virtualGraph.DataSource, datasource) {
+			dependents = append(dependents, virtualGraph)
+		}
+	}
+	return dependents, resp, nil
+}
+
+// dataSourcePrefix prefixes the names of data sources in the responses of some endpoints.
+const dataSourcePrefix = "data-source://"
+
+// sameDataSource reports whether two data source names are the same, ignoring their data-source:// prefix.
+func sameDataSource(a string, b string) bool {
+	return strings.TrimPrefix(a, dataSourcePrefix) == strings.TrimPrefix(b, dataSourcePrefix)
+}
+
 // Add adds a new data source to the system
 //
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/Data-Sources/operation/addDataSource
@@ -226,8 +258,13 @@ func (s *DataSourceService) RefreshMetadata(ctx context.Context, datasource stri
 // If the size of one or more tables change after the virtual graph is loaded, these estimates become stale,
 // potentially leading to suboptimal query plans.
 //
+// It requires Stardog 8.0.0 or later, which is checked first if Client.CheckCapabilities is set.
+//
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/Data-Sources/operation/refreshMetadata
 func (s *DataSourceService) RefreshCounts(ctx context.Context, datasource string, opts *RefreshDataSourceCountsOptions) (*Response, error) {
+	if err := s.client.checkCapability(ctx, "DataSourceService.RefreshCounts"); err != nil {
+		return nil, err
+	}
 	u := fmt.Sprintf("admin/data_sources/%s/refresh_counts", datasource)
 	headerOpts := &requestHeaderOptions{
 		ContentType: mediaTypeApplicationJSON,
@@ -354,3 +391,163 @@ func (s *DataSourceService) Query(ctx context.Context, datasource string, query
 	}
 	return &results, resp, err
 }
+
+// DataSourceInfo describes a data source: its status from [DataSourceService.List] and its options, with
+// secrets such as passwords removed so it can be logged or displayed. Stardog doesn't expose a data
+// source's table counts or when its metadata was last refreshed.
+type DataSourceInfo struct {
+	// Name of data source
+	Name string
+	// Whether the data source can be shared amongst virtual graphs
+	Shareable bool
+	// Whether the data source is available or not
+	Available bool
+	// Kind of data source: the subprotocol of its JDBC URL (e.g. "postgresql"), or the prefix of the option
+	// holding the URL of other data sources (e.g. "mongodb")
+	Type string
+	// URL of the data source, without any password it contains
+	URL string
+	// Options of the data source, with the values of secrets replaced by "<redacted>"
+	Options map[string]any
+}
+
+// redactedValue replaces secrets in DataSourceInfo.
+const redactedValue = "<redacted>"
+
+// Info returns a description of a data source, combining its options and its status.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Data-Sources/operation/getDataSourceOptions
+func (s *DataSourceService) Info(ctx context.Context, datasource string) (*DataSourceInfo, *Response, error) {
+	opts, resp, err := s.Options(ctx, datasource)
+	if err != nil {
+		return nil, resp, err
+	}
+	dataSources, resp, err := s.List(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	info := &DataSourceInfo{Name: datasource, Options: make(map[string]any, len(opts))}
+	for _, dataSource := range dataSources {
+		if dataSource.Name == datasource {
+			info.Shareable = dataSource.Shareable
+			info.Available = dataSource.Available
+			break
+		}
+	}
+	names := make([]string, 0, len(opts))
+	for name := range opts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value := opts[name]
+		str, isString := value.(string)
+		switch {
+		case isSecretOption(name):
+			info.Options[name] = redactedValue
+		case isString && isURLOption(name):
+			str = redactURL(str)
+			info.Options[name] = str
+			if info.URL == "" || name == "jdbc.url" {
+				info.URL = str
+				info.Type = dataSourceType(name, str)
+			}
+		default:
+			info.Options[name] = value
+		}
+	}
+	return info, resp, nil
+}
+
+// isSecretOption reports whether the value of a data source option is a secret.
+func isSecretOption(name string) bool {
+	name = strings.ToLower(name)
+	for _, secret := range []string{"password", "secret", "token", "private.key"} {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+	return false
+}
+
+// isURLOption reports whether a data source option is the URL of the data source, e.g. jdbc.url or mongodb.uri.
+func isURLOption(name string) bool {
+	return strings.HasSuffix(name, ".url") || strings.HasSuffix(name, ".uri") || strings.HasSuffix(name, ".urls")
+}
+
+// dataSourceType returns the kind of data source from the option holding its URL.
+func dataSourceType(option string, u string) string {
+	if option == "jdbc.url" {
+		// jdbc:<subprotocol>:<subname>
+		parts := strings.SplitN(u, ":", 3)
+		if len(parts) == 3 {
+			return parts[1]
+		}
+	}
+	return strings.SplitN(option, ".", 2)[0]
+}
+
+// redactURL removes the password from the user information of a URL and the values of its secret parameters,
+// in both the URL query (?password=x&...) and the semicolon-separated properties of some JDBC URLs (;password=x;...).
+func redactURL(u string) string {
+	if scheme := strings.Index(u, "://"); scheme >= 0 {
+		rest := u[scheme+3:]
+		end := strings.IndexAny(rest, "/?;")
+		if end < 0 {
+			end = len(rest)
+		}
+		if at := strings.LastIndex(rest[:end], "@"); at >= 0 {
+			if colon := strings.Index(rest[:at], ":"); colon >= 0 {
+				u = u[:scheme+3] + rest[:colon+1] + redactedValue + rest[at:]
+			}
+		}
+	}
+	var b strings.Builder
+	for len(u) > 0 {
+		i := strings.IndexAny(u, "?&;")
+		if i < 0 {
+			b.WriteString(u)
+			break
+		}
+		b.WriteString(u[:i+1])
+		u = u[i+1:]
+		end := strings.IndexAny(u, "&;")
+		if end < 0 {
+			end = len(u)
+		}
+		param := u[:end]
+		if eq := strings.Index(param, "="); eq >= 0 && (isSecretOption(param[:eq]) || strings.EqualFold(param[:eq], "pwd")) {
+			param = param[:eq+1] + redactedValue
+		}
+		b.WriteString(param)
+		u = u[end:]
+	}
+	return b.String()
+}
+
+// DataSourceConnectionTest is the result of [DataSourceService.TestConnection].
+type DataSourceConnectionTest struct {
+	// Whether Stardog connected to the data source
+	OK bool
+	// The error reported by Stardog or the data source's driver if the connection failed
+	Error string
+}
+
+// TestConnection tests the connection of an existing data source like [DataSourceService.TestExisting], but
+// reports a failed connection in the result, with the error from the data source's driver, instead of as an
+// error. Errors are only returned if the test couldn't be run, e.g. the data source doesn't exist or the
+// user isn't permitted to test it.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Data-Sources/operation/testDataSource
+func (s *DataSourceService) TestConnection(ctx context.Context, datasource string) (*DataSourceConnectionTest, *Response, error) {
+	resp, err := s.TestExisting(ctx, datasource)
+	if err == nil {
+		return &DataSourceConnectionTest{OK: true}, resp, nil
+	}
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) || IsNotFound(err) || IsAuthError(err) {
+		return nil, resp, err
+	}
+	return &DataSourceConnectionTest{Error: errorResponse.Message}, resp, nil
+}
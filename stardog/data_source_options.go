@@ -0,0 +1,60 @@
+package stardog
+
+// DataSourceOptionDetails describes a [data source option]: the key of an entry in the options passed to
+// [DataSourceService.Add], [DataSourceService.Update] and [DataSourceService.TestNew].
+//
+// [data source option]: https://docs.stardog.com/virtual-graphs/data-sources/#data-source-options
+type DataSourceOptionDetails struct {
+	// Key of the option
+	Name string
+	// Type of the option's value: "string", "boolean", "integer" or "list" (a comma-separated string)
+	Type string
+	// Kind of data source the option applies to, e.g. "jdbc" or "mongodb", or "all"
+	Category string
+	// Whether the option is required for data sources of its category
+	Required bool
+	// Whether the option's value is a secret, such as a password, that shouldn't be displayed
+	Secret bool
+	// What the option does
+	Description string
+	// Value used if the option isn't set, if any
+	DefaultValue any
+}
+
+// dataSourceOptions is the catalog of documented data source options returned by OptionsDocumentation.
+var dataSourceOptions = []DataSourceOptionDetails{
+	{Name: "jdbc.url", Type: "string", Category: "jdbc", Required: true, Description: "The JDBC connection URL of the data source"},
+	{Name: "jdbc.username", Type: "string", Category: "jdbc", Description: "The username used to connect to the data source"},
+	{Name: "jdbc.password", Type: "string", Category: "jdbc", Secret: true, Description: "The password used to connect to the data source"},
+	{Name: "jdbc.driver", Type: "string", Category: "jdbc", Required: true, Description: "The fully qualified class name of the JDBC driver"},
+	{Name: "sql.default.schema", Type: "string", Category: "jdbc", Description: "The schema used for unqualified table names in mappings"},
+	{Name: "sql.schemas", Type: "list", Category: "jdbc", Description: "The schemas, in addition to the default schema, whose tables can be mapped"},
+	{Name: "sql.dialect", Type: "string", Category: "jdbc", Description: "The SQL dialect of the data source, if it can't be detected from the driver"},
+	{Name: "sql.functions", Type: "list", Category: "jdbc", Description: "Additional SQL functions the data source supports, beyond those of its dialect"},
+	{Name: "testOnBorrow", Type: "boolean", Category: "jdbc", Description: "Whether connections are validated before they're taken from the connection pool", DefaultValue: true},
+	{Name: "validationQuery", Type: "string", Category: "jdbc", Description: "The query used to validate connections taken from the connection pool"},
+	{Name: "unique.key.sets", Type: "string", Category: "jdbc", Description: "Column sets that uniquely identify the rows of tables or views without declared keys, e.g. (schema.table.col1, schema.table.col2)"},
+	{Name: "mongodb.uri", Type: "string", Category: "mongodb", Required: true, Description: "The connection string of the MongoDB database"},
+	{Name: "elasticsearch.rest.urls", Type: "list", Category: "elasticsearch", Required: true, Description: "The URLs of the Elasticsearch cluster's nodes"},
+	{Name: "elasticsearch.username", Type: "string", Category: "elasticsearch", Description: "The username used to connect to the Elasticsearch cluster"},
+	{Name: "elasticsearch.password", Type: "string", Category: "elasticsearch", Secret: true, Description: "The password used to connect to the Elasticsearch cluster"},
+	{Name: "cassandra.contact.point", Type: "string", Category: "cassandra", Required: true, Description: "The address of a node of the Cassandra cluster"},
+	{Name: "cassandra.keyspace", Type: "string", Category: "cassandra", Required: true, Description: "The keyspace of the mapped tables"},
+	{Name: "sparql.url", Type: "string", Category: "sparql", Required: true, Description: "The URL of the SPARQL endpoint"},
+	{Name: "sparql.username", Type: "string", Category: "sparql", Description: "The username used to connect to the SPARQL endpoint"},
+	{Name: "sparql.password", Type: "string", Category: "sparql", Secret: true, Description: "The password used to connect to the SPARQL endpoint"},
+	{Name: "sparql.graphname", Type: "string", Category: "sparql", Description: "The graph of the SPARQL endpoint to query, if not the default graph"},
+	{Name: "share", Type: "boolean", Category: "all", Description: "Whether the data source can be shared amongst virtual graphs", DefaultValue: false},
+}
+
+// OptionsDocumentation returns information about the data source options documented by Stardog, keyed by
+// name, including their types and descriptions, e.g. to render a form for creating a data source. Unlike
+// [DatabaseAdminService.MetadataDocumentation], Stardog has no endpoint describing data source options, so
+// the catalog is maintained in the client and may lag behind the server; options not in it can still be set.
+func (s *DataSourceService) OptionsDocumentation() map[string]DataSourceOptionDetails {
+	options := make(map[string]DataSourceOptionDetails, len(dataSourceOptions))
+	for _, option := range dataSourceOptions {
+		options[option.Name] = option
+	}
+	return options
+}
@@ -0,0 +1,37 @@
+package stardog
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDataSourceService_OptionsDocumentation(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	got := client.DataSource.OptionsDocumentation()
+	if len(got) != len(dataSourceOptions) {
+		t.Errorf("DataSource.OptionsDocumentation has %d options, want %d: are option names duplicated?", len(got), len(dataSourceOptions))
+	}
+	jdbcURL, ok := got["jdbc.url"]
+	if !ok || !jdbcURL.Required || jdbcURL.Type != "string" || jdbcURL.Category != "jdbc" {
+		t.Errorf("DataSource.OptionsDocumentation[jdbc.url] = %+v, want a required jdbc string option", jdbcURL)
+	}
+
+	for name, option := range got {
+		if option.Name != name || option.Type == "" || option.Category == "" || option.Description == "" {
+			t.Errorf("option %q is incompletely documented: %+v", name, option)
+		}
+		if option.Secret != isSecretOption(name) {
+			t.Errorf("option %q Secret = %v, but DataSource.Info redacts it: %v", name, option.Secret, isSecretOption(name))
+		}
+		if category := strings.SplitN(name, ".", 2)[0]; option.Category != "all" && option.Category != category && option.Category != "jdbc" {
+			t.Errorf("option %q has category %q", name, option.Category)
+		}
+	}
+
+	got["jdbc.url"] = DataSourceOptionDetails{}
+	if client.DataSource.OptionsDocumentation()["jdbc.url"].Name != "jdbc.url" {
+		t.Errorf("modifying the result of DataSource.OptionsDocumentation modified the catalog")
+	}
+}
@@ -7,10 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"mime/multipart"
 	"net/http"
 	"os"
-	"path/filepath"
 	"strconv"
 	"strings"
 )
@@ -111,18 +109,34 @@ type DatabaseOptionDetails struct {
 type CreateDatabaseOptions struct {
 	// The data to be bulk-loaded to the database at creation time
 	Datasets []Dataset
-	// Database configuration options
+	// Database configuration options. See [DatabaseOptions.ToMap] for a typed way to build them.
 	DatabaseOptions map[string]any
 	// Whether to send the file contents to the server. Use if data exists client-side.
 	CopyToServer bool
+	// Progress, if not nil, is called as the datasets are sent to the server with CopyToServer, e.g. to
+	// display a progress bar. It's called from the goroutine streaming the request, one call at a time.
+	Progress func(UploadProgress)
 }
 
 // Dataset is used to specify a dataset (filepath and named graph to add data into) to be added to a Stardog database.
+// The data is either the file at Path or, to upload data that isn't in a file, read from Reader.
 type Dataset struct {
-	// Path to the file to be uploaded to the server
+	// Path to the file to be uploaded to the server. If Reader is set, Path is optional and only used as
+	// the name of the uploaded data.
 	Path string
 	// The optional named-graph (A.K.A context) for the data contained in the file to be added to.
 	NamedGraph string
+
+	// Reader, if not nil, provides the data to upload instead of the file at Path. It's streamed to
+	// the server, so it's never fully buffered in memory. Requires CreateDatabaseOptions.CopyToServer.
+	Reader io.Reader
+	// The RDF format of the data read from Reader. Required if Path doesn't have an RDF file extension.
+	Format RDFFormat
+	// The compression of the data read from Reader, if any
+	Compression Compression
+	// The number of bytes that will be read from Reader, if known. If the size of every uploaded
+	// dataset is known, the request is sent with a Content-Length instead of chunked.
+	Size int64
 }
 
 // ExportDataOptions specifies the optional parameters to the [DatabaseAdminService.ExportData] method.
@@ -130,12 +144,19 @@ type ExportDataOptions struct {
 	// The named graph(s) to export from the dataset
 	NamedGraph []string `url:"named-graph-uri,omitempty"`
 
-	// The RDF format for the exported data
+	// The RDF format for the exported data. RDFFormatTurtleStar, RDFFormatTrigStar and RDFFormatN3 can't be
+	// exported server-side.
 	Format RDFFormat `url:"-"`
 
-	// Compression format for the exported data. **Only applicable if data is exported ServerSide**
+	// Compression format for the exported data: that of the file if the data is exported ServerSide,
+	// otherwise that of the response, which Decompress undoes
 	Compression Compression `url:"compression,omitempty"`
 
+	// Decompress the exported data as it's read if it's compressed, detecting gzip, bzip2 and ZIP from the
+	// data itself. Not applicable if data is exported ServerSide. A ZIP archive can only be read once it has
+	// been received in full, so streamed exports can't decompress it and return an error instead.
+	Decompress bool `url:"-"`
+
 	// Export the data to the server
 	ServerSide bool `url:"server-side,omitempty"`
 }
@@ -146,18 +167,29 @@ type ExportObfuscatedDataOptions struct {
 	// The named graph(s) to export from the dataset
 	NamedGraph []string `url:"named-graph-uri,omitempty"`
 
-	// The RDF format for the exported data
+	// The RDF format for the exported data. RDFFormatTurtleStar, RDFFormatTrigStar and RDFFormatN3 can't be
+	// exported server-side.
 	Format RDFFormat `url:"-"`
 
-	// Compression format for the exported data. **Only applicable if data is exported ServerSide**
+	// Compression format for the exported data: that of the file if the data is exported ServerSide,
+	// otherwise that of the response, which Decompress undoes
 	Compression Compression `url:"compression,omitempty"`
 
+	// Decompress the exported data as it's read if it's compressed, detecting gzip, bzip2 and ZIP from the
+	// data itself. Not applicable if data is exported ServerSide. A ZIP archive can only be read once it has
+	// been received in full, so streamed exports can't decompress it and return an error instead.
+	Decompress bool `url:"-"`
+
 	// Export the data to Stardog's export dir ($STARDOG_HOME/.exports by default)
 	ServerSide bool `url:"server-side,omitempty"`
 
 	// Configuration file for obfuscation.
 	// See https://github.com/stardog-union/stardog-examples/blob/master/config/obfuscation.ttl for an example configuration file.
 	ObfuscationConfig *os.File `url:"-"`
+
+	// The obfuscation configuration, in Turtle, read from any source such as an in-memory buffer or an object
+	// storage download. It can't be set along with ObfuscationConfig.
+	ObfuscationConfigReader io.Reader `url:"-"`
 }
 
 // response for Namespaces
@@ -165,11 +197,6 @@ type databaseNamespacesResponse struct {
 	Namespaces []Namespace `json:"namespaces"`
 }
 
-// response for ListWithMetadata
-type listDatabasesWithMetadataResponse struct {
-	Databases []map[string]any `json:"databases"`
-}
-
 // response for List
 type listDatabasesResponse struct {
 	Databases []string `json:"databases"`
@@ -198,6 +225,9 @@ type createDatabaseResponse struct {
 //
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/getDatabaseOptions
 func (s *DatabaseAdminService) Metadata(ctx context.Context, database string, opts []string) (map[string]any, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
 	u := fmt.Sprintf("admin/databases/%s/options", database)
 	headerOpts := requestHeaderOptions{
 		ContentType: mediaTypeApplicationJSON,
@@ -226,6 +256,9 @@ func (s *DatabaseAdminService) Metadata(ctx context.Context, database string, op
 //
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/setDatabaseOption
 func (s *DatabaseAdminService) SetMetadata(ctx context.Context, database string, opts map[string]any) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
 	u := fmt.Sprintf("admin/databases/%s/options", database)
 	headerOpts := requestHeaderOptions{
 		ContentType: mediaTypeApplicationJSON,
@@ -249,6 +282,9 @@ func (s *DatabaseAdminService) SetMetadata(ctx context.Context, database string,
 //
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/getAllDatabaseOptions
 func (s *DatabaseAdminService) AllMetadata(ctx context.Context, database string) (map[string]any, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
 	u := fmt.Sprintf("admin/databases/%s/options", database)
 	headerOpts := requestHeaderOptions{
 		Accept: mediaTypeApplicationJSON,
@@ -268,8 +304,45 @@ func (s *DatabaseAdminService) AllMetadata(ctx context.Context, database string)
 
 // ListWithMetadata returns all databases with their database configuration options (a.k.a. metadata)
 //
+// The response is decoded as it is read. For servers with many databases, use [DatabaseAdminService.IterateWithMetadata]
+// to avoid holding every database's metadata in memory at once. An empty response lists no databases.
+//
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/listDatabasesWithOptions
 func (s *DatabaseAdminService) ListWithMetadata(ctx context.Context) ([]map[string]any, *Response, error) {
+	iter, resp, err := s.IterateWithMetadata(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer iter.Close()
+
+	databases := make([]map[string]any, 0)
+	for iter.Next() {
+		databases = append(databases, iter.Metadata())
+	}
+	if err := iter.Err(); err != nil {
+		return nil, resp, err
+	}
+	return databases, resp, nil
+}
+
+// IterateWithMetadata returns an iterator over all databases with their database configuration options (a.k.a. metadata).
+// Databases are decoded from the response one at a time as the iterator is advanced, bounding memory use
+// on servers with many databases. The caller must call [DatabaseMetadataIterator.Close] when done.
+//
+//	iter, _, err := client.DatabaseAdmin.IterateWithMetadata(ctx)
+//	if err != nil {
+//	  return err
+//	}
+//	defer iter.Close()
+//	for iter.Next() {
+//	  fmt.Println(iter.Metadata()["database.name"])
+//	}
+//	if err := iter.Err(); err != nil {
+//	  return err
+//	}
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/listDatabasesWithOptions
+func (s *DatabaseAdminService) IterateWithMetadata(ctx context.Context) (*DatabaseMetadataIterator, *Response, error) {
 	u := "admin/databases/options"
 	headerOpts := requestHeaderOptions{
 		Accept: mediaTypeApplicationJSON,
@@ -279,12 +352,125 @@ func (s *DatabaseAdminService) ListWithMetadata(ctx context.Context) ([]map[stri
 		return nil, nil, err
 	}
 
-	var data listDatabasesWithMetadataResponse
-	resp, err := s.client.Do(ctx, req, &data)
+	resp, err := s.client.BareDo(ctx, req)
 	if err != nil {
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
 		return nil, resp, err
 	}
-	return data.Databases, resp, err
+	return newDatabaseMetadataIterator(resp.Body), resp, nil
+}
+
+// DatabaseMetadataIterator iterates over the databases returned by [DatabaseAdminService.IterateWithMetadata].
+type DatabaseMetadataIterator struct {
+	body    io.ReadCloser
+	decoder *json.Decoder
+	current map[string]any
+	started bool
+	done    bool
+	err     error
+}
+
+func newDatabaseMetadataIterator(body io.ReadCloser) *DatabaseMetadataIterator {
+	return &DatabaseMetadataIterator{
+		body:    body,
+		decoder: json.NewDecoder(body),
+	}
+}
+
+// Next advances the iterator to the next database. It returns false when there are
+// no more databases or an error occurred; use [DatabaseMetadataIterator.Err] to tell the two apart.
+func (it *DatabaseMetadataIterator) Next() bool {
+	if it.done {
+		return false
+	}
+	if !it.started {
+		it.started = true
+		found, err := it.seekDatabases()
+		if !found || err != nil {
+			return it.stop(err)
+		}
+	}
+	if !it.decoder.More() {
+		return it.stop(nil)
+	}
+	var metadata map[string]any
+	if err := it.decoder.Decode(&metadata); err != nil {
+		return it.stop(err)
+	}
+	it.current = metadata
+	return true
+}
+
+// Metadata returns the configuration options of the current database.
+func (it *DatabaseMetadataIterator) Metadata() map[string]any {
+	return it.current
+}
+
+// Err returns the first error encountered while iterating, if any.
+func (it *DatabaseMetadataIterator) Err() error {
+	return it.err
+}
+
+// Close closes the underlying response body. It is safe to call Close more than once.
+func (it *DatabaseMetadataIterator) Close() error {
+	it.done = true
+	if it.body == nil {
+		return nil
+	}
+	err := it.body.Close()
+	it.body = nil
+	return err
+}
+
+// stop ends the iteration, recording err if non-nil.
+func (it *DatabaseMetadataIterator) stop(err error) bool {
+	it.done = true
+	it.current = nil
+	it.err = err
+	return false
+}
+
+// seekDatabases positions the decoder at the first element of the "databases" array, skipping any other fields.
+// It returns false if the response body is empty, which lists no databases.
+func (it *DatabaseMetadataIterator) seekDatabases() (bool, error) {
+	token, err := it.decoder.Token()
+	if errors.Is(err, io.EOF) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return false, fmt.Errorf("unexpected JSON token %v, want %v", token, json.Delim('{'))
+	}
+	for it.decoder.More() {
+		key, err := it.decoder.Token()
+		if err != nil {
+			return false, err
+		}
+		if key == "databases" {
+			return true, expectDelim(it.decoder, '[')
+		}
+		var skip json.RawMessage
+		if err := it.decoder.Decode(&skip); err != nil {
+			return false, err
+		}
+	}
+	return false, errors.New(`response does not contain a "databases" field`)
+}
+
+// expectDelim reads the next token from the decoder and checks that it is the delimiter want.
+func expectDelim(decoder *json.Decoder, want json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != want {
+		return fmt.Errorf("unexpected JSON token %v, want %v", token, want)
+	}
+	return nil
 }
 
 // ListDatabases returns the names of all databases in the server.
@@ -309,9 +495,13 @@ func (s *DatabaseAdminService) ListDatabases(ctx context.Context) ([]string, *Re
 }
 
 // Namespaces retrieves the namespaces stored in the database.
+// Only read access to the database is required, so this can be called by non-admin users.
 //
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/getNamespaces
 func (s *DatabaseAdminService) Namespaces(ctx context.Context, database string) ([]Namespace, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
 	u := fmt.Sprintf("%s/namespaces", database)
 	headerOpts := requestHeaderOptions{
 		Accept: mediaTypeApplicationJSON,
@@ -329,11 +519,15 @@ func (s *DatabaseAdminService) Namespaces(ctx context.Context, database string)
 	return data.Namespaces, resp, err
 }
 
-// ImportNamespaces adds namespaces to the database that are declared in the RDF file.
+// ImportNamespaces adds namespaces to the database that are declared in the RDF file, whose format is
+// determined from its extension or, if it doesn't have an RDF file extension, its contents (see
+// [DetectRDFFormat]). See [DatabaseAdminService.ImportNamespacesFromReader] for RDF that isn't in a file.
 //
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/getNamespaces
 func (s *DatabaseAdminService) ImportNamespaces(ctx context.Context, database string, file *os.File) (*ImportNamespacesResponse, *Response, error) {
-	u := fmt.Sprintf("%s/namespaces", database)
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
 	headerOpts := requestHeaderOptions{
 		Accept: mediaTypeApplicationJSON,
 	}
@@ -345,22 +539,68 @@ func (s *DatabaseAdminService) ImportNamespaces(ctx context.Context, database st
 			return nil, nil, err
 		}
 		if stat.IsDir() {
-			return nil, nil, errors.New("the file containing the namespaces can't be a directory")
+			return nil, nil, &ValidationError{Field: "file", Value: file.Name(), Constraint: "must not be a directory"}
 		}
 
+		var data io.Reader = file
 		rdfFormat, err := GetRDFFormatFromExtension(file.Name())
 		if err != nil {
-			return nil, nil, err
+			// files without an RDF extension, such as temporary files, are recognized by their contents
+			detected, sniffed, sniffErr := sniffRDFFormat(file)
+			if errors.Is(sniffErr, errUnknownRDFContent) {
+				return nil, nil, err
+			}
+			if sniffErr != nil {
+				return nil, nil, sniffErr
+			}
+			rdfFormat, data = detected, sniffed
 		}
 		headerOpts.ContentType = rdfFormat.String()
 
-		_, err = io.Copy(&requestBody, file)
+		headerOpts.ContentEncoding, err = readRDFFile(&requestBody, data, file.Name())
 		if err != nil {
 			return nil, nil, err
 		}
 	}
+	return s.importNamespaces(ctx, database, &headerOpts, &requestBody)
+}
+
+// ImportNamespacesFromReader adds namespaces to the database that are declared in the RDF data read from r,
+// which is in the given format. Use it for RDF that's embedded or generated rather than in a file. Gzipped
+// or bzip2 compressed data is detected from its contents.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/getNamespaces
+func (s *DatabaseAdminService) ImportNamespacesFromReader(ctx context.Context, database string, r io.Reader, format RDFFormat) (*ImportNamespacesResponse, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
+	if r == nil {
+		return nil, nil, &ValidationError{Field: "r", Value: r, Constraint: "must not be nil"}
+	}
+	if !format.Valid() {
+		return nil, nil, &ValidationError{Field: "format", Value: format, Constraint: "must be a known value"}
+	}
+	headerOpts := requestHeaderOptions{
+		ContentType: format.String(),
+		Accept:      mediaTypeApplicationJSON,
+	}
 
-	req, err := s.client.NewRequest(http.MethodPost, u, &headerOpts, requestBody)
+	data, contentEncoding, err := uploadReader(r, "r", CompressionUnknown)
+	if err != nil {
+		return nil, nil, err
+	}
+	headerOpts.ContentEncoding = contentEncoding
+	// the namespaces are buffered, since they're small, so the request can be retried
+	var requestBody bytes.Buffer
+	if _, err := io.Copy(&requestBody, data); err != nil {
+		return nil, nil, err
+	}
+	return s.importNamespaces(ctx, database, &headerOpts, &requestBody)
+}
+
+func (s *DatabaseAdminService) importNamespaces(ctx context.Context, database string, headerOpts *requestHeaderOptions, requestBody *bytes.Buffer) (*ImportNamespacesResponse, *Response, error) {
+	u := fmt.Sprintf("%s/namespaces", database)
+	req, err := s.client.NewRequest(http.MethodPost, u, headerOpts, requestBody)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -373,10 +613,95 @@ func (s *DatabaseAdminService) ImportNamespaces(ctx context.Context, database st
 	return &importNamespacesResponse, resp, err
 }
 
+// databaseNamespacesOption is the database option the namespaces of a database are stored in, as a list of "prefix=IRI".
+const databaseNamespacesOption = "database.namespaces"
+
+// SetNamespaces replaces the namespaces stored in the database with the given namespaces.
+// A namespace with an empty Prefix is the default namespace.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/setDatabaseOption
+func (s *DatabaseAdminService) SetNamespaces(ctx context.Context, database string, namespaces []Namespace) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	option := make([]string, 0, len(namespaces))
+	for i, namespace := range namespaces {
+		if err := namespace.validate(fmt.Sprintf("namespaces[%d]", i)); err != nil {
+			return nil, err
+		}
+		option = append(option, namespace.Prefix+"="+namespace.Name)
+	}
+	return s.SetMetadata(ctx, database, map[string]any{databaseNamespacesOption: option})
+}
+
+// AddNamespace adds a namespace to the database, replacing the IRI of the prefix if it's already declared.
+// The database's namespaces are read and then written back, so concurrent changes to them may be lost.
+func (s *DatabaseAdminService) AddNamespace(ctx context.Context, database string, prefix string, iri string) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	added := Namespace{Prefix: prefix, Name: iri}
+	if err := added.validate("namespace"); err != nil {
+		return nil, err
+	}
+	namespaces, resp, err := s.Namespaces(ctx, database)
+	if err != nil {
+		return resp, err
+	}
+	replaced := false
+	for i, namespace := range namespaces {
+		if namespace.Prefix == prefix {
+			namespaces[i] = added
+			replaced = true
+		}
+	}
+	if !replaced {
+		namespaces = append(namespaces, added)
+	}
+	return s.SetNamespaces(ctx, database, namespaces)
+}
+
+// RemoveNamespace removes the namespace with the prefix from the database. It's not an error if the prefix
+// isn't declared. The database's namespaces are read and then written back, so concurrent changes to them
+// may be lost.
+func (s *DatabaseAdminService) RemoveNamespace(ctx context.Context, database string, prefix string) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	namespaces, resp, err := s.Namespaces(ctx, database)
+	if err != nil {
+		return resp, err
+	}
+	remaining := make([]Namespace, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		if namespace.Prefix != prefix {
+			remaining = append(remaining, namespace)
+		}
+	}
+	if len(remaining) == len(namespaces) {
+		return resp, nil
+	}
+	return s.SetNamespaces(ctx, database, remaining)
+}
+
+// readRDFFile copies the data of the RDF file with the given name into body, returning the Content-Encoding to
+// upload it with (see [uploadReader]).
+func readRDFFile(body *bytes.Buffer, data io.Reader, name string) (string, error) {
+	r, contentEncoding, err := uploadReader(data, "file", GetCompressionFromExtension(name))
+	if err != nil {
+		return "", err
+	}
+	_, err = io.Copy(body, r)
+	return contentEncoding, err
+}
+
 // Size returns the size of the database. Size is approximate unless the GetDatabaseSizeOptions.Exact field is set to true.
 //
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/listDatabases
 func (s *DatabaseAdminService) Size(ctx context.Context, database string, opts *DatabaseSizeOptions) (*int, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
 	u := fmt.Sprintf("%s/size", database)
 	urlWithOptions, err := addOptions(u, opts)
 	if err != nil {
@@ -402,8 +727,46 @@ func (s *DatabaseAdminService) Size(ctx context.Context, database string, opts *
 	return &resultAsInt, resp, err
 }
 
+// PublicDatabaseMetadata is the metadata returned by [DatabaseAdminService.PublicMetadata].
+// Fields that could not be fetched are nil.
+type PublicDatabaseMetadata struct {
+	// The database the metadata is for
+	Database string
+	// The namespaces stored in the database
+	Namespaces []Namespace
+	// The approximate size of the database
+	Size *int
+}
+
+// PublicMetadata returns the metadata about the database that is available to users with read access to it,
+// without requiring admin rights like [DatabaseAdminService.Metadata] does. Each piece of metadata is fetched
+// with its own request; if some of them fail (e.g. due to missing permissions) the metadata that could be fetched
+// is returned along with an error joining the failures. The response is that of the last request.
+func (s *DatabaseAdminService) PublicMetadata(ctx context.Context, database string) (*PublicDatabaseMetadata, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
+	metadata := &PublicDatabaseMetadata{Database: database}
+
+	var errs []error
+	namespaces, _, err := s.Namespaces(ctx, database)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("fetching namespaces: %w", err))
+	} else {
+		metadata.Namespaces = namespaces
+	}
+	size, resp, err := s.Size(ctx, database, nil)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("fetching size: %w", err))
+	} else {
+		metadata.Size = size
+	}
+	return metadata, resp, errors.Join(errs...)
+}
+
 // MetadataDocumentation returns information about all available database configuration options
-// (a.k.a. metadata) including description and example values.
+// (a.k.a. metadata) including description and example values. Convert the result to [DatabaseOptionsDocumentation]
+// to look options up by category and mutability, and to check values before calling SetMetadata.
 //
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/getAllMetaProperties
 func (s *DatabaseAdminService) MetadataDocumentation(ctx context.Context) (map[string]DatabaseOptionDetails, *Response, error) {
@@ -426,7 +789,8 @@ func (s *DatabaseAdminService) MetadataDocumentation(ctx context.Context) (map[s
 
 // Create creates a database, optionally with RDF and database options. Create assumes that the
 // Paths in the Dataset(s) provided for CreateDatabaseOptions.Datasets exist on the server. If they are client side,
-// provide a value of true for CreateDatabaseOptions.CopyToServer
+// provide a value of true for CreateDatabaseOptions.CopyToServer, in which case the files, and the data of
+// Datasets with a Reader, are streamed to the server as the request is sent rather than buffered in memory.
 //
 // If the database creation is successful a *string containing details about the database creation will be returned
 // such as:
@@ -437,12 +801,19 @@ func (s *DatabaseAdminService) MetadataDocumentation(ctx context.Context) (map[s
 //
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/createNewDatabase
 func (s *DatabaseAdminService) Create(ctx context.Context, name string, opts *CreateDatabaseOptions) (*string, *Response, error) {
-	body, writer, err := newCreateDatabaseRequestBody(name, opts)
+	if err := validateNotEmpty("name", name); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+	body, err := newCreateDatabaseRequestBody(name, opts)
 	if err != nil {
 		return nil, nil, err
 	}
+	defer body.Close()
 	headerOpts := &requestHeaderOptions{
-		ContentType: writer.FormDataContentType(),
+		ContentType: body.ContentType(),
 		Accept:      mediaTypeApplicationJSON,
 	}
 	req, err := s.client.NewMultipartFormDataRequest(
@@ -453,6 +824,7 @@ func (s *DatabaseAdminService) Create(ctx context.Context, name string, opts *Cr
 	if err != nil {
 		return nil, nil, err
 	}
+	req.ContentLength = body.Size()
 
 	var createDatabaseResponse createDatabaseResponse
 	resp, err := s.client.Do(ctx, req, &createDatabaseResponse)
@@ -462,11 +834,9 @@ func (s *DatabaseAdminService) Create(ctx context.Context, name string, opts *Cr
 	return createDatabaseResponse.Message, resp, nil
 }
 
-// newCreateDatabaseRequestBody creates the request body needed for DatabaseAdminService.CreateDatabase
-func newCreateDatabaseRequestBody(name string, opts *CreateDatabaseOptions) (*bytes.Buffer, *multipart.Writer, error) {
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
-
+// newCreateDatabaseRequestBody creates the request body needed for DatabaseAdminService.CreateDatabase. The files
+// of the datasets are opened, and any errors doing so returned, before the body is streamed.
+func newCreateDatabaseRequestBody(name string, opts *CreateDatabaseOptions) (*multipartUpload, error) {
 	req := createDatabaseRequest{
 		Name: name,
 		// initialize Files and Options to make sure [], {} respectively instead of null
@@ -475,12 +845,13 @@ func newCreateDatabaseRequestBody(name string, opts *CreateDatabaseOptions) (*by
 		Options: make(map[string]any),
 	}
 
+	var files []uploadFile
 	if opts != nil {
 		if opts.Datasets != nil {
 			req.Files = make([]createDatabaseRequestFile, len(opts.Datasets))
 			for i, dataset := range opts.Datasets {
 				req.Files[i] = createDatabaseRequestFile{
-					Filename: dataset.Path,
+					Filename: dataset.uploadName(i),
 					Context:  dataset.NamedGraph,
 				}
 			}
@@ -489,53 +860,32 @@ func newCreateDatabaseRequestBody(name string, opts *CreateDatabaseOptions) (*by
 			req.Options = opts.DatabaseOptions
 		}
 		req.CopyToServer = opts.CopyToServer
-	}
-
-	jsonReq, err := json.Marshal(req)
-	if err != nil {
-		return nil, nil, err
-	}
-	err = writer.WriteField("root", string(jsonReq))
-	if err != nil {
-		return nil, nil, err
-	}
 
-	// if files are to be sent to server, check that they exist on host
-	if opts != nil && opts.CopyToServer && opts.Datasets != nil {
-		for _, dataset := range opts.Datasets {
-			file, err := os.Open(dataset.Path)
+		// if files are to be sent to server, check that they exist on host
+		if opts.CopyToServer {
+			var err error
+			files, err = openDatasets(opts.Datasets, opts.Progress)
 			if err != nil {
-				return nil, nil, err
-			}
-
-			part, err := writer.CreateFormFile(filepath.Base(dataset.Path), filepath.Base(dataset.Path))
-			if err != nil {
-				return nil, nil, err
-			}
-
-			_, err = io.Copy(part, file)
-			if err != nil {
-				return nil, nil, err
-			}
-
-			err = file.Close()
-			if err != nil {
-				return nil, nil, err
+				return nil, err
 			}
 		}
 	}
 
-	err = writer.Close()
+	jsonReq, err := json.Marshal(req)
 	if err != nil {
-		return nil, nil, err
+		closeUploadFiles(files)
+		return nil, err
 	}
-	return body, writer, err
+	return newMultipartUpload(map[string]string{"root": string(jsonReq)}, files), nil
 }
 
 // Drop deletes a database
 //
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/dropDatabase
 func (s *DatabaseAdminService) Drop(ctx context.Context, database string) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
 	u := fmt.Sprintf("admin/databases/%s", database)
 
 	reqHeaderOpts := &requestHeaderOptions{
@@ -554,6 +904,9 @@ func (s *DatabaseAdminService) Drop(ctx context.Context, database string) (*Resp
 //
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/optimizeDatabase
 func (s *DatabaseAdminService) Optimize(ctx context.Context, database string) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
 	u := fmt.Sprintf("admin/databases/%s/optimize", database)
 
 	reqHeaderOpts := &requestHeaderOptions{
@@ -572,6 +925,9 @@ func (s *DatabaseAdminService) Optimize(ctx context.Context, database string) (*
 //
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/repairDatabase
 func (s *DatabaseAdminService) Repair(ctx context.Context, database string) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
 	u := fmt.Sprintf("admin/databases/%s/repair", database)
 
 	reqHeaderOpts := &requestHeaderOptions{
@@ -611,6 +967,9 @@ func (s *DatabaseAdminService) Restore(ctx context.Context, path string, opts *R
 //
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/onlineDatabase
 func (s *DatabaseAdminService) Online(ctx context.Context, database string) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
 	u := fmt.Sprintf("admin/databases/%s/online", database)
 
 	reqHeaderOpts := &requestHeaderOptions{
@@ -629,6 +988,9 @@ func (s *DatabaseAdminService) Online(ctx context.Context, database string) (*Re
 //
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/offlineDatabase
 func (s *DatabaseAdminService) Offline(ctx context.Context, database string) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
 	u := fmt.Sprintf("admin/databases/%s/offline", database)
 
 	reqHeaderOpts := &requestHeaderOptions{
@@ -647,6 +1009,12 @@ func (s *DatabaseAdminService) Offline(ctx context.Context, database string) (*R
 //
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/generateModel
 func (s *DatabaseAdminService) DataModel(ctx context.Context, database string, opts *DataModelOptions) (*bytes.Buffer, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
 	u := fmt.Sprintf("%s/model", database)
 	urlWithOptions, err := addOptions(u, opts)
 	if err != nil {
@@ -673,8 +1041,51 @@ func (s *DatabaseAdminService) DataModel(ctx context.Context, database string, o
 //
 //	Exported 28 statements from db1 to /stardog-home/.exports/db1-2023-01-15.trig in 2.551 ms
 //
+// The export is buffered in memory. For large databases, use [DatabaseAdminService.ExportDataStream].
+//
 // Starodg API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/exportDatabase
 func (s *DatabaseAdminService) ExportData(ctx context.Context, database string, opts *ExportDataOptions) (*bytes.Buffer, *Response, error) {
+	req, err := s.newExportDataRequest(database, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var writer bytes.Buffer
+	resp, err := s.client.Do(ctx, req, &writer)
+	if err != nil {
+		return nil, resp, err
+	}
+	if opts.decompress() {
+		decompressed, err := decompressBuffer(&writer)
+		return decompressed, resp, err
+	}
+	return &writer, resp, err
+}
+
+// ExportDataStream exports RDF data from the database like [DatabaseAdminService.ExportData], but instead of
+// buffering the export in memory it returns the response body so the data can be streamed to its destination
+// (e.g. a file). The caller must close the returned io.ReadCloser.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/exportDatabase
+func (s *DatabaseAdminService) ExportDataStream(ctx context.Context, database string, opts *ExportDataOptions) (io.ReadCloser, *Response, error) {
+	if opts.decompress() && opts.Compression == CompressionZIP {
+		return nil, nil, errStreamZIP("ExportDataOptions.Compression")
+	}
+	req, err := s.newExportDataRequest(database, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.streamExport(ctx, req, opts.decompress())
+}
+
+// newExportDataRequest validates the parameters of a data export and builds the request for it.
+func (s *DatabaseAdminService) newExportDataRequest(database string, opts *ExportDataOptions) (*http.Request, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
 	u := fmt.Sprintf("%s/export", database)
 
 	requestHeaderOptions := &requestHeaderOptions{}
@@ -684,10 +1095,10 @@ func (s *DatabaseAdminService) ExportData(ctx context.Context, database string,
 			if !opts.ServerSide {
 				requestHeaderOptions.Accept = opts.Format.String()
 			} else {
-				format, err := opts.Format.toExportFormat()
+				format, err := opts.Format.toExportFormat("ExportDataOptions.Format")
 				// this is very unlikely to happen because a check to see if format is valid is done earlier
 				if err != nil {
-					return nil, nil, err
+					return nil, err
 				}
 				u += fmt.Sprintf("?format=%s", format)
 
@@ -700,25 +1111,15 @@ func (s *DatabaseAdminService) ExportData(ctx context.Context, database string,
 
 	urlWithOptions, err := addOptions(u, opts)
 	if err != nil {
-		return nil, nil, err
-	}
-
-	req, err := s.client.NewRequest(http.MethodGet, urlWithOptions, requestHeaderOptions, nil)
-	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	var writer bytes.Buffer
-	resp, err := s.client.Do(ctx, req, &writer)
-	if err != nil {
-		return nil, resp, err
-	}
-	return &writer, resp, err
+	return s.client.NewRequest(http.MethodGet, urlWithOptions, requestHeaderOptions, nil)
 }
 
 // ExportObfuscatedData exports [obfuscated RDF data] from the database.
 //
-// If nil is provided for ExportObfuscatedDataOptions.ObfuscationConfig, Stardog will use its default
+// If neither ExportObfuscatedDataOptions.ObfuscationConfig nor ObfuscationConfigReader is provided, Stardog will use its default
 // obfuscation configuration. All URIs, bnodes, and string literals in the database will be
 // obfuscated using the SHA256 message digest algorithm. Non-string typed literals (numbers, dates, etc.)
 // are left unchanged as well as URIs from built-in namespaces (e.g. RDF, RDFS, OWL, etc.)
@@ -730,10 +1131,75 @@ func (s *DatabaseAdminService) ExportData(ctx context.Context, database string,
 //
 //	Exported 28 statements from db1 to /stardog-home/.exports/db1-2023-01-15.trig in 2.551 ms
 //
+// The export is buffered in memory. For large databases, use [DatabaseAdminService.ExportObfuscatedDataStream].
+//
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/exportDatabaseObfuscated
 //
 // [obfuscated RDF data]: https://docs.stardog.com/query-stardog/obfuscating-data
 func (s *DatabaseAdminService) ExportObfuscatedData(ctx context.Context, database string, opts *ExportObfuscatedDataOptions) (*bytes.Buffer, *Response, error) {
+	req, err := s.newExportObfuscatedDataRequest(database, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var writer bytes.Buffer
+	resp, err := s.client.Do(ctx, req, &writer)
+	if err != nil {
+		return nil, resp, err
+	}
+	if opts.decompress() {
+		decompressed, err := decompressBuffer(&writer)
+		return decompressed, resp, err
+	}
+	return &writer, resp, err
+}
+
+// ExportObfuscatedDataStream exports obfuscated RDF data from the database like [DatabaseAdminService.ExportObfuscatedData],
+// but instead of buffering the export in memory it returns the response body so the data can be streamed to its
+// destination. The caller must close the returned io.ReadCloser.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/exportDatabaseObfuscated
+func (s *DatabaseAdminService) ExportObfuscatedDataStream(ctx context.Context, database string, opts *ExportObfuscatedDataOptions) (io.ReadCloser, *Response, error) {
+	if opts.decompress() && opts.Compression == CompressionZIP {
+		return nil, nil, errStreamZIP("ExportObfuscatedDataOptions.Compression")
+	}
+	req, err := s.newExportObfuscatedDataRequest(database, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.streamExport(ctx, req, opts.decompress())
+}
+
+// obfuscationConfig returns the reader of the custom obfuscation configuration, or nil to use Stardog's default one.
+// ObfuscationConfigReader takes precedence over ObfuscationConfig, which must not be a directory.
+func (o *ExportObfuscatedDataOptions) obfuscationConfig() (io.Reader, error) {
+	if o == nil {
+		return nil, nil
+	}
+	if o.ObfuscationConfigReader != nil {
+		return o.ObfuscationConfigReader, nil
+	}
+	if o.ObfuscationConfig == nil {
+		return nil, nil
+	}
+	stat, err := o.ObfuscationConfig.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if stat.IsDir() {
+		return nil, &ValidationError{Field: "ExportObfuscatedDataOptions.ObfuscationConfig", Value: o.ObfuscationConfig.Name(), Constraint: "must not be a directory"}
+	}
+	return o.ObfuscationConfig, nil
+}
+
+// newExportObfuscatedDataRequest validates the parameters of an obfuscated data export and builds the request for it.
+func (s *DatabaseAdminService) newExportObfuscatedDataRequest(database string, opts *ExportObfuscatedDataOptions) (*http.Request, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
 	u := fmt.Sprintf("%s/export", database)
 
 	requestHeaderOptions := &requestHeaderOptions{}
@@ -742,21 +1208,15 @@ func (s *DatabaseAdminService) ExportObfuscatedData(ctx context.Context, databas
 	httpMethod := http.MethodGet
 
 	var requestBody *bytes.Buffer
-	if opts != nil && opts.ObfuscationConfig != nil {
+	if config, err := opts.obfuscationConfig(); err != nil {
+		return nil, err
+	} else if config != nil {
 		// if using custom obfuscation configuration, request should be a POST
 		httpMethod = http.MethodPost
 
-		stat, err := opts.ObfuscationConfig.Stat()
-		if err != nil {
-			return nil, nil, err
-		}
-		if stat.IsDir() {
-			return nil, nil, errors.New("the obfuscation configuration file can't be a directory")
-		}
-
-		requestBytes, err := io.ReadAll(opts.ObfuscationConfig)
+		requestBytes, err := io.ReadAll(config)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 
 		requestBody = bytes.NewBuffer(requestBytes)
@@ -772,10 +1232,10 @@ func (s *DatabaseAdminService) ExportObfuscatedData(ctx context.Context, databas
 				requestHeaderOptions.Accept = opts.Format.String()
 			} else {
 				requestHeaderOptions.Accept = mediaTypePlainText
-				format, err := opts.Format.toExportFormat()
+				format, err := opts.Format.toExportFormat("ExportObfuscatedDataOptions.Format")
 				// this is unlikely to occur, since we check if RDFFormat is Valid
 				if err != nil {
-					return nil, nil, err
+					return nil, err
 				}
 				// if obfuscation configuration was NOT provided
 				if strings.Contains(u, "?obf=DEFAULT") {
@@ -790,26 +1250,58 @@ func (s *DatabaseAdminService) ExportObfuscatedData(ctx context.Context, databas
 
 	urlWithOptions, err := addOptions(u, opts)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	var req *http.Request
 	if requestBody != nil && len(requestBody.Bytes()) > 0 {
-		req, err = s.client.NewRequest(httpMethod, urlWithOptions, requestHeaderOptions, requestBody)
-		if err != nil {
-			return nil, nil, err
-		}
-	} else {
-		req, err = s.client.NewRequest(httpMethod, urlWithOptions, requestHeaderOptions, nil)
-		if err != nil {
-			return nil, nil, err
-		}
+		return s.client.NewRequest(httpMethod, urlWithOptions, requestHeaderOptions, requestBody)
 	}
+	return s.client.NewRequest(httpMethod, urlWithOptions, requestHeaderOptions, nil)
+}
 
-	var writer bytes.Buffer
-	resp, err := s.client.Do(ctx, req, &writer)
+// streamExport sends an export request and returns the open response body.
+func (s *DatabaseAdminService) streamExport(ctx context.Context, req *http.Request, decompress bool) (io.ReadCloser, *Response, error) {
+	resp, err := s.client.BareDo(ctx, req)
 	if err != nil {
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
 		return nil, resp, err
 	}
-	return &writer, resp, err
+	if decompress {
+		body, err := decompressReadCloser(resp.Body)
+		return body, resp, err
+	}
+	return resp.Body, resp, nil
+}
+
+// errStreamZIP returns the error for a streamed export that would have to decompress a ZIP archive.
+func errStreamZIP(field string) error {
+	return &ValidationError{Field: field, Value: CompressionZIP, Constraint: "must not be ZIP when a streamed export is decompressed"}
+}
+
+// decompress reports whether a client-side export should be decompressed.
+func (o *ExportDataOptions) decompress() bool {
+	return o != nil && o.Decompress && !o.ServerSide
+}
+
+// decompress reports whether a client-side export should be decompressed.
+func (o *ExportObfuscatedDataOptions) decompress() bool {
+	return o != nil && o.Decompress && !o.ServerSide
+}
+
+// decompressBuffer returns the data of buf decompressed with Decompress.
+func decompressBuffer(buf *bytes.Buffer) (*bytes.Buffer, error) {
+	if detectCompression(buf.Bytes()) == CompressionUnknown {
+		return buf, nil
+	}
+	decompressed, _, err := Decompress(buf)
+	if err != nil {
+		return nil, err
+	}
+	var out bytes.Buffer
+	if _, err := io.Copy(&out, decompressed); err != nil {
+		return nil, err
+	}
+	return &out, nil
 }
@@ -1,10 +1,16 @@
 package stardog
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -109,6 +115,234 @@ func TestDatabaseAdminService_ExportData_clientSide(t *testing.T) {
 	})
 }
 
+func TestDatabaseAdminService_ExportData_decompress(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/db1/export", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.Query().Get("compression"); got != "GZIP" {
+			t.Errorf("compression parameter = %q, want GZIP", got)
+		}
+		w.Write(compressed(t, CompressionGZIP, "hello"))
+	})
+
+	ctx := context.Background()
+	opts := &ExportDataOptions{Format: RDFFormatTurtle, Compression: CompressionGZIP, Decompress: true}
+	got, _, err := client.DatabaseAdmin.ExportData(ctx, "db1", opts)
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.ExportData returned error: %v", err)
+	}
+	if got.String() != "hello" {
+		t.Errorf("DatabaseAdmin.ExportData = %q, want %q", got.String(), "hello")
+	}
+
+	body, _, err := client.DatabaseAdmin.ExportDataStream(ctx, "db1", opts)
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.ExportDataStream returned error: %v", err)
+	}
+	defer body.Close()
+	data, _ := io.ReadAll(body)
+	if string(data) != "hello" {
+		t.Errorf("DatabaseAdmin.ExportDataStream = %q, want %q", data, "hello")
+	}
+
+	opts.Decompress = false
+	got, _, err = client.DatabaseAdmin.ExportData(ctx, "db1", opts)
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.ExportData returned error: %v", err)
+	}
+	if !bytes.Equal(got.Bytes(), compressed(t, CompressionGZIP, "hello")) {
+		t.Errorf("DatabaseAdmin.ExportData without Decompress = %q, want the compressed data", got.String())
+	}
+}
+
+func TestDatabaseAdminService_ExportObfuscatedData_decompress(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var uncompressed bool
+	mux.HandleFunc("/db1/export", func(w http.ResponseWriter, r *http.Request) {
+		if uncompressed {
+			w.Write([]byte("plain"))
+			return
+		}
+		w.Write(compressed(t, CompressionZIP, "hello"))
+	})
+
+	ctx := context.Background()
+	opts := &ExportObfuscatedDataOptions{Compression: CompressionZIP, Decompress: true}
+	got, _, err := client.DatabaseAdmin.ExportObfuscatedData(ctx, "db1", opts)
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.ExportObfuscatedData returned error: %v", err)
+	}
+	if got.String() != "hello" {
+		t.Errorf("DatabaseAdmin.ExportObfuscatedData = %q, want %q", got.String(), "hello")
+	}
+
+	uncompressed = true
+	got, _, err = client.DatabaseAdmin.ExportObfuscatedData(ctx, "db1", opts)
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.ExportObfuscatedData returned error: %v", err)
+	}
+	if got.String() != "plain" {
+		t.Errorf("DatabaseAdmin.ExportObfuscatedData of uncompressed data = %q, want %q", got.String(), "plain")
+	}
+}
+
+func TestDatabaseAdminService_ExportDataStream(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	returnedRDF := `:The_Beatles rdf:type :Band .`
+
+	mux.HandleFunc(fmt.Sprintf("/%s/export", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", RDFFormatTurtle.String())
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(returnedRDF))
+	})
+
+	ctx := context.Background()
+
+	opts := &ExportDataOptions{
+		Format: RDFFormatTurtle,
+	}
+
+	body, _, err := client.DatabaseAdmin.ExportDataStream(ctx, db, opts)
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.ExportDataStream returned error: %v", err)
+	}
+	got, err := io.ReadAll(body)
+	if err != nil {
+		t.Errorf("reading DatabaseAdmin.ExportDataStream body returned error: %v", err)
+	}
+	if err := body.Close(); err != nil {
+		t.Errorf("closing DatabaseAdmin.ExportDataStream body returned error: %v", err)
+	}
+	if want := returnedRDF; !cmp.Equal(string(got), want) {
+		t.Errorf("DatabaseAdmin.ExportDataStream = %+v, want %+v", string(got), want)
+	}
+
+	const methodName = "ExportDataStream"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.DatabaseAdmin.ExportDataStream(ctx, "", opts)
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.DatabaseAdmin.ExportDataStream(nil, db, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestDatabaseAdminService_ExportDataStream_errorResponse(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	mux.HandleFunc(fmt.Sprintf("/%s/export", db), func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"Database 'db1' does not exist.","code":"0D0DU2"}`))
+	})
+
+	body, resp, err := client.DatabaseAdmin.ExportDataStream(context.Background(), db, nil)
+	if err == nil {
+		t.Errorf("DatabaseAdmin.ExportDataStream err = nil, want error")
+	}
+	if body != nil {
+		t.Errorf("DatabaseAdmin.ExportDataStream body = %#v, want nil", body)
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("DatabaseAdmin.ExportDataStream resp = %#v, want status %d", resp, http.StatusNotFound)
+	}
+}
+
+func TestDatabaseAdminService_ExportDataStream_decompressZIP(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var requests int
+	mux.HandleFunc("/db1/export", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write(compressed(t, CompressionZIP, "hello"))
+	})
+
+	ctx := context.Background()
+	var validationErr *ValidationError
+	_, _, err := client.DatabaseAdmin.ExportDataStream(ctx, "db1", &ExportDataOptions{Compression: CompressionZIP, Decompress: true})
+	if !errors.As(err, &validationErr) || validationErr.Field != "ExportDataOptions.Compression" {
+		t.Errorf("DatabaseAdmin.ExportDataStream of a ZIP export err = %v, want a ValidationError for ExportDataOptions.Compression", err)
+	}
+	_, _, err = client.DatabaseAdmin.ExportObfuscatedDataStream(ctx, "db1", &ExportObfuscatedDataOptions{Compression: CompressionZIP, Decompress: true})
+	if !errors.As(err, &validationErr) || validationErr.Field != "ExportObfuscatedDataOptions.Compression" {
+		t.Errorf("DatabaseAdmin.ExportObfuscatedDataStream of a ZIP export err = %v, want a ValidationError for ExportObfuscatedDataOptions.Compression", err)
+	}
+	if requests != 0 {
+		t.Errorf("ZIP exports to decompress sent %d requests, want 0", requests)
+	}
+
+	// a ZIP archive is detected from the data too
+	body, _, err := client.DatabaseAdmin.ExportDataStream(ctx, "db1", &ExportDataOptions{Decompress: true})
+	if err == nil {
+		body.Close()
+		t.Errorf("DatabaseAdmin.ExportDataStream of ZIP data returned no error")
+	}
+}
+
+func TestDatabaseAdminService_ExportObfuscatedDataStream(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	returnedRDF := `obf:c61219651e7f0bf78ef1ab754768a6eb1bd9d53df39aa5ef153fcf55b4f12b1f "1971-10-11"^^xsd:date .`
+
+	mux.HandleFunc(fmt.Sprintf("/%s/export", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", RDFFormatTrig.String())
+		if got, want := r.URL.Query().Get("obf"), "DEFAULT"; got != want {
+			t.Errorf("obf = %q, want %q", got, want)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(returnedRDF))
+	})
+
+	ctx := context.Background()
+
+	opts := &ExportObfuscatedDataOptions{
+		Format: RDFFormatTrig,
+	}
+
+	body, _, err := client.DatabaseAdmin.ExportObfuscatedDataStream(ctx, db, opts)
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.ExportObfuscatedDataStream returned error: %v", err)
+	}
+	defer body.Close()
+	got, err := io.ReadAll(body)
+	if err != nil {
+		t.Errorf("reading DatabaseAdmin.ExportObfuscatedDataStream body returned error: %v", err)
+	}
+	if want := returnedRDF; !cmp.Equal(string(got), want) {
+		t.Errorf("DatabaseAdmin.ExportObfuscatedDataStream = %+v, want %+v", string(got), want)
+	}
+
+	const methodName = "ExportObfuscatedDataStream"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.DatabaseAdmin.ExportObfuscatedDataStream(ctx, "", opts)
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.DatabaseAdmin.ExportObfuscatedDataStream(nil, db, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestDatabaseAdminService_ExportObfuscatedData_client_side(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -239,6 +473,43 @@ func TestDatabaseAdminService_ExportObfuscatedData_clientSideCustomObfConfig(t *
 	}
 }
 
+func TestDatabaseAdminService_ExportObfuscatedData_configReader(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	config := `@prefix obf: <tag:stardog:api:obf:> .
+[] a obf:Obfuscation ; obf:digest "SHA-256" .
+`
+	mux.HandleFunc(fmt.Sprintf("/%s/export", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", RDFFormatTurtle.String())
+		testBody(t, r, config)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("<urn:a> <urn:b> <urn:c> ."))
+	})
+
+	ctx := context.Background()
+	opts := &ExportObfuscatedDataOptions{
+		Format:                  RDFFormatTurtle,
+		ObfuscationConfigReader: strings.NewReader(config),
+	}
+	if _, _, err := client.DatabaseAdmin.ExportObfuscatedData(ctx, db, opts); err != nil {
+		t.Errorf("DatabaseAdmin.ExportObfuscatedData returned error: %v", err)
+	}
+
+	file, err := os.Open("./test-resources/obfuscation-config.ttl")
+	if err != nil {
+		t.Fatalf("error opening the obfuscation configuration file: %v", err)
+	}
+	defer file.Close()
+	opts.ObfuscationConfig = file
+	testBadOptions(t, "ExportObfuscatedData", func() error {
+		_, _, err := client.DatabaseAdmin.ExportObfuscatedData(ctx, db, opts)
+		return err
+	})
+}
+
 func TestDatabaseAdminService_ExportObfuscatedData_serverSide(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -825,6 +1096,18 @@ func TestDatabaseAdminService_ImportNamespaces(t *testing.T) {
 		t.Errorf("DatabaseAdmin.ImportNamespaces: unexpected error deleting a temp file: %v", err)
 	}
 
+	// a file that can't be read reports the read error rather than its extension
+	writeOnly, err := os.OpenFile(filepath.Join(t.TempDir(), "namespaces"), os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.ImportNamespaces: unexpected error creating a file: %v", err)
+	}
+	defer writeOnly.Close()
+	_, _, err = client.DatabaseAdmin.ImportNamespaces(ctx, db, writeOnly)
+	var pathErr *os.PathError
+	if !errors.As(err, &pathErr) {
+		t.Errorf("DatabaseAdmin.ImportNamespaces of an unreadable file err = %v, want *os.PathError", err)
+	}
+
 	const methodName = "ImportNamespaces"
 	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
 		got, resp, err := client.DatabaseAdmin.ImportNamespaces(nil, db, rdf)
@@ -845,6 +1128,249 @@ func TestDatabaseAdminService_ImportNamespaces(t *testing.T) {
 	}
 }
 
+func TestDatabaseAdminService_ImportNamespacesFromReader(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	rdf := "@prefix schema: <http://schema.org/> .\nschema:a schema:b schema:c .\n"
+	mux.HandleFunc(fmt.Sprintf("/%s/namespaces", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", RDFFormatTurtle.String())
+		testHeader(t, r, "Accept", mediaTypeApplicationJSON)
+		testBody(t, r, rdf)
+		w.Write([]byte(`{"numImportedNamespaces": 1, "namespaces": ["schema=http://schema.org/"]}`))
+	})
+
+	ctx := context.Background()
+	got, _, err := client.DatabaseAdmin.ImportNamespacesFromReader(ctx, db, strings.NewReader(rdf), RDFFormatTurtle)
+	if err != nil {
+		t.Errorf("DatabaseAdmin.ImportNamespacesFromReader returned error: %v", err)
+	}
+	want := &ImportNamespacesResponse{NumberImportedNamespaces: 1, UpdatedNamespaces: []string{"schema=http://schema.org/"}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("DatabaseAdmin.ImportNamespacesFromReader = %+v, want %+v", got, want)
+	}
+
+	const methodName = "ImportNamespacesFromReader"
+	testBadOptions(t, methodName, func() error {
+		_, _, err := client.DatabaseAdmin.ImportNamespacesFromReader(ctx, db, strings.NewReader(rdf), RDFFormatUnknown)
+		return err
+	})
+	testBadOptions(t, methodName, func() error {
+		_, _, err := client.DatabaseAdmin.ImportNamespacesFromReader(ctx, db, nil, RDFFormatTurtle)
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.DatabaseAdmin.ImportNamespacesFromReader(nil, db, strings.NewReader(rdf), RDFFormatTurtle)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestDatabaseAdminService_ImportNamespaces_compressed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	schema, err := os.ReadFile("./test-resources/music_schema.ttl")
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.ImportNamespaces: unexpected error during test: %v", err)
+	}
+	var gzipped bytes.Buffer
+	gz := gzip.NewWriter(&gzipped)
+	gz.Write(schema)
+	gz.Close()
+
+	dir := t.TempDir()
+	writeFile := func(name string, data []byte) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, data, 0o600); err != nil {
+			t.Fatalf("DatabaseAdmin.ImportNamespaces: unexpected error during test: %v", err)
+		}
+		return path
+	}
+
+	tests := []struct {
+		name         string
+		path         string
+		wantEncoding string
+		wantBody     []byte
+	}{
+		{name: "gzip extension", path: writeFile("schema.ttl.gz", gzipped.Bytes()), wantEncoding: "gzip", wantBody: gzipped.Bytes()},
+		{name: "gzip contents", path: writeFile("schema.ttl", gzipped.Bytes()), wantEncoding: "gzip", wantBody: gzipped.Bytes()},
+		{name: "bzip2", path: "./test-resources/music_schema.ttl.bz2", wantEncoding: "", wantBody: schema},
+		{name: "uncompressed", path: "./test-resources/music_schema.ttl", wantEncoding: "", wantBody: schema},
+		{name: "no extension", path: writeFile("upload-1234", schema), wantEncoding: "", wantBody: schema},
+		{name: "gzipped without extension", path: writeFile("upload-5678", gzipped.Bytes()), wantEncoding: "gzip", wantBody: gzipped.Bytes()},
+	}
+
+	var gotEncoding string
+	var gotBody []byte
+	mux.HandleFunc("/db1/namespaces", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", RDFFormatTurtle.String())
+		gotEncoding = r.Header.Get("Content-Encoding")
+		gotBody, _ = io.ReadAll(r.Body)
+		w.Write([]byte(`{"numImportedNamespaces": 0, "namespaces": []}`))
+	})
+
+	ctx := context.Background()
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			file, err := os.Open(tc.path)
+			if err != nil {
+				t.Fatalf("unexpected error opening %s: %v", tc.path, err)
+			}
+			defer file.Close()
+			if _, _, err := client.DatabaseAdmin.ImportNamespaces(ctx, "db1", file); err != nil {
+				t.Fatalf("DatabaseAdmin.ImportNamespaces returned error: %v", err)
+			}
+			if gotEncoding != tc.wantEncoding {
+				t.Errorf("Content-Encoding = %q, want %q", gotEncoding, tc.wantEncoding)
+			}
+			if !bytes.Equal(gotBody, tc.wantBody) {
+				t.Errorf("request body is %d bytes, want %d", len(gotBody), len(tc.wantBody))
+			}
+		})
+	}
+
+	zipped, err := os.Open(writeFile("schema.ttl.zip", []byte("PK\x03\x04")))
+	if err != nil {
+		t.Fatalf("unexpected error opening zip: %v", err)
+	}
+	defer zipped.Close()
+	_, _, err = client.DatabaseAdmin.ImportNamespaces(ctx, "db1", zipped)
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Errorf("DatabaseAdmin.ImportNamespaces with a ZIP archive error = %v, want a *ValidationError", err)
+	}
+}
+
+func TestDatabaseAdminService_SetNamespaces(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	mux.HandleFunc(fmt.Sprintf("/admin/databases/%s/options", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", mediaTypeApplicationJSON)
+		testBody(t, r, `{"database.namespaces":["=http://stardog.com/tutorial/","schema=http://schema.org/"]}`+"\n")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := context.Background()
+	namespaces := []Namespace{
+		{Prefix: "", Name: "http://stardog.com/tutorial/"},
+		{Prefix: "schema", Name: "http://schema.org/"},
+	}
+	if _, err := client.DatabaseAdmin.SetNamespaces(ctx, db, namespaces); err != nil {
+		t.Errorf("DatabaseAdmin.SetNamespaces returned error: %v", err)
+	}
+
+	testBadOptions(t, "SetNamespaces", func() error {
+		_, err := client.DatabaseAdmin.SetNamespaces(ctx, db, []Namespace{{Prefix: "bad:prefix", Name: "http://schema.org/"}})
+		return err
+	})
+	testBadOptions(t, "SetNamespaces", func() error {
+		_, err := client.DatabaseAdmin.SetNamespaces(ctx, db, []Namespace{{Prefix: "schema"}})
+		return err
+	})
+}
+
+// handleNamespaceOptions serves the namespaces of db, recording the namespaces option set on it.
+func handleNamespaceOptions(t *testing.T, mux *http.ServeMux, db string, namespacesJSON string, set *map[string]any) {
+	t.Helper()
+	mux.HandleFunc(fmt.Sprintf("/%s/namespaces", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(namespacesJSON))
+	})
+	mux.HandleFunc(fmt.Sprintf("/admin/databases/%s/options", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if err := json.NewDecoder(r.Body).Decode(set); err != nil {
+			t.Errorf("unable to decode options: %v", err)
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func TestDatabaseAdminService_AddNamespace(t *testing.T) {
+	namespacesJSON := `{"namespaces": [{"prefix": "", "name": "http://stardog.com/tutorial/"}, {"prefix": "schema", "name": "http://schema.org/"}]}`
+	tests := []struct {
+		name   string
+		prefix string
+		iri    string
+		want   []any
+	}{
+		{
+			name:   "new prefix",
+			prefix: "foaf",
+			iri:    "http://xmlns.com/foaf/0.1/",
+			want:   []any{"=http://stardog.com/tutorial/", "schema=http://schema.org/", "foaf=http://xmlns.com/foaf/0.1/"},
+		},
+		{
+			name:   "existing prefix",
+			prefix: "schema",
+			iri:    "https://schema.org/",
+			want:   []any{"=http://stardog.com/tutorial/", "schema=https://schema.org/"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			db := "db1"
+			var set map[string]any
+			handleNamespaceOptions(t, mux, db, namespacesJSON, &set)
+
+			ctx := context.Background()
+			if _, err := client.DatabaseAdmin.AddNamespace(ctx, db, tt.prefix, tt.iri); err != nil {
+				t.Errorf("DatabaseAdmin.AddNamespace returned error: %v", err)
+			}
+			if want := map[string]any{"database.namespaces": tt.want}; !cmp.Equal(set, want) {
+				t.Errorf("DatabaseAdmin.AddNamespace set options %v, want %v", set, want)
+			}
+		})
+	}
+
+	client, _, _, teardown := setup()
+	defer teardown()
+	testBadOptions(t, "AddNamespace", func() error {
+		_, err := client.DatabaseAdmin.AddNamespace(context.Background(), "db1", "foaf", "")
+		return err
+	})
+}
+
+func TestDatabaseAdminService_RemoveNamespace(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	namespacesJSON := `{"namespaces": [{"prefix": "", "name": "http://stardog.com/tutorial/"}, {"prefix": "schema", "name": "http://schema.org/"}]}`
+	var set map[string]any
+	handleNamespaceOptions(t, mux, db, namespacesJSON, &set)
+
+	ctx := context.Background()
+	if _, err := client.DatabaseAdmin.RemoveNamespace(ctx, db, "schema"); err != nil {
+		t.Errorf("DatabaseAdmin.RemoveNamespace returned error: %v", err)
+	}
+	if want := map[string]any{"database.namespaces": []any{"=http://stardog.com/tutorial/"}}; !cmp.Equal(set, want) {
+		t.Errorf("DatabaseAdmin.RemoveNamespace set options %v, want %v", set, want)
+	}
+
+	// removing an undeclared prefix doesn't set the option
+	set = nil
+	if _, err := client.DatabaseAdmin.RemoveNamespace(ctx, db, "foaf"); err != nil {
+		t.Errorf("DatabaseAdmin.RemoveNamespace returned error: %v", err)
+	}
+	if set != nil {
+		t.Errorf("DatabaseAdmin.RemoveNamespace set options %v for an undeclared prefix", set)
+	}
+}
+
 func TestDatabaseAdminService_Metadata(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -1218,3 +1744,198 @@ func TestDatabaseAdminService_Size_nonIntegerResponse(t *testing.T) {
 		t.Fatalf("DatabaseAdmin.Size should return an error if response cannot be converted to an integer")
 	}
 }
+
+func TestDatabaseAdminService_IterateWithMetadata(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/databases/options", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeApplicationJSON)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"other": {"ignored": [1, 2]}, "databases": [{"database.name": "db1"}, {"database.name": "db2"}]}`))
+	})
+
+	ctx := context.Background()
+	iter, _, err := client.DatabaseAdmin.IterateWithMetadata(ctx)
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.IterateWithMetadata returned error: %v", err)
+	}
+	defer iter.Close()
+
+	var got []string
+	for iter.Next() {
+		got = append(got, iter.Metadata()["database.name"].(string))
+	}
+	if err := iter.Err(); err != nil {
+		t.Errorf("DatabaseMetadataIterator.Err = %v, want nil", err)
+	}
+	if want := []string{"db1", "db2"}; !cmp.Equal(got, want) {
+		t.Errorf("DatabaseAdmin.IterateWithMetadata databases = %+v, want %+v", got, want)
+	}
+	if iter.Next() {
+		t.Errorf("DatabaseMetadataIterator.Next after exhaustion = true, want false")
+	}
+	if err := iter.Close(); err != nil {
+		t.Errorf("DatabaseMetadataIterator.Close returned error: %v", err)
+	}
+	if err := iter.Close(); err != nil {
+		t.Errorf("second DatabaseMetadataIterator.Close returned error: %v", err)
+	}
+
+	const methodName = "IterateWithMetadata"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.DatabaseAdmin.IterateWithMetadata(nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestDatabaseAdminService_ListWithMetadata_emptyBody(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/databases/options", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	got, _, err := client.DatabaseAdmin.ListWithMetadata(context.Background())
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.ListWithMetadata returned error: %v", err)
+	}
+	if want := []map[string]any{}; !cmp.Equal(got, want) {
+		t.Errorf("DatabaseAdmin.ListWithMetadata = %#v, want %#v", got, want)
+	}
+}
+
+func TestDatabaseAdminService_IterateWithMetadata_malformedResponse(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "not an object", body: `[]`},
+		{name: "missing databases", body: `{"other": 1}`},
+		{name: "databases not an array", body: `{"databases": {}}`},
+		{name: "truncated", body: `{"databases": [{"database.name": "db1"}, {"database.na`},
+	}
+
+	for _, test := range tests {
+		client, mux, _, teardown := setup()
+		mux.HandleFunc("/admin/databases/options", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(test.body))
+		})
+
+		ctx := context.Background()
+		iter, _, err := client.DatabaseAdmin.IterateWithMetadata(ctx)
+		if err != nil {
+			t.Fatalf("%s: DatabaseAdmin.IterateWithMetadata returned error: %v", test.name, err)
+		}
+		for iter.Next() {
+		}
+		if iter.Err() == nil {
+			t.Errorf("%s: DatabaseMetadataIterator.Err = nil, want error", test.name)
+		}
+		iter.Close()
+
+		got, _, err := client.DatabaseAdmin.ListWithMetadata(ctx)
+		if err == nil {
+			t.Errorf("%s: DatabaseAdmin.ListWithMetadata err = nil, want error", test.name)
+		}
+		if got != nil {
+			t.Errorf("%s: DatabaseAdmin.ListWithMetadata = %#v, want nil", test.name, got)
+		}
+		teardown()
+	}
+}
+
+func TestDatabaseAdminService_IterateWithMetadata_errorResponse(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/databases/options", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"message": "forbidden", "code": "0D0DU2"}`))
+	})
+
+	iter, resp, err := client.DatabaseAdmin.IterateWithMetadata(context.Background())
+	if err == nil {
+		t.Errorf("DatabaseAdmin.IterateWithMetadata err = nil, want error")
+	}
+	if iter != nil {
+		t.Errorf("DatabaseAdmin.IterateWithMetadata = %#v, want nil", iter)
+	}
+	if resp == nil || resp.StatusCode != http.StatusForbidden {
+		t.Errorf("DatabaseAdmin.IterateWithMetadata resp = %#v, want status %d", resp, http.StatusForbidden)
+	}
+}
+
+func TestDatabaseAdminService_PublicMetadata(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	mux.HandleFunc(fmt.Sprintf("/%s/namespaces", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"namespaces":[{"prefix":"rdf","name":"http://www.w3.org/1999/02/22-rdf-syntax-ns#"}]}`))
+	})
+	mux.HandleFunc(fmt.Sprintf("/%s/size", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("42"))
+	})
+
+	ctx := context.Background()
+	got, resp, err := client.DatabaseAdmin.PublicMetadata(ctx, db)
+	if err != nil {
+		t.Errorf("DatabaseAdmin.PublicMetadata returned error: %v", err)
+	}
+	if resp == nil || string(resp.RawBody) != "42" {
+		t.Errorf("DatabaseAdmin.PublicMetadata resp = %#v, want the response of the last request", resp)
+	}
+	want := &PublicDatabaseMetadata{
+		Database:   db,
+		Namespaces: []Namespace{{Prefix: "rdf", Name: "http://www.w3.org/1999/02/22-rdf-syntax-ns#"}},
+		Size:       newInt(42),
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("DatabaseAdmin.PublicMetadata = %+v, want %+v", got, want)
+	}
+
+	const methodName = "PublicMetadata"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.DatabaseAdmin.PublicMetadata(ctx, "")
+		return err
+	})
+}
+
+func TestDatabaseAdminService_PublicMetadata_partial(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	mux.HandleFunc(fmt.Sprintf("/%s/namespaces", db), func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"namespaces":[]}`))
+	})
+	mux.HandleFunc(fmt.Sprintf("/%s/size", db), func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"message":"Permission denied","code":"0D0DU2"}`))
+	})
+
+	got, _, err := client.DatabaseAdmin.PublicMetadata(context.Background(), db)
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) || errorResponse.Response.StatusCode != http.StatusForbidden {
+		t.Errorf("DatabaseAdmin.PublicMetadata error = %v, want *ErrorResponse with status %d", err, http.StatusForbidden)
+	}
+	want := &PublicDatabaseMetadata{
+		Database:   db,
+		Namespaces: []Namespace{},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("DatabaseAdmin.PublicMetadata = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,144 @@
+package stardog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// CopyDatabaseOptions specifies the optional parameters to the [DatabaseAdminService.Copy] method.
+type CopyDatabaseOptions struct {
+	// Replace the target database if it exists. If false, copying to an existing database fails.
+	Overwrite bool
+	// Take the source database offline (see [DatabaseAdminService.Offline]) before copying it if it's online,
+	// since only offline databases can be copied, and bring it back online once it's copied, even if copying fails.
+	TakeOffline bool
+}
+
+// Copy copies the database to a new database named target, e.g. to refresh a staging database from a
+// snapshot of production. The source database must be offline, unless CopyDatabaseOptions.TakeOffline is set:
+// if it's online, an error wrapping [ErrDatabaseOnline] is returned without copying it.
+//
+// With CopyDatabaseOptions.Overwrite, an existing target is only replaced once the copy has succeeded: the
+// database is copied to a temporary database, the target is renamed out of the way, the copy is renamed to the
+// target and only then is the old target dropped. If renaming the copy fails, the old target is restored.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/copyDatabase
+func (s *DatabaseAdminService) Copy(ctx context.Context, database string, target string, opts *CopyDatabaseOptions) (resp *Response, err error) {
+	if err := firstError(validateDatabaseName(database), validateDatabaseName(target)); err != nil {
+		return nil, err
+	}
+	if target == database {
+		return nil, &ValidationError{Field: "target", Value: target, Constraint: "must not be the database being copied"}
+	}
+	if ctx == nil {
+		return nil, errNonNilContext
+	}
+
+	online, resp, err := s.isOnline(ctx, database)
+	if err != nil {
+		return resp, err
+	}
+	if online {
+		if opts == nil || !opts.TakeOffline {
+			return resp, fmt.Errorf("copying %s: %w", database, ErrDatabaseOnline)
+		}
+		if resp, err := s.Offline(ctx, database); err != nil {
+			return resp, err
+		}
+		defer func() {
+			// bring the database back online even if ctx is done, so it isn't left unavailable
+			if _, onlineErr := s.Online(context.Background(), database); onlineErr != nil {
+				err = errors.Join(err, fmt.Errorf("bringing %s back online: %w", database, onlineErr))
+			}
+		}()
+	}
+
+	if opts == nil || !opts.Overwrite {
+		return s.copy(ctx, database, target)
+	}
+	databases, resp, err := s.ListDatabases(ctx)
+	if err != nil {
+		return resp, err
+	}
+	if indexOf(databases, target) < 0 {
+		return s.copy(ctx, database, target)
+	}
+
+	temporary := temporaryDatabaseName("copy")
+	if resp, err := s.copy(ctx, database, temporary); err != nil {
+		return resp, err
+	}
+	// move the target aside rather than dropping it, so it can be restored if renaming the copy fails
+	backup := temporaryDatabaseName("backup")
+	if resp, err := s.renameDatabase(ctx, target, backup); err != nil {
+		if _, dropErr := s.Drop(context.Background(), temporary); dropErr != nil {
+			err = errors.Join(err, fmt.Errorf("dropping %s: %w", temporary, dropErr))
+		}
+		return resp, err
+	}
+	if resp, err := s.renameDatabase(ctx, temporary, target); err != nil {
+		// restore the target even if ctx is done, so it isn't left under the backup name
+		if _, restoreErr := s.renameDatabase(context.Background(), backup, target); restoreErr != nil {
+			return resp, errors.Join(err, fmt.Errorf("restoring %s: %w", target, restoreErr))
+		}
+		if _, dropErr := s.Drop(context.Background(), temporary); dropErr != nil {
+			err = errors.Join(err, fmt.Errorf("dropping %s: %w", temporary, dropErr))
+		}
+		return resp, err
+	}
+	if resp, err := s.Drop(ctx, backup); err != nil {
+		return resp, fmt.Errorf("dropping %s, the replaced %s: %w", backup, target, err)
+	}
+	return resp, nil
+}
+
+// temporaryDatabaseName returns a unique name for a database that only exists while Copy runs. The name doesn't
+// include the target's, so it can't exceed the server's limit on the length of database names.
+func temporaryDatabaseName(prefix string) string {
+	return prefix + "_" + strconv.FormatInt(time.Now().UnixNano(), 36)
+}
+
+// copy copies the offline database to target, which must not exist.
+func (s *DatabaseAdminService) copy(ctx context.Context, database string, target string) (*Response, error) {
+	u := fmt.Sprintf("admin/databases/%s/copy?to=%s", database, url.QueryEscape(target))
+	reqHeaderOpts := &requestHeaderOptions{
+		Accept: mediaTypeApplicationJSON,
+	}
+	req, err := s.client.NewRequest(http.MethodPut, u, reqHeaderOpts, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// renameDatabase renames the database to target, taking it offline to rename it if it's online and bringing
+// it back online afterwards, or if renaming it fails.
+func (s *DatabaseAdminService) renameDatabase(ctx context.Context, database string, target string) (*Response, error) {
+	online, resp, err := s.isOnline(ctx, database)
+	if err != nil {
+		return resp, fmt.Errorf("renaming %s to %s: %w", database, target, err)
+	}
+	if online {
+		if resp, err := s.Offline(ctx, database); err != nil {
+			return resp, fmt.Errorf("renaming %s to %s: %w", database, target, err)
+		}
+	}
+	if resp, err := s.Rename(ctx, database, target); err != nil {
+		err = fmt.Errorf("renaming %s to %s: %w", database, target, err)
+		if online {
+			if _, onlineErr := s.Online(context.Background(), database); onlineErr != nil {
+				err = errors.Join(err, fmt.Errorf("bringing %s back online: %w", database, onlineErr))
+			}
+		}
+		return resp, err
+	}
+	if online {
+		return s.Online(ctx, target)
+	}
+	return resp, nil
+}
@@ -0,0 +1,204 @@
+package stardog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// copyTestServer handles the database admin endpoints used by Copy, recording the calls made, with temporary
+// database names replaced by "tmp" and "backup". Copies and renames whose recorded call is in fail fail.
+type copyTestServer struct {
+	t      *testing.T
+	online map[string]bool
+	calls  []string
+	fail   map[string]bool
+}
+
+func (s *copyTestServer) name(database string) string {
+	switch {
+	case strings.HasPrefix(database, "copy_"):
+		return "tmp"
+	case strings.HasPrefix(database, "backup_"):
+		return "backup"
+	}
+	return database
+}
+
+func (s *copyTestServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/admin/databases")
+	if path == "" {
+		var databases []string
+		for database := range s.online {
+			databases = append(databases, database)
+		}
+		sort.Strings(databases)
+		json.NewEncoder(w).Encode(map[string]any{"databases": databases})
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	database := parts[0]
+	action := "drop"
+	if len(parts) > 1 {
+		action = parts[1]
+	}
+	if _, ok := s.online[database]; !ok {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "no such database"}`))
+		return
+	}
+	switch action {
+	case "options":
+		json.NewEncoder(w).Encode(map[string]any{databaseOnlineOption: s.online[database]})
+		return
+	case "copy", "rename":
+		to := r.URL.Query().Get("to")
+		call := action + " " + s.name(database) + " to " + s.name(to)
+		s.calls = append(s.calls, call)
+		if s.fail[call] {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"message": "unable to copy"}`))
+			return
+		}
+		s.online[to] = false
+		if action == "rename" {
+			delete(s.online, database)
+		}
+		return
+	case "drop":
+		testMethod(s.t, r, "DELETE")
+		delete(s.online, database)
+	case "online", "offline":
+		s.online[database] = action == "online"
+	}
+	s.calls = append(s.calls, action+" "+s.name(database))
+}
+
+func TestDatabaseAdminService_Copy(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	server := &copyTestServer{t: t, online: map[string]bool{"prod": false}}
+	mux.Handle("/admin/databases", server)
+	mux.Handle("/admin/databases/", server)
+
+	ctx := context.Background()
+	if _, err := client.DatabaseAdmin.Copy(ctx, "prod", "staging", nil); err != nil {
+		t.Fatalf("DatabaseAdmin.Copy returned error: %v", err)
+	}
+	if want := []string{"copy prod to staging"}; !cmp.Equal(server.calls, want) {
+		t.Errorf("calls = %v, want %v", server.calls, want)
+	}
+
+	const methodName = "Copy"
+	testBadOptions(t, methodName, func() error {
+		_, err := client.DatabaseAdmin.Copy(ctx, "prod", "prod", nil)
+		return err
+	})
+	testBadOptions(t, methodName, func() error {
+		_, err := client.DatabaseAdmin.Copy(ctx, "prod", "", nil)
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.DatabaseAdmin.Copy(nil, "prod", "staging", nil)
+	})
+}
+
+func TestDatabaseAdminService_Copy_online(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	server := &copyTestServer{t: t, online: map[string]bool{"prod": true, "staging": true}}
+	mux.Handle("/admin/databases", server)
+	mux.Handle("/admin/databases/", server)
+
+	// nothing is dropped or copied if the source is online and TakeOffline isn't set
+	_, err := client.DatabaseAdmin.Copy(context.Background(), "prod", "staging", &CopyDatabaseOptions{Overwrite: true})
+	if !errors.Is(err, ErrDatabaseOnline) {
+		t.Errorf("DatabaseAdmin.Copy error = %v, want %v", err, ErrDatabaseOnline)
+	}
+	if len(server.calls) != 0 {
+		t.Errorf("calls = %v, want none", server.calls)
+	}
+}
+
+func TestDatabaseAdminService_Copy_overwriteOffline(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	server := &copyTestServer{t: t, online: map[string]bool{"prod": true, "staging": true}, fail: map[string]bool{"copy prod to broken": true}}
+	mux.Handle("/admin/databases", server)
+	mux.Handle("/admin/databases/", server)
+
+	ctx := context.Background()
+	opts := &CopyDatabaseOptions{Overwrite: true, TakeOffline: true}
+	if _, err := client.DatabaseAdmin.Copy(ctx, "prod", "staging", opts); err != nil {
+		t.Fatalf("DatabaseAdmin.Copy returned error: %v", err)
+	}
+	want := []string{"offline prod", "copy prod to tmp", "offline staging", "rename staging to backup", "online backup", "rename tmp to staging", "drop backup", "online prod"}
+	if !cmp.Equal(server.calls, want) {
+		t.Errorf("calls = %v, want %v", server.calls, want)
+	}
+	if want := map[string]bool{"prod": true, "staging": false}; !cmp.Equal(server.online, want) {
+		t.Errorf("databases = %v, want %v", server.online, want)
+	}
+
+	// the source is brought back online even if the copy fails
+	server.calls = nil
+	if _, err := client.DatabaseAdmin.Copy(ctx, "prod", "broken", opts); err == nil {
+		t.Error("DatabaseAdmin.Copy should return the error copying the database")
+	}
+	want = []string{"offline prod", "copy prod to broken", "online prod"}
+	if !cmp.Equal(server.calls, want) {
+		t.Errorf("calls = %v, want %v", server.calls, want)
+	}
+}
+
+func TestDatabaseAdminService_Copy_alreadyOffline(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	server := &copyTestServer{t: t, online: map[string]bool{"prod": false}}
+	mux.Handle("/admin/databases", server)
+	mux.Handle("/admin/databases/", server)
+
+	// a source that was already offline is left offline
+	opts := &CopyDatabaseOptions{TakeOffline: true}
+	if _, err := client.DatabaseAdmin.Copy(context.Background(), "prod", "staging", opts); err != nil {
+		t.Fatalf("DatabaseAdmin.Copy returned error: %v", err)
+	}
+	if want := []string{"copy prod to staging"}; !cmp.Equal(server.calls, want) {
+		t.Errorf("calls = %v, want %v", server.calls, want)
+	}
+	if server.online["prod"] {
+		t.Error("DatabaseAdmin.Copy brought a database that was offline online")
+	}
+}
+
+func TestDatabaseAdminService_Copy_overwriteRenameFails(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	server := &copyTestServer{t: t, online: map[string]bool{"prod": false, "staging": true}, fail: map[string]bool{"rename tmp to staging": true}}
+	mux.Handle("/admin/databases", server)
+	mux.Handle("/admin/databases/", server)
+
+	// the original target is restored, online as it was, and the copy dropped
+	opts := &CopyDatabaseOptions{Overwrite: true}
+	if _, err := client.DatabaseAdmin.Copy(context.Background(), "prod", "staging", opts); err == nil {
+		t.Error("DatabaseAdmin.Copy should return the error renaming the copy")
+	}
+	want := []string{"copy prod to tmp", "offline staging", "rename staging to backup", "online backup", "rename tmp to staging", "offline backup", "rename backup to staging", "online staging", "drop tmp"}
+	if !cmp.Equal(server.calls, want) {
+		t.Errorf("calls = %v, want %v", server.calls, want)
+	}
+	if want := map[string]bool{"prod": false, "staging": true}; !cmp.Equal(server.online, want) {
+		t.Errorf("databases = %v, want %v", server.online, want)
+	}
+}
@@ -0,0 +1,203 @@
+package stardog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DiffOptions specifies the optional parameters to [DiffDatabases].
+type DiffOptions struct {
+	// The graphs to compare. If empty, the default graph and every named graph in either database are compared.
+	NamedGraphs []string
+
+	// Only compare the number of triples in each graph, skipping the more expensive checksums
+	CountsOnly bool
+
+	// Progress, if not nil, is called after each graph is compared.
+	Progress func(DiffProgress)
+}
+
+// DiffProgress reports the progress of [DiffDatabases].
+type DiffProgress struct {
+	// The graph that was just compared
+	NamedGraph string
+	// The number of graphs compared so far
+	Compared int
+	// The total number of graphs to compare
+	Total int
+}
+
+// GraphDiff is the comparison of a graph in two databases.
+type GraphDiff struct {
+	// The graph that was compared
+	NamedGraph string
+	// The number of triples in the graph in each database
+	SourceCount int64
+	TargetCount int64
+	// A checksum of the triples in the graph in each database. Empty if DiffOptions.CountsOnly was set.
+	SourceChecksum string
+	TargetChecksum string
+}
+
+// Equal reports whether the graph has the same number of triples, and the same checksum, in both databases.
+func (d GraphDiff) Equal() bool {
+	return d.SourceCount == d.TargetCount && d.SourceChecksum == d.TargetChecksum
+}
+
+// DatabaseDiff is the result of [DiffDatabases].
+type DatabaseDiff struct {
+	// The comparison of every graph, ordered by graph
+	Graphs []GraphDiff
+}
+
+// Equal reports whether every graph is the same in both databases.
+func (d *DatabaseDiff) Equal() bool {
+	return len(d.Differences()) == 0
+}
+
+// Differences returns the graphs that aren't the same in both databases.
+func (d *DatabaseDiff) Differences() []GraphDiff {
+	var differences []GraphDiff
+	for _, graph := range d.Graphs {
+		if !graph.Equal() {
+			differences = append(differences, graph)
+		}
+	}
+	return differences
+}
+
+// graphCountQuery counts the triples in a graph.
+const graphCountQuery = `SELECT (COUNT(*) AS ?count) { GRAPH %s { ?s ?p ?o } }`
+
+// graphChecksumQuery counts the triples in a graph and computes a checksum from an MD5 hash of each triple:
+// the sums of two 32-bit slices of the hashes, which don't depend on the order the triples are read in and
+// stay small however large the graph is. Blank node labels aren't preserved by exports and restores, so every
+// blank node hashes the same, and a literal's datatype and language tag are part of its hash.
+var graphChecksumQuery = `SELECT (COUNT(*) AS ?count) (CONCAT(STR(SUM(?high)), "-", STR(SUM(?low))) AS ?checksum) {
+  GRAPH %s { ?s ?p ?o }
+  BIND(MD5(CONCAT(
+    IF(isBlank(?s), "_:", STR(?s)), " ",
+    STR(?p), " ",
+    IF(isBlank(?o), "_:", CONCAT(STR(?o), "^^", COALESCE(STR(DATATYPE(?o)), ""), "@", COALESCE(LANG(?o), "")))
+  )) AS ?hash)
+  BIND(` + hexValue("?hash", 1) + ` AS ?high)
+  BIND(` + hexValue("?hash", 9) + ` AS ?low)
+}`
+
+// hexValue returns a SPARQL expression for the integer value of the 8 lowercase hex digits of the variable
+// starting at the 1-based index start, since SPARQL has no function for parsing hex.
+func hexValue(variable string, start int) string {
+	terms := make([]string, 8)
+	for i := range terms {
+		digit := fmt.Sprintf(`STRLEN(STRBEFORE("0123456789abcdef", SUBSTR(%s, %d, 1)))`, variable, start+i)
+		terms[i] = fmt.Sprintf("%s * %d", digit, 1<<(4*(len(terms)-1-i)))
+	}
+	return "(" + strings.Join(terms, " + ") + ")"
+}
+
+// DiffDatabases compares the source database on the source server with the target database on the
+// target server, graph by graph, to validate a migration or restore. The number of triples in each
+// graph is compared and, unless DiffOptions.CountsOnly is set, a checksum of the graph's triples
+// computed by the server. The source and target may be the same client to compare two databases on
+// one server.
+//
+// Checksums treat all blank nodes as equal, since their labels change when data is copied, so graphs that
+// differ only in which blank nodes their triples use aren't reported as different. Reasoning is disabled
+// for the comparison, whatever the clients' DefaultReasoning, so only stored triples are compared.
+func DiffDatabases(ctx context.Context, source *Client, sourceDatabase string, target *Client, targetDatabase string, opts *DiffOptions) (*DatabaseDiff, error) {
+	if err := validateNotEmpty("sourceDatabase", sourceDatabase); err != nil {
+		return nil, err
+	}
+	if err := validateNotEmpty("targetDatabase", targetDatabase); err != nil {
+		return nil, err
+	}
+	if source == nil {
+		return nil, &ValidationError{Field: "source", Value: source, Constraint: "must not be nil"}
+	}
+	if target == nil {
+		return nil, &ValidationError{Field: "target", Value: target, Constraint: "must not be nil"}
+	}
+	if ctx == nil {
+		return nil, errNonNilContext
+	}
+	if opts == nil {
+		opts = &DiffOptions{}
+	}
+	ctx = WithReasoning(ctx, false)
+
+	graphs := opts.NamedGraphs
+	if len(graphs) == 0 {
+		var err error
+		graphs, err = diffGraphs(ctx, source, sourceDatabase, target, targetDatabase)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	diff := &DatabaseDiff{Graphs: make([]GraphDiff, 0, len(graphs))}
+	for i, graph := range graphs {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		graphDiff := GraphDiff{NamedGraph: graph}
+		var err error
+		graphDiff.SourceCount, graphDiff.SourceChecksum, err = summarizeGraph(ctx, source, sourceDatabase, graph, opts.CountsOnly)
+		if err != nil {
+			return nil, fmt.Errorf("summarizing graph %s in %s: %w", graph, sourceDatabase, err)
+		}
+		graphDiff.TargetCount, graphDiff.TargetChecksum, err = summarizeGraph(ctx, target, targetDatabase, graph, opts.CountsOnly)
+		if err != nil {
+			return nil, fmt.Errorf("summarizing graph %s in %s: %w", graph, targetDatabase, err)
+		}
+		diff.Graphs = append(diff.Graphs, graphDiff)
+		if opts.Progress != nil {
+			opts.Progress(DiffProgress{NamedGraph: graph, Compared: i + 1, Total: len(graphs)})
+		}
+	}
+	return diff, nil
+}
+
+// diffGraphs returns the default graph followed by the sorted named graphs in either database.
+func diffGraphs(ctx context.Context, source *Client, sourceDatabase string, target *Client, targetDatabase string) ([]string, error) {
+	sourceGraphs, err := source.DatabaseAdmin.listNamedGraphs(ctx, sourceDatabase)
+	if err != nil {
+		return nil, err
+	}
+	targetGraphs, err := target.DatabaseAdmin.listNamedGraphs(ctx, targetDatabase)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	var namedGraphs []string
+	for _, graph := range append(sourceGraphs, targetGraphs...) {
+		if !seen[graph] {
+			seen[graph] = true
+			namedGraphs = append(namedGraphs, graph)
+		}
+	}
+	sort.Strings(namedGraphs)
+	return append([]string{defaultGraph}, namedGraphs...), nil
+}
+
+// summarizeGraph returns the number of triples in the graph and, unless countOnly is set, its checksum.
+func summarizeGraph(ctx context.Context, client *Client, database string, graph string, countOnly bool) (int64, string, error) {
+	query := graphChecksumQuery
+	if countOnly {
+		query = graphCountQuery
+	}
+	results, _, err := client.Sparql.SelectResultSet(ctx, database, fmt.Sprintf(query, quoteIRI(graph)), nil)
+	if err != nil {
+		return 0, "", err
+	}
+	if len(results.Bindings) != 1 {
+		return 0, "", fmt.Errorf("expected 1 result, got %d", len(results.Bindings))
+	}
+	count, err := strconv.ParseInt(results.Bindings[0]["count"].Value, 10, 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid triple count: %w", err)
+	}
+	return count, results.Bindings[0]["checksum"].Value, nil
+}
@@ -0,0 +1,141 @@
+package stardog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// handleDiffQueries serves the queries DiffDatabases sends to a database, with the given named graphs and
+// a count and checksum per graph.
+func handleDiffQueries(t *testing.T, mux *http.ServeMux, db string, namedGraphs []string, summaries map[string][2]string) {
+	mux.HandleFunc(fmt.Sprintf("/%s/query", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Has("reasoning") {
+			t.Errorf("DiffDatabases query sent with reasoning: %s", r.URL)
+		}
+		query := r.URL.Query().Get("query")
+		if strings.HasPrefix(query, "SELECT DISTINCT ?g") {
+			var bindings []string
+			for _, g := range namedGraphs {
+				bindings = append(bindings, fmt.Sprintf(`{"g":{"type":"uri","value":%q}}`, g))
+			}
+			fmt.Fprintf(w, `{"head":{"vars":["g"]},"results":{"bindings":[%s]}}`, strings.Join(bindings, ","))
+			return
+		}
+		for graph, summary := range summaries {
+			if strings.Contains(query, "<"+graph+">") {
+				fmt.Fprintf(w, `{"head":{"vars":["count","checksum"]},"results":{"bindings":[{
+					"count":{"type":"literal","value":%q,"datatype":"http://www.w3.org/2001/XMLSchema#integer"},
+					"checksum":{"type":"literal","value":%q}}]}}`, summary[0], summary[1])
+				return
+			}
+		}
+		fmt.Fprint(w, `{"head":{"vars":["count","checksum"]},"results":{"bindings":[{
+			"count":{"type":"literal","value":"0"},"checksum":{"type":"literal","value":"0-0"}}]}}`)
+	})
+}
+
+func TestDiffDatabases(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.DefaultReasoning = true
+
+	handleDiffQueries(t, mux, "source", []string{"urn:g:b", "urn:g:a"}, map[string][2]string{
+		defaultGraph: {"10", "aaa"},
+		"urn:g:a":    {"5", "bbb"},
+		"urn:g:b":    {"3", "ccc"},
+	})
+	handleDiffQueries(t, mux, "target", []string{"urn:g:a", "urn:g:c"}, map[string][2]string{
+		defaultGraph: {"10", "aaa"},
+		"urn:g:a":    {"5", "xxx"},
+		"urn:g:c":    {"1", "ddd"},
+	})
+
+	var progress []DiffProgress
+	opts := &DiffOptions{Progress: func(p DiffProgress) { progress = append(progress, p) }}
+	diff, err := DiffDatabases(context.Background(), client, "source", client, "target", opts)
+	if err != nil {
+		t.Fatalf("DiffDatabases returned error: %v", err)
+	}
+
+	empty := "0-0"
+	want := &DatabaseDiff{Graphs: []GraphDiff{
+		{NamedGraph: defaultGraph, SourceCount: 10, TargetCount: 10, SourceChecksum: "aaa", TargetChecksum: "aaa"},
+		{NamedGraph: "urn:g:a", SourceCount: 5, TargetCount: 5, SourceChecksum: "bbb", TargetChecksum: "xxx"},
+		{NamedGraph: "urn:g:b", SourceCount: 3, TargetCount: 0, SourceChecksum: "ccc", TargetChecksum: empty},
+		{NamedGraph: "urn:g:c", SourceCount: 0, TargetCount: 1, SourceChecksum: empty, TargetChecksum: "ddd"},
+	}}
+	if !cmp.Equal(diff, want) {
+		t.Errorf("DiffDatabases = %+v, want %+v", diff, want)
+	}
+	if diff.Equal() {
+		t.Errorf("DatabaseDiff.Equal = true, want false")
+	}
+	if got := len(diff.Differences()); got != 3 {
+		t.Errorf("len(DatabaseDiff.Differences) = %d, want 3", got)
+	}
+	wantProgress := []DiffProgress{
+		{NamedGraph: defaultGraph, Compared: 1, Total: 4},
+		{NamedGraph: "urn:g:a", Compared: 2, Total: 4},
+		{NamedGraph: "urn:g:b", Compared: 3, Total: 4},
+		{NamedGraph: "urn:g:c", Compared: 4, Total: 4},
+	}
+	if !cmp.Equal(progress, wantProgress) {
+		t.Errorf("progress = %+v, want %+v", progress, wantProgress)
+	}
+}
+
+func TestDiffDatabases_countsOnly(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/db1/query", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query().Get("query")
+		if strings.Contains(query, "MD5") || strings.Contains(query, "SUM") {
+			t.Errorf("checksum query sent with CountsOnly: %s", query)
+		}
+		fmt.Fprint(w, `{"head":{"vars":["count"]},"results":{"bindings":[{"count":{"type":"literal","value":"7"}}]}}`)
+	})
+
+	opts := &DiffOptions{NamedGraphs: []string{"urn:g:a"}, CountsOnly: true}
+	diff, err := DiffDatabases(context.Background(), client, "db1", client, "db1", opts)
+	if err != nil {
+		t.Fatalf("DiffDatabases returned error: %v", err)
+	}
+	want := &DatabaseDiff{Graphs: []GraphDiff{{NamedGraph: "urn:g:a", SourceCount: 7, TargetCount: 7}}}
+	if !cmp.Equal(diff, want) || !diff.Equal() {
+		t.Errorf("DiffDatabases = %+v, want %+v", diff, want)
+	}
+}
+
+func TestDiffDatabases_invalid(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	if _, err := DiffDatabases(ctx, client, "", client, "db", nil); err == nil {
+		t.Errorf("DiffDatabases with an empty source database returned no error")
+	}
+	if _, err := DiffDatabases(ctx, client, "db", nil, "db", nil); err == nil {
+		t.Errorf("DiffDatabases with a nil target returned no error")
+	}
+}
+
+func TestHexValue(t *testing.T) {
+	want := `(STRLEN(STRBEFORE("0123456789abcdef", SUBSTR(?h, 9, 1))) * 268435456 + ` +
+		`STRLEN(STRBEFORE("0123456789abcdef", SUBSTR(?h, 10, 1))) * 16777216 + ` +
+		`STRLEN(STRBEFORE("0123456789abcdef", SUBSTR(?h, 11, 1))) * 1048576 + ` +
+		`STRLEN(STRBEFORE("0123456789abcdef", SUBSTR(?h, 12, 1))) * 65536 + ` +
+		`STRLEN(STRBEFORE("0123456789abcdef", SUBSTR(?h, 13, 1))) * 4096 + ` +
+		`STRLEN(STRBEFORE("0123456789abcdef", SUBSTR(?h, 14, 1))) * 256 + ` +
+		`STRLEN(STRBEFORE("0123456789abcdef", SUBSTR(?h, 15, 1))) * 16 + ` +
+		`STRLEN(STRBEFORE("0123456789abcdef", SUBSTR(?h, 16, 1))) * 1)`
+	if got := hexValue("?h", 9); got != want {
+		t.Errorf("hexValue = %s, want %s", got, want)
+	}
+}
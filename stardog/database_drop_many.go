@@ -0,0 +1,110 @@
+package stardog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// defaultDropConcurrency is the number of databases DropMany drops at a time if DropManyOptions.Concurrency isn't set.
+const defaultDropConcurrency = 4
+
+// DropManyOptions specifies the optional parameters to the [DatabaseAdminService.DropMany] method.
+type DropManyOptions struct {
+	// Only report which of the databases exist and would be dropped, without dropping any of them
+	DryRun bool
+	// The maximum number of databases dropped at a time. Defaults to 4.
+	Concurrency int
+	// Skip databases that don't exist instead of reporting an error for them
+	IgnoreMissing bool
+}
+
+// DropResult is the outcome of dropping one of the databases passed to [DatabaseAdminService.DropMany].
+type DropResult struct {
+	// The name of the database
+	Database string
+	// Whether the database existed when DropMany started
+	Exists bool
+	// Whether the database was dropped. Always false for a dry run.
+	Dropped bool
+	// The response to dropping the database, if it was attempted
+	Response *Response
+	// The error dropping the database, if any
+	Err error
+}
+
+// DropMany drops the databases, several at a time, e.g. to tear down the databases of a test run. The names are
+// all validated, and the databases that exist are listed, before any is dropped, and with DropManyOptions.DryRun
+// none is dropped at all, so the results can be reviewed first.
+//
+// The results are in the order of databases. If dropping any of the databases fails, the others are still
+// dropped, and the errors are also returned joined together.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/dropDatabase
+func (s *DatabaseAdminService) DropMany(ctx context.Context, databases []string, opts *DropManyOptions) ([]DropResult, error) {
+	if len(databases) == 0 {
+		return nil, &ValidationError{Field: "databases", Value: databases, Constraint: "must not be empty"}
+	}
+	seen := make(map[string]bool, len(databases))
+	for _, database := range databases {
+		if err := validateDatabaseName(database); err != nil {
+			return nil, err
+		}
+		if seen[database] {
+			return nil, &ValidationError{Field: "databases", Value: database, Constraint: "must not contain duplicates"}
+		}
+		seen[database] = true
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	if ctx == nil {
+		return nil, errNonNilContext
+	}
+
+	existing, _, err := s.ListDatabases(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]DropResult, len(databases))
+	for i, database := range databases {
+		results[i] = DropResult{Database: database, Exists: indexOf(existing, database) >= 0}
+	}
+	if opts != nil && opts.DryRun {
+		return results, nil
+	}
+
+	concurrency := defaultDropConcurrency
+	if opts != nil && opts.Concurrency > 0 {
+		concurrency = opts.Concurrency
+	}
+	ignoreMissing := opts != nil && opts.IgnoreMissing
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := range results {
+		result := &results[i]
+		if !result.Exists && ignoreMissing {
+			continue
+		}
+		wg.Add(1)
+		slots <- struct{}{}
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			result.Response, result.Err = s.Drop(ctx, result.Database)
+			result.Dropped = result.Err == nil
+		}()
+	}
+	wg.Wait()
+
+	var errs []error
+	for _, result := range results {
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("dropping %s: %w", result.Database, result.Err))
+		}
+	}
+	return results, errors.Join(errs...)
+}
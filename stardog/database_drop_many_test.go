@@ -0,0 +1,103 @@
+package stardog
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestDatabaseAdminService_DropMany(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/databases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`{"databases": ["ci1", "ci2", "ci3", "ci4", "prod"]}`))
+	})
+	var mu sync.Mutex
+	var dropped []string
+	inFlight, maxInFlight := 0, 0
+	mux.HandleFunc("/admin/databases/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		database := strings.TrimPrefix(r.URL.Path, "/admin/databases/")
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		if database == "ci3" {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"message": "unable to drop"}`))
+			return
+		}
+		mu.Lock()
+		dropped = append(dropped, database)
+		mu.Unlock()
+	})
+
+	ctx := context.Background()
+	databases := []string{"ci1", "ci2", "ci3", "ci4", "missing"}
+	ignoreFields := cmpopts.IgnoreFields(DropResult{}, "Response", "Err")
+
+	// a dry run doesn't drop anything
+	got, err := client.DatabaseAdmin.DropMany(ctx, databases, &DropManyOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.DropMany dry run returned error: %v", err)
+	}
+	want := []DropResult{
+		{Database: "ci1", Exists: true},
+		{Database: "ci2", Exists: true},
+		{Database: "ci3", Exists: true},
+		{Database: "ci4", Exists: true},
+		{Database: "missing"},
+	}
+	if !cmp.Equal(got, want, ignoreFields) || len(dropped) != 0 {
+		t.Errorf("DatabaseAdmin.DropMany dry run = %+v and dropped %v, want %+v and nothing dropped", got, dropped, want)
+	}
+
+	got, err = client.DatabaseAdmin.DropMany(ctx, databases, &DropManyOptions{Concurrency: 2, IgnoreMissing: true})
+	if err == nil || !strings.Contains(err.Error(), "dropping ci3") {
+		t.Errorf("DatabaseAdmin.DropMany error = %v, want the error dropping ci3", err)
+	}
+	want = []DropResult{
+		{Database: "ci1", Exists: true, Dropped: true},
+		{Database: "ci2", Exists: true, Dropped: true},
+		{Database: "ci3", Exists: true},
+		{Database: "ci4", Exists: true, Dropped: true},
+		{Database: "missing"},
+	}
+	if !cmp.Equal(got, want, ignoreFields) {
+		t.Errorf("DatabaseAdmin.DropMany = %+v, want %+v", got, want)
+	}
+	if got[2].Err == nil || got[4].Err != nil || got[4].Response != nil {
+		t.Errorf("DatabaseAdmin.DropMany errors = %v, %v, want an error for ci3 only", got[2].Err, got[4].Err)
+	}
+	if maxInFlight > 2 {
+		t.Errorf("DatabaseAdmin.DropMany dropped %d databases at a time, want at most 2", maxInFlight)
+	}
+
+	const methodName = "DropMany"
+	testBadOptions(t, methodName, func() error {
+		_, err := client.DatabaseAdmin.DropMany(ctx, nil, nil)
+		return err
+	})
+	testBadOptions(t, methodName, func() error {
+		_, err := client.DatabaseAdmin.DropMany(ctx, []string{"ci1", "ci1"}, nil)
+		return err
+	})
+	testBadOptions(t, methodName, func() error {
+		_, err := client.DatabaseAdmin.DropMany(ctx, []string{"ci1"}, &DropManyOptions{Concurrency: -1})
+		return err
+	})
+}
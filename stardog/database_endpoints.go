@@ -0,0 +1,114 @@
+package stardog
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// DatabaseEndpoint overrides where a [Client] sends the requests for a database,
+// e.g. to serve some databases from a read replica or a different cluster.
+type DatabaseEndpoint struct {
+	// URL of the Stardog server that serves the database
+	ServerURL string
+	// The http.Client used for requests to the database. If nil, the Client's http.Client is used.
+	HTTPClient *http.Client
+}
+
+// databaseEndpoint is a parsed DatabaseEndpoint.
+type databaseEndpoint struct {
+	baseURL    *url.URL
+	httpClient *http.Client
+}
+
+// SetDatabaseEndpoint routes all database scoped requests for the database (i.e. those whose path starts
+// with the database name, such as queries, transactions and exports) to the endpoint instead of the Client's server.
+// Server administration requests (those under "admin/") are always sent to the Client's server.
+func (c *Client) SetDatabaseEndpoint(database string, endpoint DatabaseEndpoint) error {
+	if err := validateDatabaseName(database); err != nil {
+		return err
+	}
+	serverEndpoint, err := url.Parse(endpoint.ServerURL)
+	if err != nil {
+		return err
+	}
+	if !strings.HasSuffix(serverEndpoint.Path, forwardSlash) {
+		serverEndpoint.Path += forwardSlash
+	}
+
+	c.endpointsMu.Lock()
+	defer c.endpointsMu.Unlock()
+	if c.endpoints == nil {
+		c.endpoints = make(map[string]databaseEndpoint)
+	}
+	c.endpoints[database] = databaseEndpoint{baseURL: serverEndpoint, httpClient: endpoint.HTTPClient}
+	return nil
+}
+
+// RemoveDatabaseEndpoint removes the endpoint override for the database, if any, so that its requests
+// are sent to the Client's server again.
+func (c *Client) RemoveDatabaseEndpoint(database string) {
+	c.endpointsMu.Lock()
+	defer c.endpointsMu.Unlock()
+	delete(c.endpoints, database)
+}
+
+// baseURLFor returns the base URL that the relative urlStr should be resolved against.
+func (c *Client) baseURLFor(urlStr string) *url.URL {
+	c.endpointsMu.RLock()
+	defer c.endpointsMu.RUnlock()
+	if len(c.endpoints) == 0 {
+		return c.baseURL
+	}
+	database, _, _ := strings.Cut(urlStr, forwardSlash)
+	database, _, _ = strings.Cut(database, "?")
+	if database == "admin" {
+		return c.baseURL
+	}
+	if endpoint, ok := c.endpoints[database]; ok {
+		return endpoint.baseURL
+	}
+	return c.baseURL
+}
+
+// httpClientFor returns the http.Client that should send the request. As in baseURLFor, the database is the first
+// segment of the path after the base URL, so the request is sent by the http.Client of the database's endpoint only
+// if the request is for that database at that endpoint. Other requests are sent by the Client's http.Client.
+func (c *Client) httpClientFor(req *http.Request) *http.Client {
+	c.endpointsMu.RLock()
+	defer c.endpointsMu.RUnlock()
+	if len(c.endpoints) == 0 {
+		return c.client
+	}
+	segments := strings.Split(strings.TrimPrefix(req.URL.EscapedPath(), forwardSlash), forwardSlash)
+	for i, segment := range segments {
+		database, err := url.PathUnescape(segment)
+		if err != nil || database == "admin" {
+			continue
+		}
+		endpoint, ok := c.endpoints[database]
+		if !ok || endpoint.httpClient == nil {
+			continue
+		}
+		basePath := forwardSlash + strings.Join(segments[:i], forwardSlash)
+		if i > 0 {
+			basePath += forwardSlash
+		}
+		if req.URL.Scheme == endpoint.baseURL.Scheme && req.URL.Host == endpoint.baseURL.Host &&
+			basePath == endpoint.baseURL.EscapedPath() {
+			return endpoint.httpClient
+		}
+	}
+	return c.client
+}
+
+// closeEndpointIdleConnections closes any idle connections held by the http.Clients of the database endpoints.
+func (c *Client) closeEndpointIdleConnections() {
+	c.endpointsMu.RLock()
+	defer c.endpointsMu.RUnlock()
+	for _, endpoint := range c.endpoints {
+		if endpoint.httpClient != nil {
+			endpoint.httpClient.CloseIdleConnections()
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package stardog
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// countingTransport counts the requests sent through it.
+type countingTransport struct {
+	requests int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestClient_SetDatabaseEndpoint(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	replicaMux := http.NewServeMux()
+	replica := httptest.NewServer(replicaMux)
+	defer replica.Close()
+
+	mux.HandleFunc("/db1/size", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("1"))
+	})
+	mux.HandleFunc("/admin/databases/db2/options", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"search.enabled": true}`))
+	})
+	mux.HandleFunc("/db2/size", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("request for db2 was sent to the primary server")
+	})
+	replicaMux.HandleFunc("/replica/db2/size", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("2"))
+	})
+
+	transport := &countingTransport{}
+	err := client.SetDatabaseEndpoint("db2", DatabaseEndpoint{
+		ServerURL:  replica.URL + "/replica",
+		HTTPClient: &http.Client{Transport: transport},
+	})
+	if err != nil {
+		t.Fatalf("Client.SetDatabaseEndpoint returned error: %v", err)
+	}
+
+	ctx := context.Background()
+	size, _, err := client.DatabaseAdmin.Size(ctx, "db2", nil)
+	if err != nil {
+		t.Errorf("DatabaseAdmin.Size returned error: %v", err)
+	} else if *size != 2 {
+		t.Errorf("DatabaseAdmin.Size(db2) = %d, want 2", *size)
+	}
+	if transport.requests != 1 {
+		t.Errorf("endpoint http.Client sent %d requests, want 1", transport.requests)
+	}
+
+	size, _, err = client.DatabaseAdmin.Size(ctx, "db1", nil)
+	if err != nil {
+		t.Errorf("DatabaseAdmin.Size returned error: %v", err)
+	} else if *size != 1 {
+		t.Errorf("DatabaseAdmin.Size(db1) = %d, want 1", *size)
+	}
+
+	// admin requests always go to the client's server
+	if _, _, err := client.DatabaseAdmin.Metadata(ctx, "db2", []string{"search.enabled"}); err != nil {
+		t.Errorf("DatabaseAdmin.Metadata returned error: %v", err)
+	}
+	if transport.requests != 1 {
+		t.Errorf("endpoint http.Client sent %d requests, want 1", transport.requests)
+	}
+
+	client.RemoveDatabaseEndpoint("db2")
+	req, err := client.NewRequest(http.MethodGet, "db2/size", nil, nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	if got, want := req.URL.String(), client.baseURL.String()+"db2/size"; got != want {
+		t.Errorf("NewRequest URL after RemoveDatabaseEndpoint = %v, want %v", got, want)
+	}
+}
+
+func TestClient_SetDatabaseEndpoint_invalid(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	var validationErr *ValidationError
+	if err := client.SetDatabaseEndpoint("", DatabaseEndpoint{ServerURL: "http://localhost:5821"}); !errors.As(err, &validationErr) {
+		t.Errorf("Client.SetDatabaseEndpoint error = %v, want *ValidationError", err)
+	}
+	if err := client.SetDatabaseEndpoint("db1", DatabaseEndpoint{ServerURL: ":"}); err == nil {
+		t.Errorf("Client.SetDatabaseEndpoint err = nil, want error for bad URL")
+	}
+}
+
+func TestClient_SetDatabaseEndpoint_defaultHTTPClient(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	if err := client.SetDatabaseEndpoint("db1", DatabaseEndpoint{ServerURL: "http://replica:5820"}); err != nil {
+		t.Fatalf("Client.SetDatabaseEndpoint returned error: %v", err)
+	}
+	req, err := client.NewRequest(http.MethodGet, "db1/query?query=ask{}", nil, nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	if got, want := req.URL.String(), "http://replica:5820/db1/query?query=ask{}"; got != want {
+		t.Errorf("NewRequest URL = %v, want %v", got, want)
+	}
+	if got := client.httpClientFor(req); got != client.client {
+		t.Errorf("httpClientFor = %v, want the client's http.Client", got)
+	}
+}
+
+func TestClient_httpClientFor(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	db1Client, db2Client := &http.Client{}, &http.Client{}
+	// both databases are served by the Client's own server, so only the path tells their requests apart
+	for database, httpClient := range map[string]*http.Client{"db1": db1Client, "db2": db2Client} {
+		if err := client.SetDatabaseEndpoint(database, DatabaseEndpoint{ServerURL: client.baseURL.String(), HTTPClient: httpClient}); err != nil {
+			t.Fatalf("Client.SetDatabaseEndpoint returned error: %v", err)
+		}
+	}
+
+	tests := []struct {
+		urlStr string
+		want   *http.Client
+	}{
+		{"db1/query", db1Client},
+		{"db2/size", db2Client},
+		{"db3/size", client.client},
+		{"admin/databases/db1/options", client.client},
+		{"admin/status", client.client},
+	}
+	for _, tc := range tests {
+		for i := 0; i < 10; i++ {
+			req, err := client.NewRequest(http.MethodGet, tc.urlStr, nil, nil)
+			if err != nil {
+				t.Fatalf("NewRequest returned error: %v", err)
+			}
+			if got := client.httpClientFor(req); got != tc.want {
+				t.Errorf("httpClientFor(%s) = %p, want %p", tc.urlStr, got, tc.want)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package stardog
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Categories of database configuration options, as found in DatabaseOptionDetails.Category. The server may
+// document categories other than those declared here.
+const (
+	DatabaseOptionCategoryDatabase    = "Database"
+	DatabaseOptionCategoryIndex       = "Index"
+	DatabaseOptionCategoryICV         = "ICV"
+	DatabaseOptionCategoryQuery       = "Query"
+	DatabaseOptionCategoryReasoning   = "Reasoning"
+	DatabaseOptionCategorySearch      = "Search"
+	DatabaseOptionCategorySpatial     = "Spatial"
+	DatabaseOptionCategoryTransaction = "Transaction"
+)
+
+// DatabaseOptionsDocumentation is the documentation of the database configuration options returned by
+// [DatabaseAdminService.MetadataDocumentation], keyed by option name. Convert the returned map to it to look
+// options up:
+//
+//	docs, _, err := client.DatabaseAdmin.MetadataDocumentation(ctx)
+//	// ...
+//	reasoningOptions := stardog.DatabaseOptionsDocumentation(docs).ByCategory(stardog.DatabaseOptionCategoryReasoning)
+type DatabaseOptionsDocumentation map[string]DatabaseOptionDetails
+
+// Categories returns the categories of the options, sorted.
+func (d DatabaseOptionsDocumentation) Categories() []string {
+	seen := make(map[string]bool)
+	var categories []string
+	for _, option := range d {
+		if !seen[option.Category] {
+			seen[option.Category] = true
+			categories = append(categories, option.Category)
+		}
+	}
+	sort.Strings(categories)
+	return categories
+}
+
+// ByCategory returns the options in the category, sorted by name. Categories are compared case-insensitively.
+func (d DatabaseOptionsDocumentation) ByCategory(category string) []DatabaseOptionDetails {
+	return d.filter(func(option DatabaseOptionDetails) bool {
+		return strings.EqualFold(option.Category, category)
+	})
+}
+
+// Mutable returns the options that can be changed with [DatabaseAdminService.SetMetadata], sorted by name.
+// If online is true, only the options that can be changed while the database is online are returned.
+func (d DatabaseOptionsDocumentation) Mutable(online bool) []DatabaseOptionDetails {
+	return d.filter(func(option DatabaseOptionDetails) bool {
+		return option.Mutable && (!online || option.MutableWhenOnline)
+	})
+}
+
+func (d DatabaseOptionsDocumentation) filter(keep func(DatabaseOptionDetails) bool) []DatabaseOptionDetails {
+	var options []DatabaseOptionDetails
+	for _, option := range d {
+		if keep(option) {
+			options = append(options, option)
+		}
+	}
+	sort.Slice(options, func(i, j int) bool { return options[i].Name < options[j].Name })
+	return options
+}
+
+// Validate returns a [ValidationError] if the option isn't documented or value doesn't have the option's
+// documented type, so type errors are caught before calling [DatabaseAdminService.SetMetadata]:
+//
+//   - Boolean options take a bool
+//   - Integer, Long, Short and Byte options take an integer, or a float64 or json.Number with an integral value
+//   - Double and Float options take any number
+//   - String options take a string, or a slice of strings for options with multiple values
+//
+// Values of options with other types are not checked.
+func (d DatabaseOptionsDocumentation) Validate(name string, value any) error {
+	option, ok := d[name]
+	if !ok {
+		return &ValidationError{Field: "name", Value: name, Constraint: "must be a documented database option"}
+	}
+	if value == nil {
+		return &ValidationError{Field: "value", Value: value, Constraint: "must not be nil for option " + name}
+	}
+	var valid bool
+	switch strings.ToLower(option.Type) {
+	case "boolean":
+		_, valid = value.(bool)
+	case "integer", "long", "short", "byte":
+		valid = isIntegerValue(value)
+	case "double", "float":
+		valid = isNumberValue(value)
+	case "string":
+		valid = isStringValue(value)
+	default:
+		return nil
+	}
+	if !valid {
+		return &ValidationError{Field: "value", Value: value, Constraint: "must be of type " + option.Type + " for option " + name}
+	}
+	return nil
+}
+
+// isIntegerValue reports whether value is an integer, or a number decoded from JSON with an integral value.
+func isIntegerValue(value any) bool {
+	switch v := value.(type) {
+	case float64:
+		return v == math.Trunc(v) && !math.IsInf(v, 0)
+	case json.Number:
+		_, err := v.Int64()
+		return err == nil
+	}
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
+
+// isNumberValue reports whether value is a number.
+func isNumberValue(value any) bool {
+	switch v := value.(type) {
+	case float32, float64:
+		return true
+	case json.Number:
+		_, err := v.Float64()
+		return err == nil
+	}
+	return isIntegerValue(value)
+}
+
+// isStringValue reports whether value is a string or a slice of strings.
+func isStringValue(value any) bool {
+	switch v := value.(type) {
+	case string, []string:
+		return true
+	case []any:
+		for _, element := range v {
+			if _, ok := element.(string); !ok {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
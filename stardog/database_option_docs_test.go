@@ -0,0 +1,98 @@
+package stardog
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var testOptionsDocumentation = DatabaseOptionsDocumentation{
+	"reasoning.type":      {Name: "reasoning.type", Type: "Enum", Mutable: true, Category: DatabaseOptionCategoryReasoning},
+	"reasoning.sameas":    {Name: "reasoning.sameas", Type: "Enum", Mutable: true, MutableWhenOnline: true, Category: DatabaseOptionCategoryReasoning},
+	"search.enabled":      {Name: "search.enabled", Type: "Boolean", Mutable: true, Category: DatabaseOptionCategorySearch},
+	"query.timeout":       {Name: "query.timeout", Type: "Long", Mutable: true, MutableWhenOnline: true, Category: DatabaseOptionCategoryQuery},
+	"index.size.estimate": {Name: "index.size.estimate", Type: "Double", Category: DatabaseOptionCategoryIndex},
+	"database.archetypes": {Name: "database.archetypes", Type: "String", Mutable: true, Category: DatabaseOptionCategoryDatabase},
+}
+
+func optionNames(options []DatabaseOptionDetails) []string {
+	var names []string
+	for _, option := range options {
+		names = append(names, option.Name)
+	}
+	return names
+}
+
+func TestDatabaseOptionsDocumentation_Categories(t *testing.T) {
+	want := []string{
+		DatabaseOptionCategoryDatabase,
+		DatabaseOptionCategoryIndex,
+		DatabaseOptionCategoryQuery,
+		DatabaseOptionCategoryReasoning,
+		DatabaseOptionCategorySearch,
+	}
+	if got := testOptionsDocumentation.Categories(); !cmp.Equal(got, want) {
+		t.Errorf("DatabaseOptionsDocumentation.Categories = %v, want %v", got, want)
+	}
+}
+
+func TestDatabaseOptionsDocumentation_ByCategory(t *testing.T) {
+	want := []string{"reasoning.sameas", "reasoning.type"}
+	if got := optionNames(testOptionsDocumentation.ByCategory("reasoning")); !cmp.Equal(got, want) {
+		t.Errorf("DatabaseOptionsDocumentation.ByCategory = %v, want %v", got, want)
+	}
+	if got := testOptionsDocumentation.ByCategory(DatabaseOptionCategoryTransaction); got != nil {
+		t.Errorf("DatabaseOptionsDocumentation.ByCategory = %v, want nil", got)
+	}
+}
+
+func TestDatabaseOptionsDocumentation_Mutable(t *testing.T) {
+	want := []string{"database.archetypes", "query.timeout", "reasoning.sameas", "reasoning.type", "search.enabled"}
+	if got := optionNames(testOptionsDocumentation.Mutable(false)); !cmp.Equal(got, want) {
+		t.Errorf("DatabaseOptionsDocumentation.Mutable(false) = %v, want %v", got, want)
+	}
+	want = []string{"query.timeout", "reasoning.sameas"}
+	if got := optionNames(testOptionsDocumentation.Mutable(true)); !cmp.Equal(got, want) {
+		t.Errorf("DatabaseOptionsDocumentation.Mutable(true) = %v, want %v", got, want)
+	}
+}
+
+func TestDatabaseOptionsDocumentation_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		wantErr bool
+	}{
+		{"search.enabled", true, false},
+		{"search.enabled", "true", true},
+		{"query.timeout", 5000, false},
+		{"query.timeout", int64(5000), false},
+		{"query.timeout", float64(5000), false},
+		{"query.timeout", json.Number("5000"), false},
+		{"query.timeout", 1.5, true},
+		{"query.timeout", "5000", true},
+		{"index.size.estimate", 0.5, false},
+		{"index.size.estimate", 2, false},
+		{"index.size.estimate", false, true},
+		{"database.archetypes", "kg", false},
+		{"database.archetypes", []string{"kg", "cim"}, false},
+		{"database.archetypes", []any{"kg"}, false},
+		{"database.archetypes", []any{1}, true},
+		{"database.archetypes", 1, true},
+		{"reasoning.type", "SL", false},
+		{"search.enabled", nil, true},
+		{"no.such.option", true, true},
+	}
+	for _, tt := range tests {
+		err := testOptionsDocumentation.Validate(tt.name, tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("DatabaseOptionsDocumentation.Validate(%q, %#v) error = %v, wantErr %v", tt.name, tt.value, err, tt.wantErr)
+		}
+		var validationErr *ValidationError
+		if err != nil && !errors.As(err, &validationErr) {
+			t.Errorf("DatabaseOptionsDocumentation.Validate(%q, %#v) error = %T, want *ValidationError", tt.name, tt.value, err)
+		}
+	}
+}
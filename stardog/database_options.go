@@ -0,0 +1,122 @@
+package stardog
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// DatabaseOptions is a typed form of commonly used [database configuration options] (a.k.a. metadata), so
+// misspelled option names are caught at compile time. Options that are nil are not set. Options without a
+// field are kept in Other.
+//
+// Convert to and from the map form used by [DatabaseAdminService.SetMetadata],
+// [DatabaseAdminService.AllMetadata] and CreateDatabaseOptions.DatabaseOptions with [DatabaseOptions.ToMap]
+// and [DatabaseOptionsFromMap]:
+//
+//	opts, _ := (&stardog.DatabaseOptions{SearchEnabled: stardog.Bool(true)}).ToMap()
+//	_, err := client.DatabaseAdmin.SetMetadata(ctx, "db1", opts)
+//
+// [database configuration options]: https://docs.stardog.com/operating-stardog/database-administration/database-configuration#configuration-options
+type DatabaseOptions struct {
+	DatabaseOnline     *bool    `json:"database.online,omitempty"`
+	DatabaseNamespaces []string `json:"database.namespaces,omitempty"`
+	DatabaseArchetypes []string `json:"database.archetypes,omitempty"`
+
+	SearchEnabled                 *bool    `json:"search.enabled,omitempty"`
+	SearchDefaultLimit            *int64   `json:"search.default.limit,omitempty"`
+	SearchWildcardSearchEnabled   *bool    `json:"search.wildcard.search.enabled,omitempty"`
+	SearchReindexMode             *string  `json:"search.reindex.mode,omitempty"`
+	SpatialEnabled                *bool    `json:"spatial.enabled,omitempty"`
+	SpatialPrecision              *int64   `json:"spatial.precision,omitempty"`
+	IndexNamedGraphs              *bool    `json:"index.named.graphs,omitempty"`
+	EdgeProperties                *bool    `json:"edge.properties,omitempty"`
+	PreserveBNodeIDs              *bool    `json:"preserve.bnode.ids,omitempty"`
+	StrictParsing                 *bool    `json:"strict.parsing,omitempty"`
+	GraphQLAutoSchema             *bool    `json:"graphql.auto.schema,omitempty"`
+	SQLSchemaGraph                *string  `json:"sql.schema.graph,omitempty"`
+	ICVEnabled                    *bool    `json:"icv.enabled,omitempty"`
+	ICVReasoningEnabled           *bool    `json:"icv.reasoning.enabled,omitempty"`
+	QueryTimeout                  *string  `json:"query.timeout,omitempty"`
+	QueryAllGraphs                *bool    `json:"query.all.graphs,omitempty"`
+	TransactionIsolation          *string  `json:"transaction.isolation,omitempty"`
+	TransactionLogging            *bool    `json:"transaction.logging,omitempty"`
+	ReasoningType                 *string  `json:"reasoning.type,omitempty"`
+	ReasoningSchemaTimeout        *string  `json:"reasoning.schema.timeout,omitempty"`
+	ReasoningSchemaGraphs         []string `json:"reasoning.schema.graphs,omitempty"`
+	ReasoningSameAs               *string  `json:"reasoning.sameas,omitempty"`
+	ReasoningApproximate          *bool    `json:"reasoning.approximate,omitempty"`
+	ReasoningConsistencyAutomatic *bool    `json:"reasoning.consistency.automatic,omitempty"`
+
+	// Options without a field, keyed by name. Entries for options that have a field are ignored by ToMap.
+	Other map[string]any `json:"-"`
+}
+
+// Bool returns a pointer to b, for setting the optional fields of [DatabaseOptions].
+func Bool(b bool) *bool { return &b }
+
+// Int64 returns a pointer to i, for setting the optional fields of [DatabaseOptions].
+func Int64(i int64) *int64 { return &i }
+
+// String returns a pointer to s, for setting the optional fields of [DatabaseOptions].
+func String(s string) *string { return &s }
+
+// ToMap returns the options that are set, and the entries of Other, keyed by option name.
+func (o *DatabaseOptions) ToMap() (map[string]any, error) {
+	m := make(map[string]any)
+	if o == nil {
+		return m, nil
+	}
+	fields := databaseOptionNames()
+	for name, value := range o.Other {
+		if !fields[name] {
+			m[name] = value
+		}
+	}
+	b, err := json.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// DatabaseOptionsFromMap converts options keyed by name, such as those returned by
+// [DatabaseAdminService.AllMetadata], to DatabaseOptions. Options without a field are put in Other.
+// An error is returned if an option has a value of the wrong type for its field.
+func DatabaseOptionsFromMap(m map[string]any) (*DatabaseOptions, error) {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	var o DatabaseOptions
+	if err := json.Unmarshal(b, &o); err != nil {
+		return nil, err
+	}
+	fields := databaseOptionNames()
+	for name, value := range m {
+		if fields[name] {
+			continue
+		}
+		if o.Other == nil {
+			o.Other = make(map[string]any)
+		}
+		o.Other[name] = value
+	}
+	return &o, nil
+}
+
+// databaseOptionNames returns the names of the options that have a field in DatabaseOptions.
+func databaseOptionNames() map[string]bool {
+	names := make(map[string]bool)
+	t := reflect.TypeOf(DatabaseOptions{})
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			names[name] = true
+		}
+	}
+	return names
+}
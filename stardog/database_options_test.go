@@ -0,0 +1,81 @@
+package stardog
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDatabaseOptions_ToMap(t *testing.T) {
+	opts := &DatabaseOptions{
+		SearchEnabled:          Bool(true),
+		SpatialEnabled:         Bool(false),
+		SpatialPrecision:       Int64(11),
+		ReasoningSchemaTimeout: String("1m"),
+		DatabaseNamespaces:     []string{"schema=http://schema.org/"},
+		Other: map[string]any{
+			"docs.default.rdf.extractors": "tika",
+			// ignored in favor of the field
+			"search.enabled": false,
+		},
+	}
+	got, err := opts.ToMap()
+	if err != nil {
+		t.Fatalf("ToMap returned error: %v", err)
+	}
+	want := map[string]any{
+		"search.enabled":              true,
+		"spatial.enabled":             false,
+		"spatial.precision":           11.0,
+		"reasoning.schema.timeout":    "1m",
+		"database.namespaces":         []any{"schema=http://schema.org/"},
+		"docs.default.rdf.extractors": "tika",
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("ToMap = %v, want %v", got, want)
+	}
+
+	var nilOpts *DatabaseOptions
+	if got, err := nilOpts.ToMap(); err != nil || len(got) != 0 {
+		t.Errorf("ToMap of nil = %v, %v, want an empty map", got, err)
+	}
+}
+
+func TestDatabaseOptionsFromMap(t *testing.T) {
+	metadata := map[string]any{
+		"search.enabled":              true,
+		"search.default.limit":        100.0,
+		"query.timeout":               "5m",
+		"reasoning.schema.graphs":     []any{"tag:stardog:api:context:schema"},
+		"docs.default.rdf.extractors": "tika",
+	}
+	got, err := DatabaseOptionsFromMap(metadata)
+	if err != nil {
+		t.Fatalf("DatabaseOptionsFromMap returned error: %v", err)
+	}
+	want := &DatabaseOptions{
+		SearchEnabled:         Bool(true),
+		SearchDefaultLimit:    Int64(100),
+		QueryTimeout:          String("5m"),
+		ReasoningSchemaGraphs: []string{"tag:stardog:api:context:schema"},
+		Other:                 map[string]any{"docs.default.rdf.extractors": "tika"},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("DatabaseOptionsFromMap = %+v, want %+v", got, want)
+	}
+
+	// converting back gives the original map
+	roundTrip, err := got.ToMap()
+	if err != nil {
+		t.Fatalf("ToMap returned error: %v", err)
+	}
+	if !cmp.Equal(roundTrip, metadata) {
+		t.Errorf("ToMap = %v, want %v", roundTrip, metadata)
+	}
+}
+
+func TestDatabaseOptionsFromMap_wrongType(t *testing.T) {
+	if _, err := DatabaseOptionsFromMap(map[string]any{"search.enabled": "yes"}); err == nil {
+		t.Errorf("DatabaseOptionsFromMap err = nil, want error for a string search.enabled")
+	}
+}
@@ -0,0 +1,47 @@
+package stardog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ErrDatabaseOnline is returned by [DatabaseAdminService.Rename] when the database is online. Take it offline
+// with [DatabaseAdminService.Offline] first.
+var ErrDatabaseOnline = errors.New("stardog: database must be offline")
+
+// Rename renames the database, like stardog-admin db rename. The database must be offline: if it's online,
+// an error wrapping ErrDatabaseOnline is returned without renaming it.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/renameDatabase
+func (s *DatabaseAdminService) Rename(ctx context.Context, oldName string, newName string) (*Response, error) {
+	if err := firstError(validateNotEmpty("oldName", oldName), validateNotEmpty("newName", newName)); err != nil {
+		return nil, err
+	}
+	if newName == oldName {
+		return nil, &ValidationError{Field: "newName", Value: newName, Constraint: "must not be the database's current name"}
+	}
+	if ctx == nil {
+		return nil, errNonNilContext
+	}
+
+	online, resp, err := s.isOnline(ctx, oldName)
+	if err != nil {
+		return resp, err
+	}
+	if online {
+		return resp, fmt.Errorf("renaming %s: %w", oldName, ErrDatabaseOnline)
+	}
+
+	u := fmt.Sprintf("admin/databases/%s/rename?to=%s", oldName, url.QueryEscape(newName))
+	reqHeaderOpts := &requestHeaderOptions{
+		Accept: mediaTypeApplicationJSON,
+	}
+	req, err := s.client.NewRequest(http.MethodPut, u, reqHeaderOpts, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,56 @@
+package stardog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestDatabaseAdminService_Rename(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	online := map[string]bool{"db1": false, "db2": true}
+	for db := range online {
+		db := db
+		mux.HandleFunc(fmt.Sprintf("/admin/databases/%s/options", db), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "PUT")
+			fmt.Fprintf(w, `{"database.online": %t}`, online[db])
+		})
+	}
+	var renamedTo string
+	mux.HandleFunc("/admin/databases/db1/rename", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		renamedTo = r.URL.Query().Get("to")
+	})
+	mux.HandleFunc("/admin/databases/db2/rename", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("an online database should not be renamed")
+	})
+
+	ctx := context.Background()
+	if _, err := client.DatabaseAdmin.Rename(ctx, "db1", "db3"); err != nil {
+		t.Fatalf("DatabaseAdmin.Rename returned error: %v", err)
+	}
+	if renamedTo != "db3" {
+		t.Errorf("DatabaseAdmin.Rename renamed db1 to %q, want %q", renamedTo, "db3")
+	}
+
+	if _, err := client.DatabaseAdmin.Rename(ctx, "db2", "db4"); !errors.Is(err, ErrDatabaseOnline) {
+		t.Errorf("DatabaseAdmin.Rename of an online database returned error %v, want ErrDatabaseOnline", err)
+	}
+
+	const methodName = "Rename"
+	testBadOptions(t, methodName, func() error {
+		_, err := client.DatabaseAdmin.Rename(ctx, "db1", "db1")
+		return err
+	})
+	testBadOptions(t, methodName, func() error {
+		_, err := client.DatabaseAdmin.Rename(ctx, "db1", "")
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.DatabaseAdmin.Rename(nil, "db1", "db3")
+	})
+}
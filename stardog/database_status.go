@@ -0,0 +1,84 @@
+package stardog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// DatabaseStatus is the state of a database, returned by [DatabaseAdminService.Status].
+type DatabaseStatus struct {
+	// Whether the database is online
+	Online bool
+	// The number of open connections to the database
+	OpenConnections int64
+	// The number of open transactions on the database
+	OpenTransactions int64
+	// The number of queries running on the database
+	RunningQueries int64
+	// All the server's metrics for the database, keyed by name without the "databases.<database>." prefix
+	// (e.g. "txns.openTransactions"). Each metric is an object such as {"count": 1} or {"value": 2}.
+	Metrics map[string]any
+}
+
+// databaseOnlineOption is the database option that's true while the database is online.
+const databaseOnlineOption = "database.online"
+
+// isOnline reports whether the database is online, from its database.online option.
+func (s *DatabaseAdminService) isOnline(ctx context.Context, database string) (bool, *Response, error) {
+	metadata, resp, err := s.Metadata(ctx, database, []string{databaseOnlineOption})
+	if err != nil {
+		return false, resp, err
+	}
+	online, _ := metadata[databaseOnlineOption].(bool)
+	return online, resp, nil
+}
+
+// Status returns the state of the database: whether it's online and, from the server's metrics, its open
+// connections, transactions and running queries. Check it before taking a database offline or optimizing it.
+// Counts the server doesn't report, such as for an offline database, are zero.
+//
+// Stardog has no single endpoint for the status of a database, so Status combines the database.online option
+// with the database's entries in the server's metrics.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Monitoring/operation/status
+func (s *DatabaseAdminService) Status(ctx context.Context, database string) (*DatabaseStatus, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
+	online, resp, err := s.isOnline(ctx, database)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	metrics, resp, err := s.client.ServerAdmin.metrics(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	status := &DatabaseStatus{Online: online, Metrics: make(map[string]any)}
+	prefix := fmt.Sprintf("databases.%s.", database)
+	for name, metric := range metrics {
+		if strings.HasPrefix(name, prefix) {
+			status.Metrics[strings.TrimPrefix(name, prefix)] = metric
+		}
+	}
+	status.OpenConnections = metricCount(status.Metrics["openConnections"])
+	status.OpenTransactions = metricCount(status.Metrics["txns.openTransactions"])
+	status.RunningQueries = metricCount(status.Metrics["queries.running"])
+	return status, resp, nil
+}
+
+// metricCount returns the count of a counter metric or the value of a numeric gauge metric, or zero.
+func metricCount(metric any) int64 {
+	fields, ok := metric.(map[string]any)
+	if !ok {
+		return 0
+	}
+	for _, field := range []string{"count", "value"} {
+		if n, ok := fields[field].(float64); ok {
+			return int64(n)
+		}
+	}
+	return 0
+}
@@ -0,0 +1,86 @@
+package stardog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDatabaseAdminService_Status(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	mux.HandleFunc(fmt.Sprintf("/admin/databases/%s/options", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"database.online":""}`+"\n")
+		w.Write([]byte(`{"database.online": true}`))
+	})
+	mux.HandleFunc("/admin/status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeApplicationJSON)
+		w.Write([]byte(`{
+			"dbms.memory.heap.used": {"value": 1024},
+			"databases.db1.openConnections": {"count": 3},
+			"databases.db1.txns.openTransactions": {"count": 1},
+			"databases.db1.queries.running": {"value": 2},
+			"databases.db10.openConnections": {"count": 7}
+		}`))
+	})
+
+	ctx := context.Background()
+	got, _, err := client.DatabaseAdmin.Status(ctx, db)
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.Status returned error: %v", err)
+	}
+	want := &DatabaseStatus{
+		Online:           true,
+		OpenConnections:  3,
+		OpenTransactions: 1,
+		RunningQueries:   2,
+		Metrics: map[string]any{
+			"openConnections":       map[string]any{"count": 3.0},
+			"txns.openTransactions": map[string]any{"count": 1.0},
+			"queries.running":       map[string]any{"value": 2.0},
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("DatabaseAdmin.Status = %+v, want %+v", got, want)
+	}
+
+	const methodName = "Status"
+	testBadOptions(t, methodName, func() error {
+		_, _, err := client.DatabaseAdmin.Status(ctx, "")
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.DatabaseAdmin.Status(nil, db)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestDatabaseAdminService_Status_offline(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/databases/db1/options", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"database.online": false}`))
+	})
+	mux.HandleFunc("/admin/status", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"dbms.memory.heap.used": {"value": 1024}}`))
+	})
+
+	got, _, err := client.DatabaseAdmin.Status(context.Background(), "db1")
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.Status returned error: %v", err)
+	}
+	if want := (&DatabaseStatus{Metrics: map[string]any{}}); !cmp.Equal(got, want) {
+		t.Errorf("DatabaseAdmin.Status = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,91 @@
+package stardog
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// processTypeTransaction is the type of the process of an open transaction, which is driven by a client
+// rather than running in the background.
+const processTypeTransaction = "Transaction"
+
+// OpenTransaction is a transaction begun with [TransactionService.Begin] that hasn't been committed or rolled back.
+type OpenTransaction struct {
+	// The transaction ID, which is also the ID of its server process
+	ID string
+	// The database the transaction is open on
+	Database string
+	// The user who began the transaction
+	User string
+	// When the transaction began
+	StartTime time.Time
+}
+
+// Age returns how long the transaction has been open.
+func (t *OpenTransaction) Age() time.Duration {
+	return time.Since(t.StartTime)
+}
+
+// Transactions returns the open transactions on the database, oldest first. Stardog has no endpoint for
+// listing transactions, but each open transaction is a server process (see [ServerAdminService.GetProcesses]),
+// so the user needs permission to list processes. Transactions left open by clients that crashed can be
+// ended with [DatabaseAdminService.ForceRollback].
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Monitoring/operation/listProcesses
+func (s *DatabaseAdminService) Transactions(ctx context.Context, database string) ([]OpenTransaction, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
+	processes, resp, err := s.client.ServerAdmin.GetProcesses(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	transactions := make([]OpenTransaction, 0)
+	if processes == nil {
+		return transactions, resp, nil
+	}
+	for _, process := range *processes {
+		if process.Type != processTypeTransaction || process.Db != database {
+			continue
+		}
+		transactions = append(transactions, OpenTransaction{
+			ID:        process.ID,
+			Database:  process.Db,
+			User:      process.User,
+			StartTime: time.UnixMilli(process.StartTime),
+		})
+	}
+	sort.SliceStable(transactions, func(i, j int) bool {
+		return transactions[i].StartTime.Before(transactions[j].StartTime)
+	})
+	return transactions, resp, nil
+}
+
+// ForceRollback rolls back an open transaction on the database, such as one abandoned by a client that crashed,
+// discarding its changes and releasing its locks. If the server refuses to roll it back because the user isn't
+// permitted to, e.g. because another user began it, the transaction's process is killed instead (see
+// [ServerAdminService.KillProcess]), which also discards its changes. The process is only killed after checking
+// that it is a transaction on the database.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Transactions/operation/rollbackTransaction
+func (s *DatabaseAdminService) ForceRollback(ctx context.Context, database string, txID string) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	if txID == "" {
+		return nil, &ValidationError{Field: "txID", Value: txID, Constraint: "must not be empty"}
+	}
+	resp, err := s.client.Transaction.Rollback(ctx, database, txID)
+	if !IsAuthError(err) {
+		return resp, err
+	}
+	process, resp, err := s.client.ServerAdmin.GetProcess(ctx, txID)
+	if err != nil {
+		return resp, err
+	}
+	if process.Type != processTypeTransaction || process.Db != database {
+		return resp, &ValidationError{Field: "txID", Value: txID, Constraint: "must be an open transaction on database " + database}
+	}
+	return s.client.ServerAdmin.KillProcess(ctx, txID)
+}
@@ -0,0 +1,143 @@
+package stardog
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDatabaseAdminService_Transactions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/processes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`[
+  {"type": "Transaction", "id": "tx2", "db": "myDb", "user": "bob", "startTime": 1669949829999, "status": "RUNNING", "progress": {"max": 0, "current": 0, "stage": ""}},
+  {"type": "DB_OPTIMIZE", "id": "optimize", "db": "myDb", "user": "admin", "startTime": 1669949829376, "status": "RUNNING", "progress": {"max": 4, "current": 1, "stage": "Compacting"}},
+  {"type": "Transaction", "id": "tx1", "db": "myDb", "user": "alice", "startTime": 1669949829376, "status": "RUNNING", "progress": {"max": 0, "current": 0, "stage": ""}},
+  {"type": "Transaction", "id": "tx3", "db": "otherDb", "user": "admin", "startTime": 1669949829000, "status": "RUNNING", "progress": {"max": 0, "current": 0, "stage": ""}}
+]`))
+	})
+
+	ctx := context.Background()
+	got, _, err := client.DatabaseAdmin.Transactions(ctx, "myDb")
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.Transactions returned error: %v", err)
+	}
+	want := []OpenTransaction{
+		{ID: "tx1", Database: "myDb", User: "alice", StartTime: time.UnixMilli(1669949829376)},
+		{ID: "tx2", Database: "myDb", User: "bob", StartTime: time.UnixMilli(1669949829999)},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("DatabaseAdmin.Transactions = %+v, want %+v", got, want)
+	}
+	if got[0].Age() <= 0 {
+		t.Errorf("OpenTransaction.Age = %v, want a positive duration", got[0].Age())
+	}
+
+	const methodName = "Transactions"
+	testBadOptions(t, methodName, func() error {
+		_, _, err := client.DatabaseAdmin.Transactions(ctx, "")
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.DatabaseAdmin.Transactions(nil, "myDb")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestDatabaseAdminService_Transactions_nullBody(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/processes", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`null`))
+	})
+
+	got, _, err := client.DatabaseAdmin.Transactions(context.Background(), "myDb")
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.Transactions returned error: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("DatabaseAdmin.Transactions = %+v, want none", got)
+	}
+}
+
+func TestDatabaseAdminService_ForceRollback(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls []string
+	mux.HandleFunc("/myDb/transaction/rollback/tx1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		calls = append(calls, "rollback tx1")
+	})
+	mux.HandleFunc("/myDb/transaction/rollback/tx2", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "rollback tx2")
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"message": "transaction belongs to another user"}`))
+	})
+	mux.HandleFunc("/myDb/transaction/rollback/tx3", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "rollback tx3")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "no such transaction"}`))
+	})
+	mux.HandleFunc("/myDb/transaction/rollback/tx4", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "rollback tx4")
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"message": "permission denied"}`))
+	})
+	mux.HandleFunc("/myDb/transaction/rollback/tx5", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "rollback tx5")
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"message": "internal error"}`))
+	})
+	mux.HandleFunc("/admin/processes/tx2", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			calls = append(calls, "get tx2")
+			w.Write([]byte(`{"type": "Transaction", "id": "tx2", "db": "myDb"}`))
+			return
+		}
+		testMethod(t, r, "DELETE")
+		calls = append(calls, "kill tx2")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/admin/processes/tx4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls = append(calls, "get tx4")
+		w.Write([]byte(`{"type": "Transaction", "id": "tx4", "db": "otherDb"}`))
+	})
+
+	ctx := context.Background()
+	for _, txID := range []string{"tx1", "tx2"} {
+		if _, err := client.DatabaseAdmin.ForceRollback(ctx, "myDb", txID); err != nil {
+			t.Errorf("DatabaseAdmin.ForceRollback(%s) returned error: %v", txID, err)
+		}
+	}
+	for _, txID := range []string{"tx3", "tx4", "tx5"} {
+		if _, err := client.DatabaseAdmin.ForceRollback(ctx, "myDb", txID); err == nil {
+			t.Errorf("DatabaseAdmin.ForceRollback(%s) err = nil, want error", txID)
+		}
+	}
+	want := []string{"rollback tx1", "rollback tx2", "get tx2", "kill tx2", "rollback tx3", "rollback tx4", "get tx4", "rollback tx5"}
+	if !cmp.Equal(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+
+	const methodName = "ForceRollback"
+	testBadOptions(t, methodName, func() error {
+		_, err := client.DatabaseAdmin.ForceRollback(ctx, "myDb", "")
+		return err
+	})
+	testBadOptions(t, methodName, func() error {
+		_, err := client.DatabaseAdmin.ForceRollback(ctx, "", "tx1")
+		return err
+	})
+}
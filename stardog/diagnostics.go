@@ -0,0 +1,137 @@
+package stardog
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// ErrServerLogNotFound is returned by [ServerAdminService.ServerLog] when the diagnostics report
+// doesn't include the server log.
+var ErrServerLogNotFound = errors.New("stardog: server log not found in diagnostics report")
+
+// serverLogName is the name of the server log file in the diagnostics report.
+const serverLogName = "stardog.log"
+
+// DiagnosticsReportOptions specifies the optional parameters to the [ServerAdminService.DiagnosticsReport] method.
+// Sections are left out by filtering the entries of the report's archive by name, which requires buffering
+// the report in memory; the full report is streamed.
+type DiagnosticsReportOptions struct {
+	// Leave out the server logs: files named *.log, including rotated logs such as stardog.log.1
+	ExcludeLogs bool
+	// Leave out metrics: files whose names contain "metrics"
+	ExcludeMetrics bool
+	// Leave out database information: files whose paths contain "database"
+	ExcludeDatabases bool
+}
+
+// excludes reports whether the report entry with the name is left out by the options.
+func (o *DiagnosticsReportOptions) excludes(name string) bool {
+	if o == nil {
+		return false
+	}
+	lower := strings.ToLower(name)
+	base := path.Base(lower)
+	switch {
+	case o.ExcludeLogs && (strings.HasSuffix(base, ".log") || strings.Contains(base, ".log.")):
+		return true
+	case o.ExcludeMetrics && strings.Contains(base, "metrics"):
+		return true
+	case o.ExcludeDatabases && strings.Contains(lower, "database"):
+		return true
+	}
+	return false
+}
+
+func (o *DiagnosticsReportOptions) filtered() bool {
+	return o != nil && (o.ExcludeLogs || o.ExcludeMetrics || o.ExcludeDatabases)
+}
+
+// DiagnosticsReport generates the server's diagnostics report, the equivalent of `stardog-admin diagnostics
+// report`, and writes it to w. The report is a ZIP archive of the server log, configuration, metrics, and
+// system and database information, suitable for attaching to a support ticket. Unless opts leaves out
+// sections, the report is streamed to w as it's received.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Server-Admin/operation/generateDiagnosticsReport
+func (s *ServerAdminService) DiagnosticsReport(ctx context.Context, w io.Writer, opts *DiagnosticsReportOptions) (*Response, error) {
+	headerOpts := requestHeaderOptions{
+		Accept: mediaTypeApplicationZip,
+	}
+	request, err := s.client.NewRequest(http.MethodGet, "admin/diagnostics", &headerOpts, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.BareDo(ctx, request)
+	if err != nil {
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+		return resp, err
+	}
+	defer resp.Body.Close()
+	if !opts.filtered() {
+		_, err = io.Copy(w, resp.Body)
+		return resp, err
+	}
+	report, err := readZip(resp.Body)
+	if err != nil {
+		return resp, err
+	}
+	writer := zip.NewWriter(w)
+	for _, file := range report.File {
+		if opts.excludes(file.Name) {
+			continue
+		}
+		if err := writer.Copy(file); err != nil {
+			return resp, err
+		}
+	}
+	return resp, writer.Close()
+}
+
+// readZip buffers the ZIP archive read from r in memory and opens it.
+func readZip(r io.Reader) (*zip.Reader, error) {
+	var archive bytes.Buffer
+	if _, err := io.Copy(&archive, r); err != nil {
+		return nil, err
+	}
+	return zip.NewReader(bytes.NewReader(archive.Bytes()), int64(archive.Len()))
+}
+
+// ServerLog returns the contents of the server log (stardog.log), extracted from the server's
+// [ServerAdminService.DiagnosticsReport]. The report is buffered in memory to read it.
+// ErrServerLogNotFound is returned if the report doesn't include the log.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Server-Admin/operation/generateDiagnosticsReport
+func (s *ServerAdminService) ServerLog(ctx context.Context) (*bytes.Buffer, *Response, error) {
+	var archive bytes.Buffer
+	resp, err := s.DiagnosticsReport(ctx, &archive, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+	report, err := readZip(&archive)
+	if err != nil {
+		return nil, resp, err
+	}
+	for _, file := range report.File {
+		if path.Base(file.Name) != serverLogName {
+			continue
+		}
+		log, err := file.Open()
+		if err != nil {
+			return nil, resp, err
+		}
+		defer log.Close()
+		var buf bytes.Buffer
+		if _, err := io.Copy(&buf, log); err != nil {
+			return nil, resp, err
+		}
+		return &buf, resp, nil
+	}
+	return nil, resp, ErrServerLogNotFound
+}
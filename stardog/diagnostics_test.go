@@ -0,0 +1,155 @@
+package stardog
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// newTestZip returns a ZIP archive of the files, keyed by name.
+func newTestZip(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for name, content := range files {
+		file, err := writer.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		file.Write([]byte(content))
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestServerAdminService_DiagnosticsReport(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	report := newTestZip(t, map[string]string{"diagnostics/stardog.log": "INFO started\n"})
+	mux.HandleFunc("/admin/diagnostics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeApplicationZip)
+		w.Write(report)
+	})
+
+	ctx := context.Background()
+	var got bytes.Buffer
+	if _, err := client.ServerAdmin.DiagnosticsReport(ctx, &got, nil); err != nil {
+		t.Fatalf("ServerAdmin.DiagnosticsReport returned error: %v", err)
+	}
+	if !bytes.Equal(got.Bytes(), report) {
+		t.Errorf("ServerAdmin.DiagnosticsReport wrote %d bytes, want the %d byte report", got.Len(), len(report))
+	}
+
+	const methodName = "DiagnosticsReport"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.ServerAdmin.DiagnosticsReport(nil, io.Discard, nil)
+	})
+}
+
+func TestServerAdminService_DiagnosticsReport_excludeSections(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	report := newTestZip(t, map[string]string{
+		"diagnostics/stardog.log":          "INFO started\n",
+		"diagnostics/stardog.log.1":        "INFO stopped\n",
+		"diagnostics/metrics.json":         "{}",
+		"diagnostics/databases/db1.json":   "{}",
+		"diagnostics/stardog.properties":   "query.timeout=5m\n",
+		"diagnostics/system/processes.txt": "java\n",
+	})
+	mux.HandleFunc("/admin/diagnostics", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(report)
+	})
+
+	tests := map[string]struct {
+		opts *DiagnosticsReportOptions
+		want []string
+	}{
+		"logs": {
+			opts: &DiagnosticsReportOptions{ExcludeLogs: true},
+			want: []string{"diagnostics/databases/db1.json", "diagnostics/metrics.json", "diagnostics/stardog.properties", "diagnostics/system/processes.txt"},
+		},
+		"metrics and databases": {
+			opts: &DiagnosticsReportOptions{ExcludeMetrics: true, ExcludeDatabases: true},
+			want: []string{"diagnostics/stardog.log", "diagnostics/stardog.log.1", "diagnostics/stardog.properties", "diagnostics/system/processes.txt"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if _, err := client.ServerAdmin.DiagnosticsReport(context.Background(), &buf, tt.opts); err != nil {
+				t.Fatalf("ServerAdmin.DiagnosticsReport returned error: %v", err)
+			}
+			reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+			if err != nil {
+				t.Fatalf("report isn't a ZIP archive: %v", err)
+			}
+			var got []string
+			for _, file := range reader.File {
+				got = append(got, file.Name)
+			}
+			sort.Strings(got)
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("report entries = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServerAdminService_ServerLog(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	report := newTestZip(t, map[string]string{
+		"diagnostics/stardog.properties": "query.timeout=5m\n",
+		"diagnostics/stardog.log":        "INFO started\n",
+	})
+	mux.HandleFunc("/admin/diagnostics", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(report)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.ServerAdmin.ServerLog(ctx)
+	if err != nil {
+		t.Fatalf("ServerAdmin.ServerLog returned error: %v", err)
+	}
+	if want := "INFO started\n"; got.String() != want {
+		t.Errorf("ServerAdmin.ServerLog = %q, want %q", got, want)
+	}
+
+	const methodName = "ServerLog"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.ServerAdmin.ServerLog(nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestServerAdminService_ServerLog_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	report := newTestZip(t, map[string]string{"diagnostics/stardog.properties": "query.timeout=5m\n"})
+	mux.HandleFunc("/admin/diagnostics", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(report)
+	})
+
+	_, _, err := client.ServerAdmin.ServerLog(context.Background())
+	if !errors.Is(err, ErrServerLogNotFound) {
+		t.Errorf("ServerAdmin.ServerLog error = %v, want ErrServerLogNotFound", err)
+	}
+}
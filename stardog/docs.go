@@ -0,0 +1,145 @@
+package stardog
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// DocsService handles communication with the [document storage] (BITES) related methods of the Stardog API.
+//
+// [document storage]: https://docs.stardog.com/unstructured-data/bites
+type DocsService service
+
+// docsUploadField is the form field the server reads a document from.
+const docsUploadField = "upload"
+
+// PutDocumentOptions specifies the optional parameters to the [DocsService.Put] method.
+type PutDocumentOptions struct {
+	// The names of the extractors used to extract RDF from the document and add it to the database,
+	// such as "tika", "entities", "linker" or "dictionary", or the name of a custom extractor. If empty,
+	// no RDF is extracted.
+	RDFExtractors []string `url:"rdf-extractors,omitempty,comma"`
+	// The name of the extractor used to extract text from the document for full-text search, such as
+	// "tika", or the name of a custom extractor. If empty, no text is extracted.
+	TextExtractor string `url:"text-extractor,omitempty"`
+	// The number of bytes that will be read from the document, used as the length of the request.
+	// If zero, the length is unknown and the request is chunked.
+	Size int64 `url:"-"`
+}
+
+// Put adds the document read from r to the database's document store, named name, replacing any
+// document with the same name. The document is streamed to the server as it's read. Any RDF and
+// text extracted from it are added to the database as specified by opts.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/BITES/operation/putDocument
+func (s *DocsService) Put(ctx context.Context, database string, name string, r io.Reader, opts *PutDocumentOptions) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	if name == "" {
+		return nil, &ValidationError{Field: "name", Value: name, Constraint: "must not be empty"}
+	}
+	u, err := addOptions(fmt.Sprintf("%s/docs", database), opts)
+	if err != nil {
+		return nil, err
+	}
+	size := int64(-1)
+	if opts != nil && opts.Size > 0 {
+		size = opts.Size
+	}
+	body := newMultipartUpload(nil, []uploadFile{{name: name, field: docsUploadField, r: r, size: size}})
+	defer body.Close()
+	headerOpts := &requestHeaderOptions{
+		ContentType: body.ContentType(),
+	}
+	req, err := s.client.NewMultipartFormDataRequest(http.MethodPost, u, headerOpts, body)
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = body.Size()
+	return s.client.Do(ctx, req, nil)
+}
+
+// Get returns the contents of the named document in the database's document store.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/BITES/operation/getDocument
+func (s *DocsService) Get(ctx context.Context, database string, name string) (*bytes.Buffer, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("%s/docs/%s", database, url.PathEscape(name))
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var buf bytes.Buffer
+	resp, err := s.client.Do(ctx, req, &buf)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &buf, resp, nil
+}
+
+// Delete removes the named document from the database's document store. RDF extracted from the
+// document when it was added isn't removed.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/BITES/operation/deleteDocument
+func (s *DocsService) Delete(ctx context.Context, database string, name string) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("%s/docs/%s", database, url.PathEscape(name))
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// Size returns the number of documents in the database's document store.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/BITES/operation/docsSize
+func (s *DocsService) Size(ctx context.Context, database string) (int64, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return 0, nil, err
+	}
+	u := fmt.Sprintf("%s/docs/size", database)
+	headerOpts := requestHeaderOptions{
+		Accept: mediaTypePlainText,
+	}
+	req, err := s.client.NewRequest(http.MethodGet, u, &headerOpts, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	var buf bytes.Buffer
+	resp, err := s.client.Do(ctx, req, &buf)
+	if err != nil {
+		return 0, resp, err
+	}
+	size, err := strconv.ParseInt(strings.TrimSpace(buf.String()), 10, 64)
+	if err != nil {
+		return 0, resp, fmt.Errorf("parsing document store size: %w", err)
+	}
+	return size, resp, nil
+}
+
+// Clear removes all documents from the database's document store.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/BITES/operation/clearDocstore
+func (s *DocsService) Clear(ctx context.Context, database string) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("%s/docs", database)
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
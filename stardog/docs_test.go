@@ -0,0 +1,169 @@
+package stardog
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDocsService_Put(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	content := "The quick brown fox"
+	mux.HandleFunc("/db1/docs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if got, want := r.URL.Query().Get("rdf-extractors"), "tika,entities"; got != want {
+			t.Errorf("rdf-extractors parameter = %q, want %q", got, want)
+		}
+		if got, want := r.URL.Query().Get("text-extractor"), "tika"; got != want {
+			t.Errorf("text-extractor parameter = %q, want %q", got, want)
+		}
+		if r.ContentLength <= 0 {
+			t.Errorf("Content-Length = %d, want the length of the body", r.ContentLength)
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("ParseMultipartForm returned error: %v", err)
+		}
+		file, header, err := r.FormFile("upload")
+		if err != nil {
+			t.Fatalf("FormFile returned error: %v", err)
+		}
+		defer file.Close()
+		if got, want := header.Filename, "fox.txt"; got != want {
+			t.Errorf("filename = %q, want %q", got, want)
+		}
+		got, _ := io.ReadAll(file)
+		if string(got) != content {
+			t.Errorf("document = %q, want %q", got, content)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	opts := &PutDocumentOptions{
+		RDFExtractors: []string{"tika", "entities"},
+		TextExtractor: "tika",
+		Size:          int64(len(content)),
+	}
+	_, err := client.Docs.Put(ctx, "db1", "fox.txt", strings.NewReader(content), opts)
+	if err != nil {
+		t.Errorf("Docs.Put returned error: %v", err)
+	}
+
+	const methodName = "Put"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Docs.Put(nil, "db1", "fox.txt", strings.NewReader(content), nil)
+	})
+}
+
+func TestDocsService_Put_invalid(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	if _, err := client.Docs.Put(ctx, "", "fox.txt", strings.NewReader(""), nil); err == nil {
+		t.Errorf("Docs.Put returned nil error for an empty database name")
+	}
+	if _, err := client.Docs.Put(ctx, "db1", "", strings.NewReader(""), nil); err == nil {
+		t.Errorf("Docs.Put returned nil error for an empty document name")
+	}
+}
+
+func TestDocsService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/db1/docs/fox report.txt", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, "The quick brown fox")
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Docs.Get(ctx, "db1", "fox report.txt")
+	if err != nil {
+		t.Errorf("Docs.Get returned error: %v", err)
+	}
+	if want := bytes.NewBufferString("The quick brown fox"); got.String() != want.String() {
+		t.Errorf("Docs.Get = %q, want %q", got, want)
+	}
+
+	const methodName = "Get"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Docs.Get(nil, "db1", "fox report.txt")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestDocsService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/db1/docs/fox.txt", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Docs.Delete(ctx, "db1", "fox.txt"); err != nil {
+		t.Errorf("Docs.Delete returned error: %v", err)
+	}
+
+	const methodName = "Delete"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Docs.Delete(nil, "db1", "fox.txt")
+	})
+}
+
+func TestDocsService_Size(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/db1/docs/size", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypePlainText)
+		fmt.Fprint(w, "42\n")
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Docs.Size(ctx, "db1")
+	if err != nil {
+		t.Errorf("Docs.Size returned error: %v", err)
+	}
+	if want := int64(42); got != want {
+		t.Errorf("Docs.Size = %d, want %d", got, want)
+	}
+
+	const methodName = "Size"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		_, resp, err := client.Docs.Size(nil, "db1")
+		return resp, err
+	})
+}
+
+func TestDocsService_Clear(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/db1/docs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Docs.Clear(ctx, "db1"); err != nil {
+		t.Errorf("Docs.Clear returned error: %v", err)
+	}
+
+	const methodName = "Clear"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Docs.Clear(nil, "db1")
+	})
+}
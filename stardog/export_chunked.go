@@ -0,0 +1,148 @@
+package stardog
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// defaultGraph is the IRI Stardog uses to refer to the default graph of a database.
+const defaultGraph = "tag:stardog:api:context:default"
+
+// ChunkedExportOptions specifies the optional parameters to the [DatabaseAdminService.ExportChunked] method.
+type ChunkedExportOptions struct {
+	// The graphs to export, one chunk per graph. If empty, the default graph and every named graph in the database are exported.
+	NamedGraphs []string
+
+	// The RDF format for the exported data
+	Format RDFFormat
+
+	// A resume token returned by a previous, interrupted call to ExportChunked. If provided, only the graphs that
+	// hadn't been exported yet are exported and NamedGraphs is ignored.
+	ResumeToken string
+}
+
+// ExportChunk is a chunk of a database export produced by [DatabaseAdminService.ExportChunked].
+type ExportChunk struct {
+	// The graph the chunk contains
+	NamedGraph string
+	// The RDF data of the graph. It must be fully read before the handler returns.
+	Data io.Reader
+}
+
+// exportResumeToken is the decoded form of the resume token returned by ExportChunked.
+type exportResumeToken struct {
+	Database  string   `json:"db"`
+	Remaining []string `json:"remaining"`
+}
+
+func (t exportResumeToken) encode() (string, error) {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeExportResumeToken(token string) (*exportResumeToken, error) {
+	invalid := &ValidationError{Field: "ChunkedExportOptions.ResumeToken", Value: token, Constraint: "must be a token returned by ExportChunked"}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalid
+	}
+	var t exportResumeToken
+	if err := json.Unmarshal(b, &t); err != nil {
+		return nil, invalid
+	}
+	return &t, nil
+}
+
+// ExportChunked exports the database one graph at a time, passing each graph to handle as it's streamed
+// from the server. This allows exports of databases too large to export in a single request to be split up
+// and continued if they're interrupted.
+//
+// Before each chunk is exported the context is checked, so an export running against a deadline stops
+// once it expires. If the export stops before every graph is exported, because the context is done,
+// a request fails or handle returns an error, a resume token is returned along with the error. Passing it
+// in ChunkedExportOptions.ResumeToken continues the export from the graph that was interrupted.
+// Once every graph has been exported, the returned resume token is empty.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/exportDatabase
+func (s *DatabaseAdminService) ExportChunked(ctx context.Context, database string, opts *ChunkedExportOptions, handle func(ExportChunk) error) (string, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return "", err
+	}
+	if err := opts.validate(); err != nil {
+		return "", err
+	}
+	if handle == nil {
+		return "", &ValidationError{Field: "handle", Value: nil, Constraint: "must not be nil"}
+	}
+	if ctx == nil {
+		return "", errNonNilContext
+	}
+
+	var format RDFFormat
+	var graphs []string
+	if opts != nil {
+		format = opts.Format
+		graphs = opts.NamedGraphs
+	}
+	if opts != nil && opts.ResumeToken != "" {
+		token, err := decodeExportResumeToken(opts.ResumeToken)
+		if err != nil {
+			return "", err
+		}
+		if token.Database != database {
+			return "", &ValidationError{Field: "ChunkedExportOptions.ResumeToken", Value: opts.ResumeToken, Constraint: fmt.Sprintf("must be for database %q", database)}
+		}
+		graphs = token.Remaining
+	} else if len(graphs) == 0 {
+		namedGraphs, err := s.listNamedGraphs(ctx, database)
+		if err != nil {
+			return "", err
+		}
+		graphs = append([]string{defaultGraph}, namedGraphs...)
+	}
+
+	for i, graph := range graphs {
+		if err := s.exportChunk(ctx, database, graph, format, handle); err != nil {
+			token, tokenErr := exportResumeToken{Database: database, Remaining: graphs[i:]}.encode()
+			if tokenErr != nil {
+				return "", tokenErr
+			}
+			return token, err
+		}
+	}
+	return "", nil
+}
+
+// exportChunk exports a single graph and passes it to handle.
+func (s *DatabaseAdminService) exportChunk(ctx context.Context, database string, graph string, format RDFFormat, handle func(ExportChunk) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	body, _, err := s.ExportDataStream(ctx, database, &ExportDataOptions{NamedGraph: []string{graph}, Format: format})
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	return handle(ExportChunk{NamedGraph: graph, Data: body})
+}
+
+// listNamedGraphs returns the named graphs in the database.
+func (s *DatabaseAdminService) listNamedGraphs(ctx context.Context, database string) ([]string, error) {
+	results, _, err := s.client.Sparql.SelectResultSet(withoutSelectLimit(ctx), database, "SELECT DISTINCT ?g { GRAPH ?g {} }", nil)
+	if err != nil {
+		return nil, err
+	}
+	graphs := make([]string, 0, len(results.Bindings))
+	for _, binding := range results.Bindings {
+		if g, ok := binding["g"]; ok && g.Value != defaultGraph {
+			graphs = append(graphs, g.Value)
+		}
+	}
+	return graphs, nil
+}
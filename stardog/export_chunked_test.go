@@ -0,0 +1,165 @@
+package stardog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDatabaseAdminService_ExportChunked(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	mux.HandleFunc(fmt.Sprintf("/%s/query", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"head":{"vars":["g"]},"results":{"bindings":[
+			{"g":{"type":"uri","value":"urn:graph:people"}},
+			{"g":{"type":"uri","value":"urn:graph:movies"}}
+		]}}`))
+	})
+	mux.HandleFunc(fmt.Sprintf("/%s/export", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", RDFFormatNTriples.String())
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, "data for %s", r.URL.Query().Get("named-graph-uri"))
+	})
+
+	ctx := context.Background()
+	opts := &ChunkedExportOptions{Format: RDFFormatNTriples}
+
+	// fail while handling the second chunk
+	errHandler := errors.New("disk full")
+	var got []string
+	handle := func(chunk ExportChunk) error {
+		data, err := io.ReadAll(chunk.Data)
+		if err != nil {
+			return err
+		}
+		if chunk.NamedGraph == "urn:graph:people" && len(got) == 1 {
+			return errHandler
+		}
+		got = append(got, string(data))
+		return nil
+	}
+	token, err := client.DatabaseAdmin.ExportChunked(ctx, db, opts, handle)
+	if !errors.Is(err, errHandler) {
+		t.Errorf("DatabaseAdmin.ExportChunked error = %v, want %v", err, errHandler)
+	}
+	if token == "" {
+		t.Fatalf("DatabaseAdmin.ExportChunked resume token is empty")
+	}
+
+	// resume from the interrupted chunk
+	handle = func(chunk ExportChunk) error {
+		data, err := io.ReadAll(chunk.Data)
+		got = append(got, string(data))
+		return err
+	}
+	opts.ResumeToken = token
+	token, err = client.DatabaseAdmin.ExportChunked(ctx, db, opts, handle)
+	if err != nil {
+		t.Errorf("DatabaseAdmin.ExportChunked returned error: %v", err)
+	}
+	if token != "" {
+		t.Errorf("DatabaseAdmin.ExportChunked resume token = %q, want empty", token)
+	}
+
+	want := []string{
+		"data for " + defaultGraph,
+		"data for urn:graph:people",
+		"data for urn:graph:movies",
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("DatabaseAdmin.ExportChunked chunks = %+v, want %+v", got, want)
+	}
+}
+
+func TestDatabaseAdminService_ExportChunked_maxSelectResults(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.MaxSelectResults = 1
+
+	mux.HandleFunc("/db1/query", func(w http.ResponseWriter, r *http.Request) {
+		if limit := r.URL.Query().Get("limit"); limit != "" {
+			t.Errorf("listing the named graphs sent limit=%s, want no limit", limit)
+		}
+		w.Write([]byte(`{"head":{"vars":["g"]},"results":{"bindings":[
+			{"g":{"type":"uri","value":"urn:graph:people"}},
+			{"g":{"type":"uri","value":"urn:graph:movies"}}
+		]}}`))
+	})
+	mux.HandleFunc("/db1/export", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, r.URL.Query().Get("named-graph-uri"))
+	})
+
+	var got []string
+	handle := func(chunk ExportChunk) error {
+		got = append(got, chunk.NamedGraph)
+		return nil
+	}
+	if _, err := client.DatabaseAdmin.ExportChunked(context.Background(), "db1", nil, handle); err != nil {
+		t.Fatalf("DatabaseAdmin.ExportChunked returned error: %v", err)
+	}
+	if want := []string{defaultGraph, "urn:graph:people", "urn:graph:movies"}; !cmp.Equal(got, want) {
+		t.Errorf("DatabaseAdmin.ExportChunked graphs = %v, want %v", got, want)
+	}
+}
+
+func TestDatabaseAdminService_ExportChunked_contextDone(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	mux.HandleFunc(fmt.Sprintf("/%s/export", db), func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("export request sent after the context was canceled")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	opts := &ChunkedExportOptions{NamedGraphs: []string{"urn:graph:people"}}
+	token, err := client.DatabaseAdmin.ExportChunked(ctx, db, opts, func(ExportChunk) error { return nil })
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("DatabaseAdmin.ExportChunked error = %v, want %v", err, context.Canceled)
+	}
+	resume, err := decodeExportResumeToken(token)
+	if err != nil {
+		t.Fatalf("decodeExportResumeToken returned error: %v", err)
+	}
+	if want := (&exportResumeToken{Database: db, Remaining: []string{"urn:graph:people"}}); !cmp.Equal(resume, want) {
+		t.Errorf("resume token = %+v, want %+v", resume, want)
+	}
+}
+
+func TestDatabaseAdminService_ExportChunked_invalidOptions(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	otherDBToken, _ := exportResumeToken{Database: "db2", Remaining: []string{"urn:g"}}.encode()
+	handle := func(ExportChunk) error { return nil }
+	tests := []struct {
+		name   string
+		opts   *ChunkedExportOptions
+		handle func(ExportChunk) error
+	}{
+		{name: "bad format", opts: &ChunkedExportOptions{Format: RDFFormat(100)}, handle: handle},
+		{name: "malformed token", opts: &ChunkedExportOptions{ResumeToken: "%%%"}, handle: handle},
+		{name: "token for another database", opts: &ChunkedExportOptions{ResumeToken: otherDBToken}, handle: handle},
+		{name: "nil handler", opts: nil, handle: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.DatabaseAdmin.ExportChunked(context.Background(), "db1", tt.opts, tt.handle)
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Errorf("DatabaseAdmin.ExportChunked error = %v, want *ValidationError", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package stardog
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+)
+
+// databaseOptionLastTransaction is the read-only database configuration option
+// containing the ID of the last transaction committed to the database.
+const databaseOptionLastTransaction = "index.last.tx"
+
+// ExportCursorStore persists the ID of the last transaction exported for each database
+// by an [ExportCursor]. Implementations must be safe for concurrent use.
+type ExportCursorStore interface {
+	// LastTransaction returns the ID of the last transaction exported for the database.
+	// If nothing has been exported for the database yet, an empty string should be returned.
+	LastTransaction(ctx context.Context, database string) (string, error)
+	// SetLastTransaction records the ID of the last transaction exported for the database.
+	SetLastTransaction(ctx context.Context, database string, txID string) error
+}
+
+// MemoryExportCursorStore is an [ExportCursorStore] that keeps its state in memory.
+// The zero value is ready to use.
+type MemoryExportCursorStore struct {
+	mu           sync.RWMutex
+	transactions map[string]string
+}
+
+// LastTransaction implements ExportCursorStore.
+func (m *MemoryExportCursorStore) LastTransaction(ctx context.Context, database string) (string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.transactions[database], nil
+}
+
+// SetLastTransaction implements ExportCursorStore.
+func (m *MemoryExportCursorStore) SetLastTransaction(ctx context.Context, database string, txID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.transactions == nil {
+		m.transactions = make(map[string]string)
+	}
+	m.transactions[database] = txID
+	return nil
+}
+
+// ExportCursor exports a database only if it has changed since the last time it was exported
+// through the cursor. It is intended as the basis for incremental backup tooling.
+type ExportCursor struct {
+	client *Client
+	store  ExportCursorStore
+}
+
+// CursorExport is the result of [ExportCursor.Export].
+type CursorExport struct {
+	// The database that was checked for changes
+	Database string
+	// The ID of the last transaction committed to the database
+	TxID string
+	// Whether the database changed since the last export. If false, nothing was exported.
+	Changed bool
+	// The exported data (or server side export details). Nil if Changed is false.
+	Data *bytes.Buffer
+}
+
+// NewExportCursor returns a new ExportCursor that uses the client to export data and
+// the store to persist the last exported transaction for each database.
+// If a nil store is provided, a new [MemoryExportCursorStore] will be used.
+func NewExportCursor(client *Client, store ExportCursorStore) *ExportCursor {
+	if store == nil {
+		store = &MemoryExportCursorStore{}
+	}
+	return &ExportCursor{client: client, store: store}
+}
+
+// Export exports data from the database using [DatabaseAdminService.ExportData] if the last transaction
+// committed to the database differs from the one recorded in the cursor's store. After a successful
+// export, the store is updated with the exported transaction.
+func (c *ExportCursor) Export(ctx context.Context, database string, opts *ExportDataOptions) (*CursorExport, *Response, error) {
+	txID, resp, err := c.lastCommittedTransaction(ctx, database)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	lastExported, err := c.store.LastTransaction(ctx, database)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	result := &CursorExport{Database: database, TxID: txID}
+	if lastExported != "" && lastExported == txID {
+		return result, resp, nil
+	}
+
+	data, resp, err := c.client.DatabaseAdmin.ExportData(ctx, database, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	if err := c.store.SetLastTransaction(ctx, database, txID); err != nil {
+		return nil, resp, err
+	}
+	result.Changed = true
+	result.Data = data
+	return result, resp, nil
+}
+
+// lastCommittedTransaction returns the ID of the last transaction committed to the database.
+func (c *ExportCursor) lastCommittedTransaction(ctx context.Context, database string) (string, *Response, error) {
+	metadata, resp, err := c.client.DatabaseAdmin.Metadata(ctx, database, []string{databaseOptionLastTransaction})
+	if err != nil {
+		return "", resp, err
+	}
+	txID, ok := metadata[databaseOptionLastTransaction].(string)
+	if !ok {
+		return "", resp, fmt.Errorf("unable to determine the last transaction for database %q", database)
+	}
+	return txID, resp, nil
+}
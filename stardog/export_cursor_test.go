@@ -0,0 +1,129 @@
+package stardog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestExportCursor_Export(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	txID := "tx-1"
+	exports := 0
+
+	mux.HandleFunc(fmt.Sprintf("/admin/databases/%s/options", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"index.last.tx":""}`+"\n")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"index.last.tx":%q}`, txID)
+	})
+	mux.HandleFunc(fmt.Sprintf("/%s/export", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		exports++
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, "<urn:a> <urn:b> <urn:c> .")
+	})
+
+	ctx := context.Background()
+	store := &MemoryExportCursorStore{}
+	cursor := NewExportCursor(client, store)
+
+	got, _, err := cursor.Export(ctx, db, nil)
+	if err != nil {
+		t.Fatalf("ExportCursor.Export returned error: %v", err)
+	}
+	if !got.Changed || got.Data == nil || got.Data.String() != "<urn:a> <urn:b> <urn:c> ." || got.TxID != txID {
+		t.Errorf("ExportCursor.Export = %+v, want changed export of %v", got, txID)
+	}
+	if last, _ := store.LastTransaction(ctx, db); last != txID {
+		t.Errorf("store.LastTransaction = %v, want %v", last, txID)
+	}
+
+	got, _, err = cursor.Export(ctx, db, nil)
+	if err != nil {
+		t.Fatalf("ExportCursor.Export returned error: %v", err)
+	}
+	if got.Changed || got.Data != nil {
+		t.Errorf("ExportCursor.Export = %+v, want unchanged", got)
+	}
+	if exports != 1 {
+		t.Errorf("export endpoint called %d times, want 1", exports)
+	}
+
+	txID = "tx-2"
+	got, _, err = cursor.Export(ctx, db, nil)
+	if err != nil {
+		t.Fatalf("ExportCursor.Export returned error: %v", err)
+	}
+	if !got.Changed || got.TxID != "tx-2" {
+		t.Errorf("ExportCursor.Export = %+v, want changed export of tx-2", got)
+	}
+	if exports != 2 {
+		t.Errorf("export endpoint called %d times, want 2", exports)
+	}
+}
+
+func TestExportCursor_Export_missingTransaction(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	mux.HandleFunc(fmt.Sprintf("/admin/databases/%s/options", db), func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, _, err := NewExportCursor(client, nil).Export(context.Background(), db, nil)
+	if err == nil {
+		t.Errorf("ExportCursor.Export err = nil, want error")
+	}
+}
+
+type failingExportCursorStore struct {
+	MemoryExportCursorStore
+}
+
+func (f *failingExportCursorStore) SetLastTransaction(ctx context.Context, database string, txID string) error {
+	return errors.New("store unavailable")
+}
+
+func TestExportCursor_Export_storeError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	mux.HandleFunc(fmt.Sprintf("/admin/databases/%s/options", db), func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"index.last.tx":"tx-1"}`)
+	})
+	mux.HandleFunc(fmt.Sprintf("/%s/export", db), func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	got, _, err := NewExportCursor(client, &failingExportCursorStore{}).Export(context.Background(), db, nil)
+	if err == nil {
+		t.Errorf("ExportCursor.Export err = nil, want error")
+	}
+	if got != nil {
+		t.Errorf("ExportCursor.Export = %#v, want nil", got)
+	}
+}
+
+func TestExportCursor_Export_requestFailure(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	const methodName = "ExportCursor.Export"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := NewExportCursor(client, nil).Export(nil, "db1", nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
@@ -0,0 +1,121 @@
+package stardog
+
+import (
+	"strings"
+	"sync"
+)
+
+// registeredFormat is a format added with RegisterRDFFormat or RegisterQueryResultFormat.
+type registeredFormat struct {
+	mediaType string
+	// file extensions, without a leading dot, of which the first is used when naming files
+	extensions []string
+}
+
+// formatRegistry holds the registered formats. The value of a registered format is the number of built-in
+// values plus its index.
+var formatRegistry struct {
+	sync.RWMutex
+	rdfFormats         []registeredFormat
+	queryResultFormats []registeredFormat
+}
+
+// RegisterRDFFormat adds an RDF format, such as one supported by a newer version of Stardog than this
+// package knows about, and returns the RDFFormat for it. The format can then be used anywhere an RDFFormat
+// is accepted, and is sent as the media type in Accept and Content-Type headers. Its extensions (e.g.
+// "ttlx", without a leading dot) are recognized by [GetRDFFormatFromExtension].
+//
+// Registering a media type that's already known returns its existing RDFFormat. Formats are typically
+// registered once, when the program starts.
+func RegisterRDFFormat(mediaType string, extensions ...string) (RDFFormat, error) {
+	if mediaType == "" {
+		return RDFFormatUnknown, &ValidationError{Field: "mediaType", Value: mediaType, Constraint: "must not be empty"}
+	}
+	for i, value := range rdfFormatValues {
+		if i != int(RDFFormatUnknown) && value == mediaType {
+			return RDFFormat(i), nil
+		}
+	}
+	formatRegistry.Lock()
+	defer formatRegistry.Unlock()
+	for i, format := range formatRegistry.rdfFormats {
+		if format.mediaType == mediaType {
+			return RDFFormat(len(rdfFormatValues) + i), nil
+		}
+	}
+	formatRegistry.rdfFormats = append(formatRegistry.rdfFormats, registeredFormat{mediaType: mediaType, extensions: normalizeExtensions(extensions)})
+	return RDFFormat(len(rdfFormatValues) + len(formatRegistry.rdfFormats) - 1), nil
+}
+
+// RegisterQueryResultFormat adds a query result format, such as one supported by a newer version of
+// Stardog than this package knows about, and returns the QueryResultFormat for it. The format is sent
+// as the media type in the Accept header of queries that request it.
+//
+// Registering a media type that's already known returns its existing QueryResultFormat. Formats are
+// typically registered once, when the program starts.
+func RegisterQueryResultFormat(mediaType string) (QueryResultFormat, error) {
+	if mediaType == "" {
+		return QueryResultFormatUnknown, &ValidationError{Field: "mediaType", Value: mediaType, Constraint: "must not be empty"}
+	}
+	for i, value := range queryResultFormatValues {
+		if i != int(QueryResultFormatUnknown) && value == mediaType {
+			return QueryResultFormat(i), nil
+		}
+	}
+	formatRegistry.Lock()
+	defer formatRegistry.Unlock()
+	for i, format := range formatRegistry.queryResultFormats {
+		if format.mediaType == mediaType {
+			return QueryResultFormat(len(queryResultFormatValues) + i), nil
+		}
+	}
+	formatRegistry.queryResultFormats = append(formatRegistry.queryResultFormats, registeredFormat{mediaType: mediaType})
+	return QueryResultFormat(len(queryResultFormatValues) + len(formatRegistry.queryResultFormats) - 1), nil
+}
+
+func normalizeExtensions(extensions []string) []string {
+	normalized := make([]string, 0, len(extensions))
+	for _, extension := range extensions {
+		if extension = strings.ToLower(strings.TrimPrefix(extension, ".")); extension != "" {
+			normalized = append(normalized, extension)
+		}
+	}
+	return normalized
+}
+
+// registeredRDFFormat returns the registered format for an RDFFormat past the built-in ones.
+func registeredRDFFormat(r RDFFormat) (registeredFormat, bool) {
+	i := int(r) - len(rdfFormatValues)
+	formatRegistry.RLock()
+	defer formatRegistry.RUnlock()
+	if i < 0 || i >= len(formatRegistry.rdfFormats) {
+		return registeredFormat{}, false
+	}
+	return formatRegistry.rdfFormats[i], true
+}
+
+// registeredQueryResultFormat returns the registered format for a QueryResultFormat past the built-in ones.
+func registeredQueryResultFormat(q QueryResultFormat) (registeredFormat, bool) {
+	i := int(q) - len(queryResultFormatValues)
+	formatRegistry.RLock()
+	defer formatRegistry.RUnlock()
+	if i < 0 || i >= len(formatRegistry.queryResultFormats) {
+		return registeredFormat{}, false
+	}
+	return formatRegistry.queryResultFormats[i], true
+}
+
+// registeredRDFFormatForExtension returns the registered RDFFormat with the extension, if any.
+func registeredRDFFormatForExtension(extension string) (RDFFormat, bool) {
+	extension = strings.ToLower(extension)
+	formatRegistry.RLock()
+	defer formatRegistry.RUnlock()
+	for i, format := range formatRegistry.rdfFormats {
+		for _, e := range format.extensions {
+			if e == extension {
+				return RDFFormat(len(rdfFormatValues) + i), true
+			}
+		}
+	}
+	return RDFFormatUnknown, false
+}
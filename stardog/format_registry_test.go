@@ -0,0 +1,72 @@
+package stardog
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestRegisterRDFFormat(t *testing.T) {
+	format, err := RegisterRDFFormat("application/x-test-rdf", ".TTLX", "ttlx2")
+	if err != nil {
+		t.Fatalf("RegisterRDFFormat returned error: %v", err)
+	}
+	if !format.Valid() {
+		t.Errorf("registered RDFFormat is not valid")
+	}
+	if got, want := format.String(), "application/x-test-rdf"; got != want {
+		t.Errorf("RDFFormat.String = %q, want %q", got, want)
+	}
+	if got, want := format.extension(), "ttlx"; got != want {
+		t.Errorf("RDFFormat.extension = %q, want %q", got, want)
+	}
+	for _, path := range []string{"data.ttlx", "data.ttlx2", "data.ttlx.gz"} {
+		if got, err := GetRDFFormatFromExtension(path); err != nil || got != format {
+			t.Errorf("GetRDFFormatFromExtension(%q) = %v, %v, want the registered format", path, got, err)
+		}
+	}
+
+	again, err := RegisterRDFFormat("application/x-test-rdf")
+	if err != nil || again != format {
+		t.Errorf("RegisterRDFFormat of a registered media type = %v, %v, want %v", again, err, format)
+	}
+	builtin, err := RegisterRDFFormat(mediaTypeTextTurtle)
+	if err != nil || builtin != RDFFormatTurtle {
+		t.Errorf("RegisterRDFFormat of a built-in media type = %v, %v, want RDFFormatTurtle", builtin, err)
+	}
+	if unregistered := format + 1; unregistered.Valid() || unregistered.String() != RDFFormatUnknown.String() {
+		t.Errorf("unregistered RDFFormat %d is valid", unregistered)
+	}
+	testBadOptions(t, "RegisterRDFFormat", func() error {
+		_, err := RegisterRDFFormat("")
+		return err
+	})
+}
+
+func TestRegisterQueryResultFormat(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	format, err := RegisterQueryResultFormat("application/x-test-results")
+	if err != nil {
+		t.Fatalf("RegisterQueryResultFormat returned error: %v", err)
+	}
+	if !format.Valid() || format.String() != "application/x-test-results" {
+		t.Errorf("registered QueryResultFormat = %v (valid %t), want application/x-test-results", format, format.Valid())
+	}
+	builtin, err := RegisterQueryResultFormat(mediaTypeTextCSV)
+	if err != nil || builtin != QueryResultFormatCSV {
+		t.Errorf("RegisterQueryResultFormat of a built-in media type = %v, %v, want QueryResultFormatCSV", builtin, err)
+	}
+
+	mux.HandleFunc("/db1/query", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Accept", "application/x-test-results")
+	})
+	if _, _, err := client.Sparql.Select(context.Background(), "db1", "SELECT * {}", &SelectOptions{ResultFormat: format}); err != nil {
+		t.Errorf("Sparql.Select returned error: %v", err)
+	}
+	testBadOptions(t, "RegisterQueryResultFormat", func() error {
+		_, err := RegisterQueryResultFormat("")
+		return err
+	})
+}
@@ -0,0 +1,204 @@
+package stardog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GraphQLService handles communication with the [GraphQL] related methods of the Stardog API.
+//
+// [GraphQL]: https://docs.stardog.com/query-stardog/graphql
+type GraphQLService service
+
+// GraphQLQueryOptions specifies the optional parameters to the [GraphQLService.Query] method
+type GraphQLQueryOptions struct {
+	// Values for the variables used in the query
+	Variables map[string]any
+	// The name of the operation to execute if the query contains multiple operations
+	OperationName string
+	// The name of the GraphQL schema to use for the query. If empty, Stardog will use the default schema.
+	Schema string
+	// Enable reasoning
+	Reasoning bool
+}
+
+// GraphQLResponse is the result of a GraphQL query.
+type GraphQLResponse struct {
+	// The data returned by the query. It can be unmarshaled into a type matching the shape of the query.
+	Data json.RawMessage `json:"data,omitempty"`
+	// Any errors encountered executing the query
+	Errors []GraphQLError `json:"errors,omitempty"`
+}
+
+// GraphQLError is an error encountered executing a GraphQL query.
+type GraphQLError struct {
+	Message   string                 `json:"message"`
+	Locations []GraphQLErrorLocation `json:"locations,omitempty"`
+	Path      []any                  `json:"path,omitempty"`
+}
+
+// GraphQLErrorLocation is the location in a GraphQL query associated with a [GraphQLError].
+type GraphQLErrorLocation struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// request for Query
+type graphQLQueryRequest struct {
+	Query         string         `json:"query"`
+	Variables     map[string]any `json:"variables,omitempty"`
+	OperationName string         `json:"operationName,omitempty"`
+}
+
+// response for ListSchemas
+type listGraphQLSchemasResponse struct {
+	Schemas []string `json:"schemas"`
+}
+
+// Stardog reads the schema and reasoning settings of a GraphQL query from these special variables
+const (
+	graphQLVariableSchema    = "@schema"
+	graphQLVariableReasoning = "@reasoning"
+)
+
+// Query executes a GraphQL query against the database.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/GraphQL/operation/postGraphQLQuery
+func (s *GraphQLService) Query(ctx context.Context, database string, query string, opts *GraphQLQueryOptions) (*GraphQLResponse, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("%s/graphql", database)
+	headerOpts := requestHeaderOptions{
+		ContentType: mediaTypeApplicationJSON,
+		Accept:      mediaTypeApplicationJSON,
+	}
+
+	reqBody := graphQLQueryRequest{
+		Query: query,
+	}
+	if opts != nil {
+		variables := make(map[string]any, len(opts.Variables)+2)
+		for k, v := range opts.Variables {
+			variables[k] = v
+		}
+		if opts.Schema != "" {
+			variables[graphQLVariableSchema] = opts.Schema
+		}
+		if opts.Reasoning {
+			variables[graphQLVariableReasoning] = true
+		}
+		if len(variables) > 0 {
+			reqBody.Variables = variables
+		}
+		reqBody.OperationName = opts.OperationName
+	}
+
+	req, err := s.client.NewRequest(http.MethodPost, u, &headerOpts, reqBody)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var graphQLResponse GraphQLResponse
+	resp, err := s.client.Do(ctx, req, &graphQLResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &graphQLResponse, resp, nil
+}
+
+// ListSchemas returns the names of all GraphQL schemas in the database.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/GraphQL/operation/listSchemas
+func (s *GraphQLService) ListSchemas(ctx context.Context, database string) ([]string, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("%s/graphql/schemas", database)
+	headerOpts := requestHeaderOptions{
+		Accept: mediaTypeApplicationJSON,
+	}
+	req, err := s.client.NewRequest(http.MethodGet, u, &headerOpts, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var listGraphQLSchemasResponse listGraphQLSchemasResponse
+	resp, err := s.client.Do(ctx, req, &listGraphQLSchemasResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+	return listGraphQLSchemasResponse.Schemas, resp, nil
+}
+
+// GetSchema returns the contents of a GraphQL schema in the database.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/GraphQL/operation/getSchema
+func (s *GraphQLService) GetSchema(ctx context.Context, database string, schema string) (*bytes.Buffer, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("%s/graphql/schemas/%s", database, schema)
+	headerOpts := requestHeaderOptions{
+		Accept: mediaTypeApplicationGraphQL,
+	}
+	req, err := s.client.NewRequest(http.MethodGet, u, &headerOpts, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var buf bytes.Buffer
+	resp, err := s.client.Do(ctx, req, &buf)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &buf, resp, nil
+}
+
+// AddSchema adds a GraphQL schema to the database.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/GraphQL/operation/addSchema
+func (s *GraphQLService) AddSchema(ctx context.Context, database string, schema string, content string) (*Response, error) {
+	return s.putSchema(ctx, database, schema, content)
+}
+
+// UpdateSchema replaces the contents of an existing GraphQL schema in the database.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/GraphQL/operation/addSchema
+func (s *GraphQLService) UpdateSchema(ctx context.Context, database string, schema string, content string) (*Response, error) {
+	return s.putSchema(ctx, database, schema, content)
+}
+
+// putSchema creates or overwrites a GraphQL schema. Stardog uses the same endpoint for adding and updating schemas.
+func (s *GraphQLService) putSchema(ctx context.Context, database string, schema string, content string) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("%s/graphql/schemas/%s", database, schema)
+	headerOpts := requestHeaderOptions{
+		ContentType: mediaTypeApplicationGraphQL,
+	}
+	req, err := s.client.NewRequest(http.MethodPut, u, &headerOpts, bytes.NewBufferString(content))
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// RemoveSchema removes a GraphQL schema from the database.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/GraphQL/operation/removeSchema
+func (s *GraphQLService) RemoveSchema(ctx context.Context, database string, schema string) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("%s/graphql/schemas/%s", database, schema)
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,219 @@
+package stardog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGraphQLService_Query(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	query := `query withVar($name: String) { Person(name: $name) { name } }`
+	opts := &GraphQLQueryOptions{
+		Variables:     map[string]any{"name": "Paul"},
+		OperationName: "withVar",
+		Schema:        "people",
+		Reasoning:     true,
+	}
+	responseJSON := `{"data":[{"name":"Paul"}]}`
+
+	mux.HandleFunc(fmt.Sprintf("/%s/graphql", db), func(w http.ResponseWriter, r *http.Request) {
+		v := new(graphQLQueryRequest)
+		json.NewDecoder(r.Body).Decode(v)
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", mediaTypeApplicationJSON)
+		testHeader(t, r, "Accept", mediaTypeApplicationJSON)
+
+		want := &graphQLQueryRequest{
+			Query: query,
+			Variables: map[string]any{
+				"name":       "Paul",
+				"@schema":    "people",
+				"@reasoning": true,
+			},
+			OperationName: "withVar",
+		}
+		if !cmp.Equal(v, want) {
+			t.Errorf("Request body = %+v, want %+v", v, want)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(responseJSON))
+	})
+
+	ctx := context.Background()
+	got, _, err := client.GraphQL.Query(ctx, db, query, opts)
+	if err != nil {
+		t.Errorf("GraphQL.Query returned error: %v", err)
+	}
+	want := &GraphQLResponse{Data: json.RawMessage(`[{"name":"Paul"}]`)}
+	if !cmp.Equal(got, want) {
+		t.Errorf("GraphQL.Query = %+v, want %+v", got, want)
+	}
+
+	const methodName = "Query"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.GraphQL.Query(nil, db, query, nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestGraphQLService_Query_errors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	responseJSON := `{"errors":[{"message":"Unknown field","locations":[{"line":1,"column":3}]}]}`
+
+	mux.HandleFunc(fmt.Sprintf("/%s/graphql", db), func(w http.ResponseWriter, r *http.Request) {
+		v := new(graphQLQueryRequest)
+		json.NewDecoder(r.Body).Decode(v)
+		if v.Variables != nil {
+			t.Errorf("Request variables = %+v, want nil", v.Variables)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(responseJSON))
+	})
+
+	got, _, err := client.GraphQL.Query(context.Background(), db, "{ Foo }", &GraphQLQueryOptions{})
+	if err != nil {
+		t.Errorf("GraphQL.Query returned error: %v", err)
+	}
+	want := &GraphQLResponse{
+		Errors: []GraphQLError{
+			{Message: "Unknown field", Locations: []GraphQLErrorLocation{{Line: 1, Column: 3}}},
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("GraphQL.Query = %+v, want %+v", got, want)
+	}
+}
+
+func TestGraphQLService_ListSchemas(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	mux.HandleFunc(fmt.Sprintf("/%s/graphql/schemas", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeApplicationJSON)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"schemas": ["people", "movies"]}`))
+	})
+
+	ctx := context.Background()
+	got, _, err := client.GraphQL.ListSchemas(ctx, db)
+	if err != nil {
+		t.Errorf("GraphQL.ListSchemas returned error: %v", err)
+	}
+	if want := []string{"people", "movies"}; !cmp.Equal(got, want) {
+		t.Errorf("GraphQL.ListSchemas = %+v, want %+v", got, want)
+	}
+
+	const methodName = "ListSchemas"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.GraphQL.ListSchemas(nil, db)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestGraphQLService_GetSchema(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	schema := "people"
+	schemaContent := "type Person { name: String }"
+
+	mux.HandleFunc(fmt.Sprintf("/%s/graphql/schemas/%s", db, schema), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeApplicationGraphQL)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(schemaContent))
+	})
+
+	ctx := context.Background()
+	got, _, err := client.GraphQL.GetSchema(ctx, db, schema)
+	if err != nil {
+		t.Errorf("GraphQL.GetSchema returned error: %v", err)
+	}
+	if want := schemaContent; !cmp.Equal(got.String(), want) {
+		t.Errorf("GraphQL.GetSchema = %+v, want %+v", got, want)
+	}
+
+	const methodName = "GetSchema"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.GraphQL.GetSchema(nil, db, schema)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestGraphQLService_AddSchema(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	schema := "people"
+	schemaContent := "type Person { name: String }"
+
+	mux.HandleFunc(fmt.Sprintf("/%s/graphql/schemas/%s", db, schema), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Content-Type", mediaTypeApplicationGraphQL)
+		testBody(t, r, schemaContent)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := context.Background()
+	_, err := client.GraphQL.AddSchema(ctx, db, schema, schemaContent)
+	if err != nil {
+		t.Errorf("GraphQL.AddSchema returned error: %v", err)
+	}
+	_, err = client.GraphQL.UpdateSchema(ctx, db, schema, schemaContent)
+	if err != nil {
+		t.Errorf("GraphQL.UpdateSchema returned error: %v", err)
+	}
+
+	const methodName = "AddSchema"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.GraphQL.AddSchema(nil, db, schema, schemaContent)
+	})
+}
+
+func TestGraphQLService_RemoveSchema(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	schema := "people"
+
+	mux.HandleFunc(fmt.Sprintf("/%s/graphql/schemas/%s", db, schema), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := context.Background()
+	_, err := client.GraphQL.RemoveSchema(ctx, db, schema)
+	if err != nil {
+		t.Errorf("GraphQL.RemoveSchema returned error: %v", err)
+	}
+
+	const methodName = "RemoveSchema"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.GraphQL.RemoveSchema(nil, db, schema)
+	})
+}
@@ -0,0 +1,34 @@
+package stardog
+
+import (
+	"net/http"
+	"time"
+)
+
+// RequestAttempt describes an attempt to send a request, for [Client.OnResponse].
+type RequestAttempt struct {
+	// The request that was sent
+	Request *http.Request
+	// The response, if one was received. Its body may not have been read yet and must not be read by the hook.
+	Response *http.Response
+	// The error sending the request, if any. Error responses from the server are not errors here.
+	Err error
+	// The attempt number, counting from 0. Attempts after the first are retries (see [Client.Retry]).
+	Attempt int
+	// How long the attempt took, from sending the request until the response headers were received
+	Duration time.Duration
+}
+
+// onRequest calls the Client's OnRequest hook, if it has one.
+func (c *Client) onRequest(req *http.Request) {
+	if c.OnRequest != nil {
+		c.OnRequest(req)
+	}
+}
+
+// onResponse calls the Client's OnResponse hook, if it has one.
+func (c *Client) onResponse(attempt RequestAttempt) {
+	if c.OnResponse != nil {
+		c.OnResponse(attempt)
+	}
+}
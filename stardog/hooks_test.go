@@ -0,0 +1,66 @@
+package stardog
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClient_hooks(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.Retry = &RetryPolicy{MaxRetries: 1, Backoff: time.Millisecond}
+
+	attempts := 0
+	mux.HandleFunc("/admin/alive", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		testHeader(t, r, "traceparent", "00-trace-span-01")
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	})
+
+	client.OnRequest = func(req *http.Request) {
+		req.Header.Set("traceparent", "00-trace-span-01")
+	}
+	var got []RequestAttempt
+	client.OnResponse = func(attempt RequestAttempt) {
+		got = append(got, attempt)
+	}
+
+	if _, _, err := client.ServerAdmin.IsAlive(context.Background()); err != nil {
+		t.Fatalf("ServerAdmin.IsAlive returned error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("OnResponse called %d times, want 2", len(got))
+	}
+	for i, wantStatus := range []int{http.StatusServiceUnavailable, http.StatusOK} {
+		attempt := got[i]
+		if attempt.Attempt != i || attempt.Err != nil || attempt.Response.StatusCode != wantStatus {
+			t.Errorf("attempt %d = %+v, want status %d", i, attempt, wantStatus)
+		}
+		if attempt.Request.Method != http.MethodGet || attempt.Request.URL.Path != "/stardog-testing/admin/alive" {
+			t.Errorf("attempt %d request = %s %s, want GET /stardog-testing/admin/alive", i, attempt.Request.Method, attempt.Request.URL.Path)
+		}
+		if attempt.Duration <= 0 {
+			t.Errorf("attempt %d Duration = %v, want positive", i, attempt.Duration)
+		}
+	}
+}
+
+func TestClient_hooks_networkError(t *testing.T) {
+	client, _, _, teardown := setup()
+	teardown()
+
+	var got []RequestAttempt
+	client.OnResponse = func(attempt RequestAttempt) {
+		got = append(got, attempt)
+	}
+	if _, _, err := client.ServerAdmin.IsAlive(context.Background()); err == nil {
+		t.Fatalf("ServerAdmin.IsAlive against a closed server returned no error")
+	}
+	if len(got) != 1 || got[0].Err == nil || got[0].Response != nil {
+		t.Errorf("OnResponse attempts = %+v, want one with an error and no response", got)
+	}
+}
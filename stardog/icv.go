@@ -0,0 +1,432 @@
+package stardog
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ICVService handles communication with the [integrity constraint validation] related methods of the Stardog API.
+//
+// [integrity constraint validation]: https://docs.stardog.com/data-quality-constraints
+type ICVService service
+
+// ICVValidateOptions specifies the optional parameters to the [ICVService.Validate] method
+type ICVValidateOptions struct {
+	// The named graph(s) to validate. If empty, the default graph is validated.
+	NamedGraph []string `url:"graph-uri,omitempty"`
+	// Enable reasoning
+	Reasoning bool `url:"reasoning,omitempty"`
+}
+
+// ICVReportOptions specifies the optional parameters to the [ICVService.Report] method
+type ICVReportOptions struct {
+	// The named graph(s) to validate. If empty, the default graph is validated.
+	NamedGraph []string `url:"graph-uri,omitempty"`
+	// Enable reasoning
+	Reasoning bool `url:"reasoning,omitempty"`
+	// Only validate against these shapes
+	Shapes []string `url:"shapes,omitempty"`
+	// Only validate these nodes
+	Nodes []string `url:"nodes,omitempty"`
+	// The maximum number of violations to report per constraint
+	CountLimit int `url:"countLimit,omitempty"`
+	// The transaction ID
+	TxID string `url:"tx,omitempty"`
+}
+
+// SHACLSeverity is the severity of a [SHACLValidationResult].
+// The zero value for a SHACLSeverity is [SHACLSeverityUnknown]
+type SHACLSeverity int
+
+// All available values for [SHACLSeverity]
+const (
+	SHACLSeverityUnknown SHACLSeverity = iota
+	SHACLSeverityViolation
+	SHACLSeverityWarning
+	SHACLSeverityInfo
+)
+
+const shaclNamespace = "http://www.w3.org/ns/shacl#"
+
+var shaclSeverityValues = [4]string{
+	SHACLSeverityUnknown:   "UNKNOWN",
+	SHACLSeverityViolation: shaclNamespace + "Violation",
+	SHACLSeverityWarning:   shaclNamespace + "Warning",
+	SHACLSeverityInfo:      shaclNamespace + "Info",
+}
+
+// Valid returns if a given SHACLSeverity is known (valid) or not.
+func (s SHACLSeverity) Valid() bool {
+	return !(s <= SHACLSeverityUnknown || int(s) >= len(shaclSeverityValues))
+}
+
+// String will return the string representation of the SHACLSeverity, which is its IRI
+func (s SHACLSeverity) String() string {
+	if !s.Valid() {
+		return shaclSeverityValues[SHACLSeverityUnknown]
+	}
+	return shaclSeverityValues[s]
+}
+
+func shaclSeverityFromIRI(iri string) SHACLSeverity {
+	for i, v := range shaclSeverityValues {
+		if v == iri {
+			return SHACLSeverity(i)
+		}
+	}
+	return SHACLSeverityUnknown
+}
+
+// SHACLValidationReport is a [SHACL validation report].
+//
+// [SHACL validation report]: https://www.w3.org/TR/shacl/#validation-report
+type SHACLValidationReport struct {
+	// Whether the data conforms to the constraints
+	Conforms bool
+	// The results of the validation. Empty if the data conforms.
+	Results []SHACLValidationResult
+}
+
+// SHACLValidationResult is a single result in a [SHACLValidationReport]. IRIs and blank nodes
+// (as "_:label") are represented by their string values, and literals by their lexical form.
+type SHACLValidationResult struct {
+	// The node that caused the result
+	FocusNode string
+	// The path of the property that caused the result, if any
+	ResultPath string
+	// The value that caused the result, if any
+	Value string
+	// The shape the focus node was validated against
+	SourceShape string
+	// The constraint component that caused the result (e.g. sh:MinCountConstraintComponent)
+	SourceConstraintComponent string
+	// The severity of the result
+	Severity SHACLSeverity
+	// Human readable messages describing the result
+	Messages []string
+}
+
+// Count returns the number of results in the report with the given severity.
+func (r *SHACLValidationReport) Count(severity SHACLSeverity) int {
+	count := 0
+	for _, result := range r.Results {
+		if result.Severity == severity {
+			count++
+		}
+	}
+	return count
+}
+
+// Err returns nil if the data conforms to the constraints, and otherwise a *SHACLValidationError
+// describing every result of the report. It's useful for failing a build or pipeline on data quality regressions.
+func (r *SHACLValidationReport) Err() error {
+	if r.Conforms {
+		return nil
+	}
+	return &SHACLValidationError{Results: r.Results}
+}
+
+// String returns a single line description of the result, e.g.
+//
+//	Violation: focus node <http://example.com/paul>, path <http://example.com/age>, value "-1": age must be positive (shape <http://example.com/PersonShape>)
+func (r SHACLValidationResult) String() string {
+	var b strings.Builder
+	b.WriteString(strings.TrimPrefix(r.Severity.String(), shaclNamespace))
+	b.WriteString(": focus node ")
+	b.WriteString(formatSHACLNode(r.FocusNode))
+	if r.ResultPath != "" {
+		b.WriteString(", path ")
+		b.WriteString(formatSHACLNode(r.ResultPath))
+	}
+	if r.Value != "" {
+		fmt.Fprintf(&b, ", value %q", r.Value)
+	}
+	if len(r.Messages) > 0 {
+		b.WriteString(": ")
+		b.WriteString(strings.Join(r.Messages, "; "))
+	} else if r.SourceConstraintComponent != "" {
+		b.WriteString(": ")
+		b.WriteString(strings.TrimPrefix(r.SourceConstraintComponent, shaclNamespace))
+	}
+	if r.SourceShape != "" {
+		b.WriteString(" (shape ")
+		b.WriteString(formatSHACLNode(r.SourceShape))
+		b.WriteString(")")
+	}
+	return b.String()
+}
+
+// formatSHACLNode formats an IRI or blank node for display.
+func formatSHACLNode(node string) string {
+	if strings.HasPrefix(node, "_:") {
+		return node
+	}
+	return "<" + node + ">"
+}
+
+// SHACLValidationError is returned by [SHACLValidationReport.Err] when the data doesn't conform to the constraints.
+type SHACLValidationError struct {
+	// The results of the validation report
+	Results []SHACLValidationResult
+}
+
+func (e *SHACLValidationError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "data does not conform to the integrity constraints: %d result(s)", len(e.Results))
+	for _, result := range e.Results {
+		b.WriteString("\n")
+		b.WriteString(result.String())
+	}
+	return b.String()
+}
+
+// Constraints returns the integrity constraints stored in the database in the requested RDF format.
+// If the format is not valid, [RDFFormatTurtle] is used.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/ICV/operation/getICVConstraints
+func (s *ICVService) Constraints(ctx context.Context, database string, format RDFFormat) (*bytes.Buffer, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
+	if !format.Valid() {
+		format = RDFFormatTurtle
+	}
+	u := fmt.Sprintf("%s/icv", database)
+	headerOpts := requestHeaderOptions{
+		Accept: format.String(),
+	}
+	req, err := s.client.NewRequest(http.MethodGet, u, &headerOpts, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var buf bytes.Buffer
+	resp, err := s.client.Do(ctx, req, &buf)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &buf, resp, nil
+}
+
+// AddConstraints adds the integrity constraints, serialized in the provided RDF format, to the database.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/ICV/operation/addICVConstraints
+func (s *ICVService) AddConstraints(ctx context.Context, database string, constraints io.Reader, format RDFFormat) (*Response, error) {
+	return s.postConstraints(ctx, database, "add", constraints, format)
+}
+
+// RemoveConstraints removes the integrity constraints, serialized in the provided RDF format, from the database.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/ICV/operation/removeICVConstraints
+func (s *ICVService) RemoveConstraints(ctx context.Context, database string, constraints io.Reader, format RDFFormat) (*Response, error) {
+	return s.postConstraints(ctx, database, "remove", constraints, format)
+}
+
+// postConstraints sends integrity constraints to one of the ICV endpoints that modify the stored constraints.
+func (s *ICVService) postConstraints(ctx context.Context, database string, action string, constraints io.Reader, format RDFFormat) (*Response, error) {
+	req, err := s.newConstraintsRequest(database, action, constraints, format, "")
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// newConstraintsRequest validates the parameters and builds a POST request to {database}/icv/{action}
+// with the integrity constraints as the body.
+func (s *ICVService) newConstraintsRequest(database string, action string, constraints io.Reader, format RDFFormat, accept string) (*http.Request, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	if !format.Valid() {
+		return nil, &ValidationError{Field: "format", Value: format, Constraint: "must be a known value"}
+	}
+	if constraints == nil {
+		return nil, &ValidationError{Field: "constraints", Value: constraints, Constraint: "must not be nil"}
+	}
+	var body bytes.Buffer
+	if _, err := body.ReadFrom(constraints); err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("%s/icv/%s", database, action)
+	headerOpts := requestHeaderOptions{
+		ContentType: format.String(),
+		Accept:      accept,
+	}
+	return s.client.NewRequest(http.MethodPost, u, &headerOpts, &body)
+}
+
+// ClearConstraints removes all integrity constraints from the database.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/ICV/operation/clearICVConstraints
+func (s *ICVService) ClearConstraints(ctx context.Context, database string) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("%s/icv/clear", database)
+	req, err := s.client.NewRequest(http.MethodPost, u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// ConvertToSPARQL converts the integrity constraints, serialized in the provided RDF format, to the
+// SPARQL query Stardog would use to find violations of them.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/ICV/operation/convertICVConstraints
+func (s *ICVService) ConvertToSPARQL(ctx context.Context, database string, constraints io.Reader, format RDFFormat) (string, *Response, error) {
+	req, err := s.newConstraintsRequest(database, "convert", constraints, format, mediaTypePlainText)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var buf bytes.Buffer
+	resp, err := s.client.Do(ctx, req, &buf)
+	if err != nil {
+		return "", resp, err
+	}
+	return buf.String(), resp, nil
+}
+
+// Validate checks whether the data in the database (or the provided named graphs) conforms to the
+// integrity constraints stored in the database.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/ICV/operation/validate
+func (s *ICVService) Validate(ctx context.Context, database string, opts *ICVValidateOptions) (*bool, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("%s/icv/validate", database)
+	urlWithOptions, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	headerOpts := requestHeaderOptions{
+		Accept: mediaTypeBoolean,
+	}
+	req, err := s.client.NewRequest(http.MethodPost, urlWithOptions, &headerOpts, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var buf bytes.Buffer
+	resp, err := s.client.Do(ctx, req, &buf)
+	if err != nil {
+		return nil, resp, err
+	}
+	conforms, err := strconv.ParseBool(strings.TrimSpace(buf.String()))
+	if err != nil {
+		return nil, resp, err
+	}
+	return &conforms, resp, nil
+}
+
+// Report validates the data in the database (or the provided named graphs) against the integrity
+// constraints stored in the database and returns the resulting SHACL validation report.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/ICV/operation/report
+func (s *ICVService) Report(ctx context.Context, database string, opts *ICVReportOptions) (*SHACLValidationReport, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("%s/icv/report", database)
+	urlWithOptions, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	headerOpts := requestHeaderOptions{
+		Accept: mediaTypeApplicationNTriples,
+	}
+	req, err := s.client.NewRequest(http.MethodPost, urlWithOptions, &headerOpts, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var buf bytes.Buffer
+	resp, err := s.client.Do(ctx, req, &buf)
+	if err != nil {
+		return nil, resp, err
+	}
+	report, err := ParseSHACLValidationReport(&buf)
+	if err != nil {
+		return nil, resp, err
+	}
+	return report, resp, nil
+}
+
+const rdfType = "http://www.w3.org/1999/02/22-rdf-syntax-ns#type"
+
+// ParseSHACLValidationReport parses a SHACL validation report serialized as N-Triples, such as one
+// saved from the Stardog CLI with "stardog icv report --format NTRIPLES". [ICVService.Report] returns
+// reports that are already parsed.
+func ParseSHACLValidationReport(r io.Reader) (*SHACLValidationReport, error) {
+	triples, err := parseNTriples(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var reportNode string
+	for _, t := range triples {
+		if t.predicate.value == rdfType && t.object.value == shaclNamespace+"ValidationReport" {
+			reportNode = t.subject.value
+			break
+		}
+	}
+	if reportNode == "" {
+		return nil, fmt.Errorf("no %sValidationReport found in the response", shaclNamespace)
+	}
+
+	report := &SHACLValidationReport{}
+	results := make(map[string]*SHACLValidationResult)
+	var resultNodes []string
+	for _, t := range triples {
+		if t.subject.value != reportNode {
+			continue
+		}
+		switch t.predicate.value {
+		case shaclNamespace + "conforms":
+			report.Conforms = t.object.value == "true" || t.object.value == "1"
+		case shaclNamespace + "result":
+			if _, ok := results[t.object.value]; !ok {
+				results[t.object.value] = &SHACLValidationResult{}
+				resultNodes = append(resultNodes, t.object.value)
+			}
+		}
+	}
+
+	for _, t := range triples {
+		result, ok := results[t.subject.value]
+		if !ok {
+			continue
+		}
+		switch t.predicate.value {
+		case shaclNamespace + "focusNode":
+			result.FocusNode = t.object.value
+		case shaclNamespace + "resultPath":
+			result.ResultPath = t.object.value
+		case shaclNamespace + "value":
+			result.Value = t.object.value
+		case shaclNamespace + "sourceShape":
+			result.SourceShape = t.object.value
+		case shaclNamespace + "sourceConstraintComponent":
+			result.SourceConstraintComponent = t.object.value
+		case shaclNamespace + "resultSeverity":
+			result.Severity = shaclSeverityFromIRI(t.object.value)
+		case shaclNamespace + "resultMessage":
+			result.Messages = append(result.Messages, t.object.value)
+		}
+	}
+
+	for _, node := range resultNodes {
+		report.Results = append(report.Results, *results[node])
+	}
+	return report, nil
+}
@@ -0,0 +1,327 @@
+package stardog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var icvConstraints = `
+@prefix sh: <http://www.w3.org/ns/shacl#> .
+@prefix : <http://example.com/> .
+
+:PersonShape a sh:NodeShape ;
+  sh:targetClass :Person ;
+  sh:property [ sh:path :name ; sh:minCount 1 ] .
+`
+
+func TestICVService_Constraints(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	mux.HandleFunc(fmt.Sprintf("/%s/icv", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", RDFFormatTurtle.String())
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(icvConstraints))
+	})
+
+	ctx := context.Background()
+	got, _, err := client.ICV.Constraints(ctx, db, RDFFormatUnknown)
+	if err != nil {
+		t.Errorf("ICV.Constraints returned error: %v", err)
+	}
+	if want := icvConstraints; got.String() != want {
+		t.Errorf("ICV.Constraints = %+v, want %+v", got, want)
+	}
+
+	const methodName = "Constraints"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.ICV.Constraints(ctx, "", RDFFormatTurtle)
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.ICV.Constraints(nil, db, RDFFormatTurtle)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestICVService_AddRemoveConstraints(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	for _, action := range []string{"add", "remove"} {
+		mux.HandleFunc(fmt.Sprintf("/%s/icv/%s", db, action), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			testHeader(t, r, "Content-Type", RDFFormatTurtle.String())
+			testBody(t, r, icvConstraints)
+			w.WriteHeader(http.StatusOK)
+		})
+	}
+
+	ctx := context.Background()
+	if _, err := client.ICV.AddConstraints(ctx, db, strings.NewReader(icvConstraints), RDFFormatTurtle); err != nil {
+		t.Errorf("ICV.AddConstraints returned error: %v", err)
+	}
+	if _, err := client.ICV.RemoveConstraints(ctx, db, strings.NewReader(icvConstraints), RDFFormatTurtle); err != nil {
+		t.Errorf("ICV.RemoveConstraints returned error: %v", err)
+	}
+
+	const methodName = "AddConstraints"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.ICV.AddConstraints(ctx, db, strings.NewReader(icvConstraints), RDFFormatUnknown)
+		return err
+	})
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.ICV.AddConstraints(ctx, db, nil, RDFFormatTurtle)
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.ICV.AddConstraints(nil, db, strings.NewReader(icvConstraints), RDFFormatTurtle)
+	})
+}
+
+func TestICVService_ClearConstraints(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	mux.HandleFunc(fmt.Sprintf("/%s/icv/clear", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := context.Background()
+	if _, err := client.ICV.ClearConstraints(ctx, db); err != nil {
+		t.Errorf("ICV.ClearConstraints returned error: %v", err)
+	}
+
+	const methodName = "ClearConstraints"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.ICV.ClearConstraints(nil, db)
+	})
+}
+
+func TestICVService_ConvertToSPARQL(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	sparql := "SELECT ?s { ?s a <http://example.com/Person> FILTER NOT EXISTS { ?s <http://example.com/name> ?o } }"
+	mux.HandleFunc(fmt.Sprintf("/%s/icv/convert", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", RDFFormatTurtle.String())
+		testHeader(t, r, "Accept", mediaTypePlainText)
+		testBody(t, r, icvConstraints)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(sparql))
+	})
+
+	ctx := context.Background()
+	got, _, err := client.ICV.ConvertToSPARQL(ctx, db, strings.NewReader(icvConstraints), RDFFormatTurtle)
+	if err != nil {
+		t.Errorf("ICV.ConvertToSPARQL returned error: %v", err)
+	}
+	if got != sparql {
+		t.Errorf("ICV.ConvertToSPARQL = %+v, want %+v", got, sparql)
+	}
+
+	const methodName = "ConvertToSPARQL"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		_, resp, err := client.ICV.ConvertToSPARQL(nil, db, strings.NewReader(icvConstraints), RDFFormatTurtle)
+		return resp, err
+	})
+}
+
+func TestICVService_Validate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	mux.HandleFunc(fmt.Sprintf("/%s/icv/validate", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", mediaTypeBoolean)
+		if got, want := r.URL.Query().Get("graph-uri"), "urn:graph:people"; got != want {
+			t.Errorf("graph-uri = %q, want %q", got, want)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("false"))
+	})
+
+	ctx := context.Background()
+	opts := &ICVValidateOptions{NamedGraph: []string{"urn:graph:people"}}
+	got, _, err := client.ICV.Validate(ctx, db, opts)
+	if err != nil {
+		t.Errorf("ICV.Validate returned error: %v", err)
+	}
+	if want := newFalse(); !cmp.Equal(got, want) {
+		t.Errorf("ICV.Validate = %+v, want %+v", got, want)
+	}
+
+	const methodName = "Validate"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.ICV.Validate(ctx, "", opts)
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.ICV.Validate(nil, db, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestICVService_Report(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	report := `_:report <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://www.w3.org/ns/shacl#ValidationReport> .
+_:report <http://www.w3.org/ns/shacl#conforms> "false"^^<http://www.w3.org/2001/XMLSchema#boolean> .
+_:report <http://www.w3.org/ns/shacl#result> _:r1 .
+_:report <http://www.w3.org/ns/shacl#result> _:r2 .
+_:r1 <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://www.w3.org/ns/shacl#ValidationResult> .
+_:r1 <http://www.w3.org/ns/shacl#focusNode> <http://example.com/paul> .
+_:r1 <http://www.w3.org/ns/shacl#resultPath> <http://example.com/name> .
+_:r1 <http://www.w3.org/ns/shacl#sourceShape> _:nameShape .
+_:r1 <http://www.w3.org/ns/shacl#sourceConstraintComponent> <http://www.w3.org/ns/shacl#MinCountConstraintComponent> .
+_:r1 <http://www.w3.org/ns/shacl#resultSeverity> <http://www.w3.org/ns/shacl#Violation> .
+_:r1 <http://www.w3.org/ns/shacl#resultMessage> "Less than 1 values" .
+_:r2 <http://www.w3.org/ns/shacl#focusNode> <http://example.com/john> .
+_:r2 <http://www.w3.org/ns/shacl#value> "-1"^^<http://www.w3.org/2001/XMLSchema#integer> .
+_:r2 <http://www.w3.org/ns/shacl#resultSeverity> <http://www.w3.org/ns/shacl#Warning> .
+`
+	mux.HandleFunc(fmt.Sprintf("/%s/icv/report", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", mediaTypeApplicationNTriples)
+		if got, want := r.URL.Query().Get("countLimit"), "10"; got != want {
+			t.Errorf("countLimit = %q, want %q", got, want)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(report))
+	})
+
+	ctx := context.Background()
+	opts := &ICVReportOptions{CountLimit: 10}
+	got, _, err := client.ICV.Report(ctx, db, opts)
+	if err != nil {
+		t.Errorf("ICV.Report returned error: %v", err)
+	}
+	want := &SHACLValidationReport{
+		Conforms: false,
+		Results: []SHACLValidationResult{
+			{
+				FocusNode:                 "http://example.com/paul",
+				ResultPath:                "http://example.com/name",
+				SourceShape:               "_:nameShape",
+				SourceConstraintComponent: "http://www.w3.org/ns/shacl#MinCountConstraintComponent",
+				Severity:                  SHACLSeverityViolation,
+				Messages:                  []string{"Less than 1 values"},
+			},
+			{
+				FocusNode: "http://example.com/john",
+				Value:     "-1",
+				Severity:  SHACLSeverityWarning,
+			},
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("ICV.Report = %+v, want %+v", got, want)
+	}
+
+	const methodName = "Report"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.ICV.Report(ctx, db, &ICVReportOptions{CountLimit: -1})
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.ICV.Report(nil, db, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestParseSHACLValidationReport(t *testing.T) {
+	conforming := `_:report <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://www.w3.org/ns/shacl#ValidationReport> .
+_:report <http://www.w3.org/ns/shacl#conforms> "true"^^<http://www.w3.org/2001/XMLSchema#boolean> .
+`
+	got, err := ParseSHACLValidationReport(strings.NewReader(conforming))
+	if err != nil {
+		t.Errorf("ParseSHACLValidationReport returned error: %v", err)
+	}
+	if want := (&SHACLValidationReport{Conforms: true}); !cmp.Equal(got, want) {
+		t.Errorf("ParseSHACLValidationReport = %+v, want %+v", got, want)
+	}
+
+	if _, err := ParseSHACLValidationReport(strings.NewReader(`<urn:s> <urn:p> <urn:o> .`)); err == nil {
+		t.Errorf("ParseSHACLValidationReport err = nil, want error for missing report")
+	}
+}
+
+func TestSHACLSeverity(t *testing.T) {
+	if got, want := SHACLSeverityInfo.String(), "http://www.w3.org/ns/shacl#Info"; got != want {
+		t.Errorf("SHACLSeverity.String = %q, want %q", got, want)
+	}
+	if got, want := SHACLSeverity(100).String(), "UNKNOWN"; got != want {
+		t.Errorf("SHACLSeverity.String = %q, want %q", got, want)
+	}
+	if got := shaclSeverityFromIRI("urn:other"); got != SHACLSeverityUnknown {
+		t.Errorf("shaclSeverityFromIRI = %v, want %v", got, SHACLSeverityUnknown)
+	}
+}
+
+func TestSHACLValidationReport_Err(t *testing.T) {
+	if err := (&SHACLValidationReport{Conforms: true}).Err(); err != nil {
+		t.Errorf("SHACLValidationReport.Err = %v, want nil", err)
+	}
+
+	report := &SHACLValidationReport{
+		Results: []SHACLValidationResult{
+			{
+				FocusNode:                 "http://example.com/paul",
+				ResultPath:                "http://example.com/name",
+				SourceShape:               "_:nameShape",
+				SourceConstraintComponent: "http://www.w3.org/ns/shacl#MinCountConstraintComponent",
+				Severity:                  SHACLSeverityViolation,
+				Messages:                  []string{"Less than 1 values"},
+			},
+			{
+				FocusNode:                 "_:b0",
+				Value:                     "-1",
+				SourceConstraintComponent: "http://www.w3.org/ns/shacl#MinInclusiveConstraintComponent",
+				Severity:                  SHACLSeverityWarning,
+			},
+		},
+	}
+	if got, want := report.Count(SHACLSeverityViolation), 1; got != want {
+		t.Errorf("SHACLValidationReport.Count = %d, want %d", got, want)
+	}
+	if got, want := report.Count(SHACLSeverityInfo), 0; got != want {
+		t.Errorf("SHACLValidationReport.Count = %d, want %d", got, want)
+	}
+
+	err := report.Err()
+	var validationErr *SHACLValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("SHACLValidationReport.Err = %v, want *SHACLValidationError", err)
+	}
+	want := `data does not conform to the integrity constraints: 2 result(s)
+Violation: focus node <http://example.com/paul>, path <http://example.com/name>: Less than 1 values (shape _:nameShape)
+Warning: focus node _:b0, value "-1": MinInclusiveConstraintComponent`
+	if got := err.Error(); got != want {
+		t.Errorf("SHACLValidationError.Error = %q, want %q", got, want)
+	}
+}
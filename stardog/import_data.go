@@ -0,0 +1,166 @@
+package stardog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// ImportDataOptions specifies the optional parameters to the [DatabaseAdminService.ImportData] method.
+type ImportDataOptions struct {
+	// Whether the Paths of the datasets are absolute paths of files on the server rather than the client.
+	// Server-side files are loaded by the server with SPARQL LOAD, so they must be readable by it.
+	// Datasets with a Reader are always uploaded.
+	ServerSide bool
+	// Progress, if not nil, is called as the datasets are sent to the server, e.g. to display a progress bar.
+	// It isn't called for server-side files.
+	Progress func(UploadProgress)
+}
+
+// ImportData loads the datasets into an existing database, each into its own NamedGraph, in a single transaction.
+// If loading any of the datasets fails, the transaction is rolled back and none of the data is added.
+//
+// The files of the datasets, and the data of Datasets with a Reader, are streamed to the server. Their RDF format is
+// Dataset.Format or, if unset, determined from the extension of Dataset.Path or, failing that, from the data itself
+// (see [DetectRDFFormat]). Their compression is Dataset.Compression or determined from the extension or contents of
+// the data. Gzipped and bzip2 data is supported, but ZIP archives are not.
+//
+// The response is that of the last request made: committing the transaction or, if loading a dataset fails,
+// loading it.
+func (s *DatabaseAdminService) ImportData(ctx context.Context, database string, datasets []Dataset, opts *ImportDataOptions) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	if len(datasets) == 0 {
+		return nil, &ValidationError{Field: "datasets", Value: datasets, Constraint: "must not be empty"}
+	}
+	serverSide := opts != nil && opts.ServerSide
+	var progress func(UploadProgress)
+	if opts != nil {
+		progress = opts.Progress
+	}
+	datasets = append([]Dataset(nil), datasets...)
+	for i := range datasets {
+		datasets[i] = datasets[i].detectFormat(serverSide)
+		if err := datasets[i].validateImport(i, serverSide); err != nil {
+			return nil, err
+		}
+	}
+	if ctx == nil {
+		return nil, errNonNilContext
+	}
+
+	txID, resp, err := s.client.Transaction.Begin(ctx, database)
+	if err != nil {
+		return resp, err
+	}
+	for i, dataset := range datasets {
+		if resp, err := s.importDataset(ctx, database, txID, i, dataset, serverSide, progress); err != nil {
+			err = fmt.Errorf("importing dataset %d (%s): %w", i, dataset.uploadName(i), err)
+			// roll back even if ctx is done, so the transaction doesn't hold its locks until it times out
+			if _, rollbackErr := s.client.Transaction.Rollback(context.Background(), database, txID); rollbackErr != nil {
+				return resp, errors.Join(err, fmt.Errorf("rolling back transaction %s: %w", txID, rollbackErr))
+			}
+			return resp, err
+		}
+	}
+	return s.client.Transaction.Commit(ctx, database, txID)
+}
+
+// validateImport checks that the dataset's data and format can be determined, before a transaction is begun.
+func (d Dataset) validateImport(i int, serverSide bool) error {
+	field := fmt.Sprintf("datasets[%d]", i)
+	if d.Reader == nil && d.Path == "" {
+		return &ValidationError{Field: field + ".Path", Value: d.Path, Constraint: "must be set for a Dataset without a Reader"}
+	}
+	// the server determines the format of server-side files
+	if d.Format == RDFFormatUnknown && !(serverSide && d.Reader == nil) {
+		if _, err := GetRDFFormatFromExtension(d.Path); err != nil {
+			return &ValidationError{Field: field + ".Format", Value: d.Format, Constraint: "must be set if it can't be determined from Path or the data"}
+		}
+	}
+	return firstError(
+		validateEnum(field+".Format", d.Format),
+		validateEnum(field+".Compression", d.Compression),
+	)
+}
+
+// detectFormat returns the dataset with its Format determined from its data (see [DetectRDFFormat]) if it's
+// unset and Path has no RDF file extension. The Reader of such a dataset is replaced with one that also reads
+// the bytes read to determine its format. The Format is left unset if it can't be determined.
+func (d Dataset) detectFormat(serverSide bool) Dataset {
+	if d.Format != RDFFormatUnknown || (serverSide && d.Reader == nil) {
+		return d
+	}
+	if _, err := GetRDFFormatFromExtension(d.Path); err == nil {
+		return d
+	}
+	if d.Reader != nil {
+		format, r, err := sniffRDFFormat(d.Reader)
+		d.Reader = r
+		if err == nil {
+			d.Format = format
+		}
+		return d
+	}
+	file, err := os.Open(d.Path)
+	if err != nil {
+		return d
+	}
+	defer file.Close()
+	if format, _, err := sniffRDFFormat(file); err == nil {
+		d.Format = format
+	}
+	return d
+}
+
+// importDataset adds the i-th dataset to the transaction.
+func (s *DatabaseAdminService) importDataset(ctx context.Context, database string, txID string, i int, dataset Dataset, serverSide bool, progress func(UploadProgress)) (*Response, error) {
+	if serverSide && dataset.Reader == nil {
+		return s.loadServerSideDataset(ctx, database, txID, dataset)
+	}
+
+	format := dataset.Format
+	if !format.Valid() {
+		var err error
+		if format, err = GetRDFFormatFromExtension(dataset.Path); err != nil {
+			return nil, err
+		}
+	}
+	compression := dataset.Compression
+	if compression == CompressionUnknown {
+		compression = GetCompressionFromExtension(dataset.Path)
+	}
+
+	data := dataset.Reader
+	size := dataset.Size
+	if size <= 0 {
+		size = -1
+	}
+	if data == nil {
+		file, err := os.Open(dataset.Path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		data = file
+		if stat, err := file.Stat(); err == nil {
+			size = stat.Size()
+		}
+	}
+	data = withProgress(data, UploadProgress{Dataset: i, Name: dataset.uploadName(i), Size: size}, progress)
+	return s.client.Transaction.Add(ctx, database, txID, data, format, &TransactionAddOptions{
+		NamedGraph:  dataset.NamedGraph,
+		Compression: compression,
+	})
+}
+
+// loadServerSideDataset loads a file on the server into the transaction with SPARQL LOAD.
+func (s *DatabaseAdminService) loadServerSideDataset(ctx context.Context, database string, txID string, dataset Dataset) (*Response, error) {
+	update := "LOAD " + quoteIRI("file://"+dataset.Path)
+	if dataset.NamedGraph != "" {
+		update += " INTO GRAPH " + quoteIRI(dataset.NamedGraph)
+	}
+	return s.client.Sparql.Update(ctx, database, update, &UpdateOptions{TxID: txID})
+}
@@ -0,0 +1,244 @@
+package stardog
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// handleImportTransaction serves the transaction endpoints used by ImportData for db1, recording the calls made.
+func handleImportTransaction(t *testing.T, mux *http.ServeMux, calls *[]string) {
+	mux.HandleFunc("/db1/transaction/begin", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		*calls = append(*calls, "begin")
+		w.Write([]byte("tx1"))
+	})
+	mux.HandleFunc("/db1/transaction/commit/tx1", func(w http.ResponseWriter, r *http.Request) {
+		*calls = append(*calls, "commit")
+	})
+	mux.HandleFunc("/db1/transaction/rollback/tx1", func(w http.ResponseWriter, r *http.Request) {
+		*calls = append(*calls, "rollback")
+	})
+}
+
+func TestDatabaseAdminService_ImportData(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	beatles, err := os.ReadFile("./test-resources/beatles.ttl")
+	if err != nil {
+		t.Fatalf("unexpected error reading test resource: %v", err)
+	}
+	var gzipped bytes.Buffer
+	gz := gzip.NewWriter(&gzipped)
+	gz.Write([]byte("<urn:s> <urn:p> <urn:o> <urn:g> ."))
+	gz.Close()
+
+	var calls []string
+	handleImportTransaction(t, mux, &calls)
+	type addRequest struct {
+		Graph, ContentType, ContentEncoding string
+		Body                                []byte
+	}
+	var adds []addRequest
+	mux.HandleFunc("/db1/tx1/add", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body, _ := io.ReadAll(r.Body)
+		calls = append(calls, "add")
+		adds = append(adds, addRequest{
+			Graph:           r.URL.Query().Get("graph-uri"),
+			ContentType:     r.Header.Get("Content-Type"),
+			ContentEncoding: r.Header.Get("Content-Encoding"),
+			Body:            body,
+		})
+	})
+
+	datasets := []Dataset{
+		{Path: "./test-resources/beatles.ttl", NamedGraph: "urn:beatles"},
+		{Reader: bytes.NewReader(gzipped.Bytes()), Format: RDFFormatNQuads},
+		{Reader: strings.NewReader("<urn:a> <urn:b> <urn:c> ."), Path: "data.nt", NamedGraph: "urn:nt"},
+	}
+	resp, err := client.DatabaseAdmin.ImportData(context.Background(), "db1", datasets, nil)
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.ImportData returned error: %v", err)
+	}
+	if resp == nil || !strings.Contains(resp.Request.URL.Path, "/commit") {
+		t.Errorf("DatabaseAdmin.ImportData response = %v, want the response to committing the transaction", resp)
+	}
+
+	if want := []string{"begin", "add", "add", "add", "commit"}; !cmp.Equal(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+	wantAdds := []addRequest{
+		{Graph: "urn:beatles", ContentType: mediaTypeTextTurtle, Body: beatles},
+		{ContentType: mediaTypeApplicationNQuads, ContentEncoding: "gzip", Body: gzipped.Bytes()},
+		{Graph: "urn:nt", ContentType: mediaTypeApplicationNTriples, Body: []byte("<urn:a> <urn:b> <urn:c> .")},
+	}
+	if !cmp.Equal(adds, wantAdds) {
+		t.Errorf("add requests = %+v, want %+v", adds, wantAdds)
+	}
+}
+
+func TestDatabaseAdminService_ImportData_detectedFormat(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls []string
+	handleImportTransaction(t, mux, &calls)
+	type addRequest struct {
+		ContentType string
+		Body        string
+	}
+	var adds []addRequest
+	mux.HandleFunc("/db1/tx1/add", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		adds = append(adds, addRequest{ContentType: r.Header.Get("Content-Type"), Body: string(body)})
+	})
+
+	turtle := "@prefix ex: <http://example.com/> .\nex:a ex:b ex:c .\n"
+	path := filepath.Join(t.TempDir(), "upload-1234")
+	if err := os.WriteFile(path, []byte(turtle), 0o600); err != nil {
+		t.Fatalf("unexpected error writing test file: %v", err)
+	}
+	quads := "<urn:s> <urn:p> <urn:o> <urn:g> .\n"
+	datasets := []Dataset{
+		{Path: path},
+		{Reader: strings.NewReader(quads)},
+	}
+	if _, err := client.DatabaseAdmin.ImportData(context.Background(), "db1", datasets, nil); err != nil {
+		t.Fatalf("DatabaseAdmin.ImportData returned error: %v", err)
+	}
+
+	wantAdds := []addRequest{
+		{ContentType: mediaTypeTextTurtle, Body: turtle},
+		{ContentType: mediaTypeApplicationNQuads, Body: quads},
+	}
+	if !cmp.Equal(adds, wantAdds) {
+		t.Errorf("add requests = %+v, want %+v", adds, wantAdds)
+	}
+	if datasets[1].Format != RDFFormatUnknown {
+		t.Errorf("DatabaseAdmin.ImportData set the Format of the caller's dataset to %v", datasets[1].Format)
+	}
+}
+
+func TestDatabaseAdminService_ImportData_progress(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls []string
+	handleImportTransaction(t, mux, &calls)
+	mux.HandleFunc("/db1/tx1/add", func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+	})
+
+	stat, err := os.Stat("./test-resources/beatles.ttl")
+	if err != nil {
+		t.Fatalf("unexpected error reading test resource: %v", err)
+	}
+	data := "<urn:a> <urn:b> <urn:c> ."
+	datasets := []Dataset{
+		{Path: "./test-resources/beatles.ttl"},
+		{Reader: strings.NewReader(data), Path: "data.nt", Size: int64(len(data))},
+	}
+	last := make(map[int]UploadProgress)
+	opts := &ImportDataOptions{Progress: func(p UploadProgress) { last[p.Dataset] = p }}
+	if _, err := client.DatabaseAdmin.ImportData(context.Background(), "db1", datasets, opts); err != nil {
+		t.Fatalf("DatabaseAdmin.ImportData returned error: %v", err)
+	}
+
+	want := map[int]UploadProgress{
+		0: {Dataset: 0, Name: "./test-resources/beatles.ttl", Sent: stat.Size(), Size: stat.Size()},
+		1: {Dataset: 1, Name: "data.nt", Sent: int64(len(data)), Size: int64(len(data))},
+	}
+	if !cmp.Equal(last, want) {
+		t.Errorf("last progress = %+v, want %+v", last, want)
+	}
+}
+
+func TestDatabaseAdminService_ImportData_rollback(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls []string
+	handleImportTransaction(t, mux, &calls)
+	mux.HandleFunc("/db1/tx1/add", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "add")
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	datasets := []Dataset{{Reader: strings.NewReader("not turtle"), Format: RDFFormatTurtle}}
+	resp, err := client.DatabaseAdmin.ImportData(context.Background(), "db1", datasets, nil)
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Errorf("DatabaseAdmin.ImportData error = %v, want an *ErrorResponse", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("DatabaseAdmin.ImportData response = %v, want the response to adding the dataset", resp)
+	}
+	if want := []string{"begin", "add", "rollback"}; !cmp.Equal(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestDatabaseAdminService_ImportData_serverSide(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls []string
+	handleImportTransaction(t, mux, &calls)
+	var updates []string
+	mux.HandleFunc("/db1/tx1/update", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "update")
+		updates = append(updates, r.URL.Query().Get("query"))
+	})
+
+	datasets := []Dataset{
+		{Path: "/data/beatles.ttl.gz", NamedGraph: "urn:beatles"},
+		{Path: "/data/my movies> } ; DROP ALL #"},
+	}
+	if _, err := client.DatabaseAdmin.ImportData(context.Background(), "db1", datasets, &ImportDataOptions{ServerSide: true}); err != nil {
+		t.Fatalf("DatabaseAdmin.ImportData returned error: %v", err)
+	}
+	wantUpdates := []string{
+		"LOAD <file:///data/beatles.ttl.gz> INTO GRAPH <urn:beatles>",
+		"LOAD <file:///data/my%20movies%3E%20%7D%20;%20DROP%20ALL%20#>",
+	}
+	if !cmp.Equal(updates, wantUpdates) {
+		t.Errorf("updates = %q, want %q", updates, wantUpdates)
+	}
+	if want := []string{"begin", "update", "update", "commit"}; !cmp.Equal(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestDatabaseAdminService_ImportData_invalid(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	tests := []struct {
+		name     string
+		datasets []Dataset
+	}{
+		{name: "no datasets"},
+		{name: "no path or reader", datasets: []Dataset{{NamedGraph: "urn:g"}}},
+		{name: "unknown format", datasets: []Dataset{{Reader: strings.NewReader("")}}},
+		{name: "no RDF extension", datasets: []Dataset{{Path: "data.pdf"}}},
+		{name: "bad compression", datasets: []Dataset{{Path: "data.ttl", Compression: Compression(9)}}},
+	}
+	for _, tc := range tests {
+		var validationErr *ValidationError
+		if _, err := client.DatabaseAdmin.ImportData(ctx, "db1", tc.datasets, nil); !errors.As(err, &validationErr) {
+			t.Errorf("%s: DatabaseAdmin.ImportData error = %v, want a *ValidationError", tc.name, err)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package stardog
+
+import "net/http"
+
+// Logger is the logging hook of a [Client]. It's implemented by *log.Logger.
+type Logger interface {
+	Printf(format string, v ...any)
+}
+
+const defaultLargeResponseThreshold = 64 << 20
+
+// logf logs a message with the Client's Logger, if it has one.
+func (c *Client) logf(format string, v ...any) {
+	if c.Logger != nil {
+		c.Logger.Printf(format, v...)
+	}
+}
+
+// warnIfLarge logs a warning if the buffered response exceeds the Client's LargeResponseThreshold.
+func (c *Client) warnIfLarge(req *http.Request, resp *Response) {
+	threshold := c.LargeResponseThreshold
+	if threshold == 0 {
+		threshold = defaultLargeResponseThreshold
+	}
+	if threshold < 0 || resp.Size <= threshold {
+		return
+	}
+	c.logf("stardog: buffered a %d byte response to %s %s in memory; consider using a streaming method for large results",
+		resp.Size, req.Method, req.URL.Path)
+}
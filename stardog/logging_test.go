@@ -0,0 +1,73 @@
+package stardog
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_largeResponseWarning(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	body := strings.Repeat("<urn:s> <urn:p> <urn:o> .\n", 100)
+	mux.HandleFunc("/db1/export", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	})
+
+	var logs bytes.Buffer
+	client.Logger = log.New(&logs, "", 0)
+	client.LargeResponseThreshold = 1000
+
+	ctx := context.Background()
+	_, resp, err := client.DatabaseAdmin.ExportData(ctx, "db1", nil)
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.ExportData returned error: %v", err)
+	}
+	if got, want := resp.Size, int64(len(body)); got != want {
+		t.Errorf("Response.Size = %d, want %d", got, want)
+	}
+	want := "stardog: buffered a 2600 byte response to GET /stardog-testing/db1/export in memory; consider using a streaming method for large results\n"
+	if got := logs.String(); got != want {
+		t.Errorf("log = %q, want %q", got, want)
+	}
+
+	// below the threshold
+	logs.Reset()
+	client.LargeResponseThreshold = int64(len(body))
+	if _, _, err := client.DatabaseAdmin.ExportData(ctx, "db1", nil); err != nil {
+		t.Fatalf("DatabaseAdmin.ExportData returned error: %v", err)
+	}
+	if logs.Len() != 0 {
+		t.Errorf("log = %q, want no warning", logs.String())
+	}
+
+	// disabled
+	client.LargeResponseThreshold = -1
+	if _, _, err := client.DatabaseAdmin.ExportData(ctx, "db1", nil); err != nil {
+		t.Fatalf("DatabaseAdmin.ExportData returned error: %v", err)
+	}
+	if logs.Len() != 0 {
+		t.Errorf("log = %q, want no warning", logs.String())
+	}
+
+	// streamed responses are not buffered
+	client.LargeResponseThreshold = 1
+	stream, resp, err := client.DatabaseAdmin.ExportDataStream(ctx, "db1", nil)
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.ExportDataStream returned error: %v", err)
+	}
+	stream.Close()
+	if resp.Size != 0 || logs.Len() != 0 {
+		t.Errorf("streamed Response.Size = %d and log = %q, want 0 and no warning", resp.Size, logs.String())
+	}
+}
+
+func TestClient_logf_noLogger(t *testing.T) {
+	client, _ := NewClient(defaultServerURL, nil)
+	// must not panic
+	client.logf("discarded %d", 1)
+}
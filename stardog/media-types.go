@@ -11,7 +11,15 @@ const (
 	mediaTypeApplicationJSONLD            = "application/ld+json"
 	mediaTypeApplicationSparqlResultsJSON = "application/sparql-results+json"
 	mediaTypeApplicationSparqlResultsXML  = "application/sparql-results+xml"
+	mediaTypeApplicationSparqlStarJSON    = "application/x-sparqlstar-results+json"
+	mediaTypeApplicationBinaryResults     = "application/x-binary-rdf-results-table"
 	mediaTypeTextCSV                      = "text/csv"
-	mediaTypeTextTSV                      = "text/tsv"
+	mediaTypeTextTSV                      = "text/tab-separated-values"
 	mediaTypeBoolean                      = "text/boolean"
+	mediaTypeApplicationGraphQL           = "application/graphql"
+	mediaTypeApplicationTurtleStar        = "application/x-turtlestar"
+	mediaTypeApplicationTrigStar          = "application/x-trigstar"
+	mediaTypeTextN3                       = "text/n3"
+	mediaTypeApplicationFormURLEncoded    = "application/x-www-form-urlencoded"
+	mediaTypeApplicationZip               = "application/zip"
 )
@@ -0,0 +1,76 @@
+package stardog
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// MetadataChange is a change to the value of a database configuration option.
+type MetadataChange struct {
+	// The name of the option, e.g. "search.enabled"
+	Option string
+	// The value before and after the change. Old is nil for an option that wasn't set before, and New is nil for
+	// an option that's no longer set.
+	Old any
+	New any
+}
+
+// MetadataAuditOptions specifies the optional parameters to the [DatabaseAdminService.SetMetadataAudited] method.
+type MetadataAuditOptions struct {
+	// Log each change with the Client's Logger
+	Log bool
+}
+
+// SetMetadataAudited sets configuration options like [DatabaseAdminService.SetMetadata], returning the changes
+// made to the database's configuration for change-management records. The changes are found by comparing
+// snapshots of [DatabaseAdminService.AllMetadata] from before and after the options are set, so they include
+// options the server changed as a result, and concurrent changes by other clients.
+//
+// If the options are set but the snapshot after setting them can't be taken, the error says so and no changes
+// are returned.
+func (s *DatabaseAdminService) SetMetadataAudited(ctx context.Context, database string, opts map[string]any, auditOpts *MetadataAuditOptions) ([]MetadataChange, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
+	before, resp, err := s.AllMetadata(ctx, database)
+	if err != nil {
+		return nil, resp, fmt.Errorf("fetching metadata before the change: %w", err)
+	}
+	if resp, err := s.SetMetadata(ctx, database, opts); err != nil {
+		return nil, resp, err
+	}
+	after, resp, err := s.AllMetadata(ctx, database)
+	if err != nil {
+		return nil, resp, fmt.Errorf("metadata was set, but fetching it after the change failed: %w", err)
+	}
+
+	changes := DiffMetadata(before, after)
+	if auditOpts != nil && auditOpts.Log {
+		for _, change := range changes {
+			s.client.logf("stardog: database %s option %s changed from %v to %v", database, change.Option, change.Old, change.New)
+		}
+	}
+	return changes, resp, nil
+}
+
+// DiffMetadata returns the changes between two snapshots of a database's configuration options, such as those
+// returned by [DatabaseAdminService.AllMetadata], ordered by option.
+func DiffMetadata(before, after map[string]any) []MetadataChange {
+	var changes []MetadataChange
+	for option, old := range before {
+		if current, ok := after[option]; !ok || !reflect.DeepEqual(old, current) {
+			changes = append(changes, MetadataChange{Option: option, Old: old, New: after[option]})
+		}
+	}
+	for option, current := range after {
+		if _, ok := before[option]; !ok {
+			changes = append(changes, MetadataChange{Option: option, New: current})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Option < changes[j].Option
+	})
+	return changes
+}
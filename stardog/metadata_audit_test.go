@@ -0,0 +1,87 @@
+package stardog
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDatabaseAdminService_SetMetadataAudited(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	snapshots := []string{
+		`{"search.enabled": false, "database.namespaces": ["schema=http://schema.org/"], "query.timeout": "5m"}`,
+		`{"search.enabled": true, "database.namespaces": ["schema=http://schema.org/"], "search.index.datatypes": ["xsd:string"]}`,
+	}
+	gets := 0
+	mux.HandleFunc(fmt.Sprintf("/admin/databases/%s/options", db), func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(snapshots[gets]))
+			gets++
+		case http.MethodPost:
+			if gets != 1 {
+				t.Errorf("options set after %d snapshots, want 1", gets)
+			}
+			testBody(t, r, `{"search.enabled":true}`+"\n")
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	var logs bytes.Buffer
+	client.Logger = log.New(&logs, "", 0)
+
+	ctx := context.Background()
+	got, _, err := client.DatabaseAdmin.SetMetadataAudited(ctx, db, map[string]any{"search.enabled": true}, &MetadataAuditOptions{Log: true})
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.SetMetadataAudited returned error: %v", err)
+	}
+	want := []MetadataChange{
+		{Option: "query.timeout", Old: "5m", New: nil},
+		{Option: "search.enabled", Old: false, New: true},
+		{Option: "search.index.datatypes", Old: nil, New: []any{"xsd:string"}},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("DatabaseAdmin.SetMetadataAudited = %+v, want %+v", got, want)
+	}
+	wantLogs := "stardog: database db1 option query.timeout changed from 5m to <nil>\n" +
+		"stardog: database db1 option search.enabled changed from false to true\n" +
+		"stardog: database db1 option search.index.datatypes changed from <nil> to [xsd:string]\n"
+	if got := logs.String(); got != wantLogs {
+		t.Errorf("log = %q, want %q", got, wantLogs)
+	}
+
+	const methodName = "SetMetadataAudited"
+	testBadOptions(t, methodName, func() error {
+		_, _, err := client.DatabaseAdmin.SetMetadataAudited(ctx, "", nil, nil)
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.DatabaseAdmin.SetMetadataAudited(nil, db, nil, nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestDiffMetadata(t *testing.T) {
+	before := map[string]any{"a": 1.0, "b": []any{"x"}}
+	if got := DiffMetadata(before, map[string]any{"a": 1.0, "b": []any{"x"}}); len(got) != 0 {
+		t.Errorf("DiffMetadata = %+v, want no changes", got)
+	}
+	got := DiffMetadata(before, map[string]any{"a": 1.0, "b": []any{"x", "y"}})
+	want := []MetadataChange{{Option: "b", Old: []any{"x"}, New: []any{"x", "y"}}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("DiffMetadata = %+v, want %+v", got, want)
+	}
+}
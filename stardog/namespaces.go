@@ -0,0 +1,71 @@
+package stardog
+
+import "strings"
+
+// CompactIRI returns the IRI as a prefixed name (e.g. "foaf:name") using the namespace with the longest
+// matching name, or enclosed in angle brackets if no namespace matches or the remainder of the IRI isn't a
+// valid local name. It's the client-side counterpart of the UseNamespaces query option for result formats
+// the server doesn't abbreviate, such as the SPARQL Query Results JSON Format. The namespaces are typically
+// those of the database, from [DatabaseAdminService.Namespaces].
+func CompactIRI(iri string, namespaces []Namespace) string {
+	best := -1
+	for i, ns := range namespaces {
+		if ns.Name == "" || !strings.HasPrefix(iri, ns.Name) || !isPrefixedNameLocal(iri[len(ns.Name):]) {
+			continue
+		}
+		if best < 0 || len(ns.Name) > len(namespaces[best].Name) {
+			best = i
+		}
+	}
+	if best < 0 {
+		return quoteIRI(iri)
+	}
+	return namespaces[best].Prefix + ":" + iri[len(namespaces[best].Name):]
+}
+
+// isPrefixedNameLocal reports whether s can be written as the local part of a prefixed name without escaping.
+// It's a conservative subset of the Turtle PN_LOCAL production that's limited to ASCII.
+func isPrefixedNameLocal(s string) bool {
+	if s == "" {
+		return true
+	}
+	if s[0] == '-' || s[0] == '.' || s[len(s)-1] == '.' {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !isAlphaNumeric(c) && c != '_' && c != '-' && c != '.' && c != ':' {
+			return false
+		}
+	}
+	return true
+}
+
+// Compact returns the term in the compact syntax of Turtle and SPARQL, abbreviating IRIs, including
+// datatype IRIs and the terms of quoted triples, with [CompactIRI]. Strings are written without their
+// datatype and blank nodes with the "_:" prefix. Blank node labels and language tags are sanitized as by
+// [BNode.String] and [Literal.String], so the result can be placed in a query. For example:
+//
+//	foaf:name
+//	"Paul"@en
+//	"42"^^xsd:integer
+//	<< ex:paul foaf:age "42"^^xsd:integer >>
+func (t BindingValue) Compact(namespaces []Namespace) string {
+	switch t.Type {
+	case "uri":
+		return CompactIRI(t.Value, namespaces)
+	case "bnode":
+		return BNode(t.Value).String()
+	case "triple":
+		if t.Triple == nil {
+			return ""
+		}
+		return "<< " + t.Triple.Subject.Compact(namespaces) + " " + t.Triple.Predicate.Compact(namespaces) + " " +
+			t.Triple.Object.Compact(namespaces) + " >>"
+	default:
+		if t.Lang != "" || t.Datatype == "" || t.Datatype == xsdNamespace+"string" {
+			return Literal{Value: t.Value, Lang: t.Lang}.String()
+		}
+		return quoteLiteral(t.Value) + "^^" + CompactIRI(t.Datatype, namespaces)
+	}
+}
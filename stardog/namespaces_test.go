@@ -0,0 +1,63 @@
+package stardog
+
+import "testing"
+
+var testNamespaces = []Namespace{
+	{Prefix: "ex", Name: "http://example.com/"},
+	{Prefix: "people", Name: "http://example.com/people/"},
+	{Prefix: "xsd", Name: "http://www.w3.org/2001/XMLSchema#"},
+	{Prefix: "", Name: "urn:default:"},
+}
+
+func TestCompactIRI(t *testing.T) {
+	tests := []struct {
+		iri  string
+		want string
+	}{
+		{"http://example.com/paul", "ex:paul"},
+		{"http://example.com/people/john", "people:john"},
+		{"http://example.com/", "ex:"},
+		{"urn:default:thing", ":thing"},
+		{"http://example.com/a/b", "<http://example.com/a/b>"},
+		{"http://example.com/name.", "<http://example.com/name.>"},
+		{"http://example.com/-x", "<http://example.com/-x>"},
+		{"http://example.com/x-1.y_z", "ex:x-1.y_z"},
+		{"http://other.com/paul", "<http://other.com/paul>"},
+	}
+	for _, tc := range tests {
+		if got := CompactIRI(tc.iri, testNamespaces); got != tc.want {
+			t.Errorf("CompactIRI(%q) = %q, want %q", tc.iri, got, tc.want)
+		}
+	}
+	if got, want := CompactIRI("http://example.com/paul", nil), "<http://example.com/paul>"; got != want {
+		t.Errorf("CompactIRI without namespaces = %q, want %q", got, want)
+	}
+}
+
+func TestBindingValue_Compact(t *testing.T) {
+	tests := []struct {
+		value BindingValue
+		want  string
+	}{
+		{BindingValue{Type: "uri", Value: "http://example.com/paul"}, "ex:paul"},
+		{BindingValue{Type: "bnode", Value: "b0"}, "_:b0"},
+		{BindingValue{Type: "literal", Value: "Paul"}, `"Paul"`},
+		{BindingValue{Type: "literal", Value: "Paul", Datatype: xsdNamespace + "string"}, `"Paul"`},
+		{BindingValue{Type: "literal", Value: "Paul", Lang: "en"}, `"Paul"@en`},
+		{BindingValue{Type: "literal", Value: "42", Datatype: xsdNamespace + "integer"}, `"42"^^xsd:integer`},
+		{BindingValue{Type: "literal", Value: "x", Datatype: "urn:type"}, `"x"^^<urn:type>`},
+		{BindingValue{Type: "literal", Value: "say \"hi\"\n\\"}, `"say \"hi\"\n\\"`},
+		{BindingValue{Type: "bnode", Value: "x } ; DROP ALL #"}, "_:x_____DROP_ALL__"},
+		{BindingValue{Type: "literal", Value: "a", Lang: "en } DROP ALL # }"}, `"a"@enDROPALL`},
+		{BindingValue{Type: "triple", Triple: &QuotedTriple{
+			Subject:   BindingValue{Type: "uri", Value: "http://example.com/paul"},
+			Predicate: BindingValue{Type: "uri", Value: "http://example.com/age"},
+			Object:    BindingValue{Type: "literal", Value: "42", Datatype: xsdNamespace + "integer"},
+		}}, `<< ex:paul ex:age "42"^^xsd:integer >>`},
+	}
+	for _, tc := range tests {
+		if got := tc.value.Compact(testNamespaces); got != tc.want {
+			t.Errorf("Compact(%+v) = %s, want %s", tc.value, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,261 @@
+package stardog
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// rdfTermKind is the kind of an RDF term.
+type rdfTermKind int
+
+const (
+	rdfTermIRI rdfTermKind = iota
+	rdfTermBlankNode
+	rdfTermLiteral
+	// a quoted triple (RDF-star)
+	rdfTermTriple
+)
+
+// rdfTerm is an RDF term parsed from N-Triples.
+type rdfTerm struct {
+	kind rdfTermKind
+	// the IRI, blank node label (including the "_:" prefix) or lexical form of a literal
+	value    string
+	datatype string
+	lang     string
+	// the quoted triple, if the term is an rdfTermTriple
+	triple *rdfTriple
+}
+
+// rdfTriple is an RDF triple parsed from N-Triples.
+type rdfTriple struct {
+	subject   rdfTerm
+	predicate rdfTerm
+	object    rdfTerm
+}
+
+// parseNTriples parses an [N-Triples] document. It supports the full N-Triples grammar, including
+// [N-Triples-star] quoted triples, but does not validate IRIs or blank node labels beyond what's needed to tokenize them.
+//
+// [N-Triples]: https://www.w3.org/TR/n-triples/
+// [N-Triples-star]: https://www.w3.org/2021/12/rdf-star.html#n-triples-star
+func parseNTriples(r io.Reader) ([]rdfTriple, error) {
+	var triples []rdfTriple
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		triple, err := parseNTriplesLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("parsing N-Triples line %d: %w", lineNumber, err)
+		}
+		triples = append(triples, triple)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return triples, nil
+}
+
+func parseNTriplesLine(line string) (rdfTriple, error) {
+	p := &nTriplesLexer{input: line}
+	triple, err := p.triple()
+	if err != nil {
+		return triple, err
+	}
+	p.skipSpace()
+	if !strings.HasPrefix(p.input[p.pos:], ".") {
+		return triple, fmt.Errorf("expected '.' at position %d", p.pos)
+	}
+	p.pos++
+	p.skipSpace()
+	if rest := p.input[p.pos:]; rest != "" && !strings.HasPrefix(rest, "#") {
+		return triple, fmt.Errorf("unexpected content after '.': %q", rest)
+	}
+	return triple, nil
+}
+
+// nTriplesLexer tokenizes the terms of a single N-Triples line.
+type nTriplesLexer struct {
+	input string
+	pos   int
+}
+
+// triple reads the subject, predicate and object of a triple.
+func (p *nTriplesLexer) triple() (rdfTriple, error) {
+	var triple rdfTriple
+	var err error
+	if triple.subject, err = p.term(); err != nil {
+		return triple, err
+	}
+	if triple.subject.kind == rdfTermLiteral {
+		return triple, fmt.Errorf("subject must not be a literal")
+	}
+	if triple.predicate, err = p.term(); err != nil {
+		return triple, err
+	}
+	if triple.predicate.kind != rdfTermIRI {
+		return triple, fmt.Errorf("predicate must be an IRI")
+	}
+	if triple.object, err = p.term(); err != nil {
+		return triple, err
+	}
+	return triple, nil
+}
+
+func (p *nTriplesLexer) skipSpace() {
+	for p.pos < len(p.input) && (p.input[p.pos] == ' ' || p.input[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+func (p *nTriplesLexer) term() (rdfTerm, error) {
+	p.skipSpace()
+	if p.pos >= len(p.input) {
+		return rdfTerm{}, fmt.Errorf("unexpected end of line")
+	}
+	switch {
+	case strings.HasPrefix(p.input[p.pos:], "<<"):
+		p.pos += 2
+		quoted, err := p.triple()
+		if err != nil {
+			return rdfTerm{}, err
+		}
+		p.skipSpace()
+		if !strings.HasPrefix(p.input[p.pos:], ">>") {
+			return rdfTerm{}, fmt.Errorf("expected '>>' at position %d", p.pos)
+		}
+		p.pos += 2
+		return rdfTerm{kind: rdfTermTriple, triple: &quoted}, nil
+	case p.input[p.pos] == '<':
+		iri, err := p.iri()
+		return rdfTerm{kind: rdfTermIRI, value: iri}, err
+	case strings.HasPrefix(p.input[p.pos:], "_:"):
+		start := p.pos
+		for p.pos < len(p.input) && !strings.ContainsRune(" \t<>", rune(p.input[p.pos])) {
+			p.pos++
+		}
+		// a blank node label can't end with '.', so it belongs to the end of the triple
+		label := strings.TrimSuffix(p.input[start:p.pos], ".")
+		p.pos = start + len(label)
+		return rdfTerm{kind: rdfTermBlankNode, value: label}, nil
+	case p.input[p.pos] == '"':
+		return p.literal()
+	default:
+		return rdfTerm{}, fmt.Errorf("unexpected character %q at position %d", p.input[p.pos], p.pos)
+	}
+}
+
+func (p *nTriplesLexer) iri() (string, error) {
+	end := strings.IndexByte(p.input[p.pos:], '>')
+	if end < 0 {
+		return "", fmt.Errorf("unterminated IRI at position %d", p.pos)
+	}
+	raw := p.input[p.pos+1 : p.pos+end]
+	p.pos += end + 1
+	return unescapeNTriples(raw)
+}
+
+func (p *nTriplesLexer) literal() (rdfTerm, error) {
+	// find the closing quote, skipping escaped characters
+	start := p.pos + 1
+	end := start
+	for ; end < len(p.input); end++ {
+		if p.input[end] == '\\' {
+			end++
+			continue
+		}
+		if p.input[end] == '"' {
+			break
+		}
+	}
+	if end >= len(p.input) {
+		return rdfTerm{}, fmt.Errorf("unterminated literal at position %d", p.pos)
+	}
+	value, err := unescapeNTriples(p.input[start:end])
+	if err != nil {
+		return rdfTerm{}, err
+	}
+	p.pos = end + 1
+	term := rdfTerm{kind: rdfTermLiteral, value: value}
+
+	switch {
+	case strings.HasPrefix(p.input[p.pos:], "^^"):
+		p.pos += 2
+		if p.pos >= len(p.input) || p.input[p.pos] != '<' {
+			return rdfTerm{}, fmt.Errorf("expected datatype IRI at position %d", p.pos)
+		}
+		if term.datatype, err = p.iri(); err != nil {
+			return rdfTerm{}, err
+		}
+	case strings.HasPrefix(p.input[p.pos:], "@"):
+		start := p.pos + 1
+		p.pos = start
+		for p.pos < len(p.input) && (isAlphaNumeric(p.input[p.pos]) || p.input[p.pos] == '-') {
+			p.pos++
+		}
+		term.lang = p.input[start:p.pos]
+	}
+	return term, nil
+}
+
+func isAlphaNumeric(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
+
+// unescapeNTriples replaces the escape sequences allowed in N-Triples IRIs and literals.
+func unescapeNTriples(s string) (string, error) {
+	if !strings.Contains(s, `\`) {
+		return s, nil
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		if i >= len(s) {
+			return "", fmt.Errorf("invalid escape sequence at end of %q", s)
+		}
+		switch s[i] {
+		case 't':
+			b.WriteByte('\t')
+		case 'b':
+			b.WriteByte('\b')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 'f':
+			b.WriteByte('\f')
+		case '"', '\'', '\\':
+			b.WriteByte(s[i])
+		case 'u', 'U':
+			size := 4
+			if s[i] == 'U' {
+				size = 8
+			}
+			if i+1+size > len(s) {
+				return "", fmt.Errorf("invalid unicode escape in %q", s)
+			}
+			codePoint, err := strconv.ParseUint(s[i+1:i+1+size], 16, 32)
+			if err != nil {
+				return "", fmt.Errorf("invalid unicode escape in %q", s)
+			}
+			b.WriteRune(rune(codePoint))
+			i += size
+		default:
+			return "", fmt.Errorf("invalid escape sequence \\%c in %q", s[i], s)
+		}
+	}
+	return b.String(), nil
+}
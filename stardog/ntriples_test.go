@@ -0,0 +1,104 @@
+package stardog
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_parseNTriples(t *testing.T) {
+	input := `
+# a comment
+<http://example.com/s> <http://example.com/p> <http://example.com/o> .
+_:b0 <http://example.com/p> "plain" .
+_:b0 <http://example.com/p> "chat"@fr .
+_:b0 <http://example.com/p> "42"^^<http://www.w3.org/2001/XMLSchema#integer> .
+_:b0 <http://example.com/p> "say \"hi\"\né\U0001F600" .
+_:b0 <http://example.com/p> _:b1.
+`
+	got, err := parseNTriples(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("parseNTriples returned error: %v", err)
+	}
+
+	p := rdfTerm{kind: rdfTermIRI, value: "http://example.com/p"}
+	b0 := rdfTerm{kind: rdfTermBlankNode, value: "_:b0"}
+	want := []rdfTriple{
+		{
+			subject:   rdfTerm{kind: rdfTermIRI, value: "http://example.com/s"},
+			predicate: p,
+			object:    rdfTerm{kind: rdfTermIRI, value: "http://example.com/o"},
+		},
+		{subject: b0, predicate: p, object: rdfTerm{kind: rdfTermLiteral, value: "plain"}},
+		{subject: b0, predicate: p, object: rdfTerm{kind: rdfTermLiteral, value: "chat", lang: "fr"}},
+		{subject: b0, predicate: p, object: rdfTerm{kind: rdfTermLiteral, value: "42", datatype: "http://www.w3.org/2001/XMLSchema#integer"}},
+		{subject: b0, predicate: p, object: rdfTerm{kind: rdfTermLiteral, value: "say \"hi\"\né😀"}},
+		{subject: b0, predicate: p, object: rdfTerm{kind: rdfTermBlankNode, value: "_:b1"}},
+	}
+	if !cmp.Equal(got, want, cmp.AllowUnexported(rdfTriple{}, rdfTerm{})) {
+		t.Errorf("parseNTriples = %+v, want %+v", got, want)
+	}
+}
+
+func Test_parseNTriples_quotedTriples(t *testing.T) {
+	input := `<< <urn:paul> <urn:memberOf> _:band >> <urn:since> "1960" .
+<urn:john> <urn:said> <<_:b <urn:p> << <urn:s> <urn:p> "o" >>>> .`
+	got, err := parseNTriples(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("parseNTriples returned error: %v", err)
+	}
+
+	memberOf := rdfTriple{
+		subject:   rdfTerm{kind: rdfTermIRI, value: "urn:paul"},
+		predicate: rdfTerm{kind: rdfTermIRI, value: "urn:memberOf"},
+		object:    rdfTerm{kind: rdfTermBlankNode, value: "_:band"},
+	}
+	innermost := rdfTriple{
+		subject:   rdfTerm{kind: rdfTermIRI, value: "urn:s"},
+		predicate: rdfTerm{kind: rdfTermIRI, value: "urn:p"},
+		object:    rdfTerm{kind: rdfTermLiteral, value: "o"},
+	}
+	nested := rdfTriple{
+		subject:   rdfTerm{kind: rdfTermBlankNode, value: "_:b"},
+		predicate: rdfTerm{kind: rdfTermIRI, value: "urn:p"},
+		object:    rdfTerm{kind: rdfTermTriple, triple: &innermost},
+	}
+	want := []rdfTriple{
+		{
+			subject:   rdfTerm{kind: rdfTermTriple, triple: &memberOf},
+			predicate: rdfTerm{kind: rdfTermIRI, value: "urn:since"},
+			object:    rdfTerm{kind: rdfTermLiteral, value: "1960"},
+		},
+		{
+			subject:   rdfTerm{kind: rdfTermIRI, value: "urn:john"},
+			predicate: rdfTerm{kind: rdfTermIRI, value: "urn:said"},
+			object:    rdfTerm{kind: rdfTermTriple, triple: &nested},
+		},
+	}
+	if !cmp.Equal(got, want, cmp.AllowUnexported(rdfTriple{}, rdfTerm{})) {
+		t.Errorf("parseNTriples = %+v, want %+v", got, want)
+	}
+
+	if _, err := parseNTriples(strings.NewReader(`<< <urn:s> <urn:p> <urn:o> <urn:p> <urn:o> .`)); err == nil {
+		t.Errorf("parseNTriples err = nil, want error for unterminated quoted triple")
+	}
+}
+
+func Test_parseNTriples_invalid(t *testing.T) {
+	inputs := []string{
+		`"literal" <http://example.com/p> <http://example.com/o> .`,
+		`<http://example.com/s> _:p <http://example.com/o> .`,
+		`<http://example.com/s> <http://example.com/p> <http://example.com/o>`,
+		`<http://example.com/s> <http://example.com/p> "unterminated .`,
+		`<http://example.com/s> <http://example.com/p> "bad \q escape" .`,
+		`<http://example.com/s> <http://example.com/p> "short \u00" .`,
+		`<http://example.com/s> <http://example.com/p> <http://example.com/o> . extra`,
+		`<http://example.com/s <http://example.com/p> <http://example.com/o> .`,
+	}
+	for _, input := range inputs {
+		if _, err := parseNTriples(strings.NewReader(input)); err == nil {
+			t.Errorf("parseNTriples(%s) err = nil, want error", input)
+		}
+	}
+}
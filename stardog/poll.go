@@ -0,0 +1,105 @@
+package stardog
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+const (
+	defaultPollInterval    = time.Second
+	defaultMaxPollInterval = 30 * time.Second
+	// pollJitter is the fraction by which each wait of a Poller is randomly lengthened or shortened
+	pollJitter = 0.2
+)
+
+// Poller polls until a long-running operation completes, such as a database coming back online after
+// [DatabaseAdminService.Optimize] or [DatabaseAdminService.Repair]. The wait between checks starts at
+// Interval and doubles after each check up to MaxInterval, and every wait is randomly lengthened or shortened
+// by up to a fifth so clients polling the same server don't do so in lockstep.
+type Poller struct {
+	// How long to wait before the first check is repeated (default 1 second)
+	Interval time.Duration
+	// The longest to wait between checks (default 30 seconds)
+	MaxInterval time.Duration
+}
+
+// WaitFor calls check until it reports that it's done, waiting between calls as a [Poller] with the interval
+// does. It's a shorthand for Poller.Wait.
+func WaitFor(ctx context.Context, check func(context.Context) (bool, error), interval time.Duration) error {
+	p := &Poller{Interval: interval}
+	return p.Wait(ctx, check)
+}
+
+// Wait calls check until it reports that it's done, returning nil. It stops early, returning the error, if check
+// returns an error or ctx is done, so give ctx a deadline to bound how long to wait. A nil Poller uses the
+// default intervals.
+func (p *Poller) Wait(ctx context.Context, check func(context.Context) (bool, error)) error {
+	if ctx == nil {
+		return errNonNilContext
+	}
+	for attempt := 0; ; attempt++ {
+		done, err := check(ctx)
+		if err != nil || done {
+			return err
+		}
+		if err := sleepContext(ctx, p.delay(attempt)); err != nil {
+			return err
+		}
+	}
+}
+
+// delay returns how long to wait after the check made on the attempt (counting from 0).
+func (p *Poller) delay(attempt int) time.Duration {
+	interval, maxInterval := defaultPollInterval, defaultMaxPollInterval
+	if p != nil && p.Interval > 0 {
+		interval = p.Interval
+	}
+	if p != nil && p.MaxInterval > 0 {
+		maxInterval = p.MaxInterval
+	}
+	delay := maxInterval
+	// avoid overflowing the shift after many attempts
+	if backoff := interval << attempt; attempt < 32 && backoff > 0 && backoff < maxInterval {
+		delay = backoff
+	}
+	return time.Duration(float64(delay) * (1 + pollJitter*(2*rand.Float64()-1)))
+}
+
+// WaitForOnline waits until the database is online, e.g. after [DatabaseAdminService.Online],
+// [DatabaseAdminService.Optimize] or [DatabaseAdminService.Repair], polling as the Poller does. A nil Poller
+// uses the default intervals. The response is that of the last check.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/getDatabaseOptions
+func (s *DatabaseAdminService) WaitForOnline(ctx context.Context, database string, poller *Poller) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	var resp *Response
+	err := poller.Wait(ctx, func(ctx context.Context) (bool, error) {
+		var online bool
+		var err error
+		online, resp, err = s.isOnline(ctx, database)
+		return online, err
+	})
+	return resp, err
+}
+
+// WaitForAvailable waits until the data source is available, e.g. after [DataSourceService.Online] or while
+// the system it connects to is restarting, polling as the Poller does. A nil Poller uses the default intervals.
+// The response is that of the last check.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Data-Sources/operation/availableDataSource
+func (s *DataSourceService) WaitForAvailable(ctx context.Context, datasource string, poller *Poller) (*Response, error) {
+	var resp *Response
+	err := poller.Wait(ctx, func(ctx context.Context) (bool, error) {
+		var available *bool
+		var err error
+		available, resp, err = s.IsAvailable(ctx, datasource)
+		if err != nil {
+			return false, err
+		}
+		return *available, nil
+	})
+	return resp, err
+}
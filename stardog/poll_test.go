@@ -0,0 +1,131 @@
+package stardog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWaitFor(t *testing.T) {
+	checks := 0
+	err := WaitFor(context.Background(), func(ctx context.Context) (bool, error) {
+		checks++
+		return checks == 3, nil
+	}, time.Millisecond)
+	if err != nil {
+		t.Errorf("WaitFor returned error: %v", err)
+	}
+	if checks != 3 {
+		t.Errorf("WaitFor checked %d times, want 3", checks)
+	}
+}
+
+func TestWaitFor_checkError(t *testing.T) {
+	checkErr := errors.New("check failed")
+	err := WaitFor(context.Background(), func(ctx context.Context) (bool, error) {
+		return false, checkErr
+	}, time.Millisecond)
+	if !errors.Is(err, checkErr) {
+		t.Errorf("WaitFor error = %v, want %v", err, checkErr)
+	}
+}
+
+func TestWaitFor_contextDone(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := WaitFor(ctx, func(ctx context.Context) (bool, error) {
+		return false, nil
+	}, time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("WaitFor error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	if err := WaitFor(nil, func(ctx context.Context) (bool, error) { return true, nil }, time.Millisecond); err != errNonNilContext {
+		t.Errorf("WaitFor error = %v, want %v", err, errNonNilContext)
+	}
+}
+
+func TestPoller_delay(t *testing.T) {
+	within := func(got, want time.Duration) bool {
+		return float64(got) >= float64(want)*(1-pollJitter) && float64(got) <= float64(want)*(1+pollJitter)
+	}
+	p := &Poller{Interval: 100 * time.Millisecond, MaxInterval: time.Second}
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{0, 100 * time.Millisecond},
+		{1, 200 * time.Millisecond},
+		{3, 800 * time.Millisecond},
+		{4, time.Second},
+		{100, time.Second},
+	}
+	for _, tt := range tests {
+		if got := p.delay(tt.attempt); !within(got, tt.want) {
+			t.Errorf("Poller.delay(%d) = %v, want %v give or take %v", tt.attempt, got, tt.want, pollJitter)
+		}
+	}
+
+	var defaults *Poller
+	if got := defaults.delay(0); !within(got, defaultPollInterval) {
+		t.Errorf("Poller.delay(0) = %v, want %v for a nil Poller", got, defaultPollInterval)
+	}
+}
+
+func TestDatabaseAdminService_WaitForOnline(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	checks := 0
+	mux.HandleFunc("/admin/databases/db1/options", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		checks++
+		fmt.Fprintf(w, `{"database.online": %t}`, checks == 2)
+	})
+
+	ctx := context.Background()
+	poller := &Poller{Interval: time.Millisecond}
+	if _, err := client.DatabaseAdmin.WaitForOnline(ctx, "db1", poller); err != nil {
+		t.Errorf("DatabaseAdmin.WaitForOnline returned error: %v", err)
+	}
+	if checks != 2 {
+		t.Errorf("DatabaseAdmin.WaitForOnline checked %d times, want 2", checks)
+	}
+
+	const methodName = "WaitForOnline"
+	testBadOptions(t, methodName, func() error {
+		_, err := client.DatabaseAdmin.WaitForOnline(ctx, "", poller)
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.DatabaseAdmin.WaitForOnline(nil, "db1", poller)
+	})
+}
+
+func TestDataSourceService_WaitForAvailable(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	checks := 0
+	mux.HandleFunc("/admin/data_sources/postgres/available", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		checks++
+		fmt.Fprintf(w, "%t", checks == 3)
+	})
+
+	ctx := context.Background()
+	if _, err := client.DataSource.WaitForAvailable(ctx, "postgres", &Poller{Interval: time.Millisecond}); err != nil {
+		t.Errorf("DataSource.WaitForAvailable returned error: %v", err)
+	}
+	if checks != 3 {
+		t.Errorf("DataSource.WaitForAvailable checked %d times, want 3", checks)
+	}
+
+	const methodName = "WaitForAvailable"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.DataSource.WaitForAvailable(nil, "postgres", nil)
+	})
+}
@@ -3,10 +3,14 @@ package stardog
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // SPARQLService handles communication with the SPARQL methods of the Stardog API.
@@ -18,8 +22,9 @@ type SelectOptions struct {
 	Reasoning bool `url:"reasoning,omitempty"`
 	// The name of the schema
 	Schema string `url:"schema,omitempty"`
-	// The transaction ID
-	TxID string `url:"txid,omitempty"`
+	// The ID of a transaction (see [TransactionService.Begin]) to execute the query in. The query sees the changes
+	// made in the transaction before they are committed.
+	TxID string `url:"-"`
 	// Base URI against which to resolve relative URIs
 	BaseURI string `url:"baseURI,omitempty"`
 	// The number of milliseconds after which the query should timeout
@@ -28,7 +33,8 @@ type SelectOptions struct {
 	Limit int `url:"limit,omitempty"`
 	// How far into the result set to offset
 	Offset int `url:"offset,omitempty"`
-	// Request query results with namespace substitution/prefix lines
+	// Request query results with namespace substitution/prefix lines. Only result formats with prefixed names
+	// (e.g. Turtle) are abbreviated; for other formats see [CompactIRI] and [BindingValue.Compact].
 	UseNamespaces bool `url:"useNamespaces,omitempty"`
 	// URI(s) to be used as the default graph (equivalent to FROM)
 	DefaultGraphURI string `url:"default-graph-uri,omitempty"`
@@ -37,6 +43,8 @@ type SelectOptions struct {
 
 	// Result format of the query results
 	ResultFormat QueryResultFormat `url:"-"`
+	// Values bound to the $name parameters of the query, keyed by name without the $. See [Query].
+	Parameters map[string]any `url:"-"`
 }
 
 // AskOptions specifies the optional parameters to the [SPARQLService.Ask] method
@@ -45,8 +53,9 @@ type AskOptions struct {
 	Reasoning bool `url:"reasoning,omitempty"`
 	// The name of the schema
 	Schema string `url:"schema,omitempty"`
-	// The transaction ID
-	TxID string `url:"txid,omitempty"`
+	// The ID of a transaction (see [TransactionService.Begin]) to execute the query in. The query sees the changes
+	// made in the transaction before they are committed.
+	TxID string `url:"-"`
 	// Base URI against which to resolve relative URIs
 	BaseURI string `url:"baseURI,omitempty"`
 	// The number of milliseconds after which the query should timeout
@@ -55,6 +64,13 @@ type AskOptions struct {
 	DefaultGraphURI string `url:"default-graph-uri,omitempty"`
 	// URI(s) to be used as named graphs (equivalent to FROM NAMED)
 	NamedGraphURI string `url:"named-graph-uri,omitempty"`
+	// Values bound to the $name parameters of the query, keyed by name without the $. See [Query].
+	Parameters map[string]any `url:"-"`
+
+	// The format the server sends the result in: QueryResultFormatSparqlResultsJSON or
+	// QueryResultFormatSparqlResultsXML, for servers or proxies that only negotiate SPARQL results formats.
+	// If unset, the result is requested as plain text. Ask decodes the result in any of them.
+	ResultFormat QueryResultFormat `url:"-"`
 }
 
 // ConstructOptions specifies the optional parameters to the [SPARQLService.Construct] method
@@ -63,8 +79,9 @@ type ConstructOptions struct {
 	Reasoning bool `url:"reasoning,omitempty"`
 	// The name of the schema
 	Schema string `url:"schema,omitempty"`
-	// The transaction ID
-	TxID string `url:"txid,omitempty"`
+	// The ID of a transaction (see [TransactionService.Begin]) to execute the query in. The query sees the changes
+	// made in the transaction before they are committed.
+	TxID string `url:"-"`
 	// Base URI against which to resolve relative URIs
 	BaseURI string `url:"baseURI,omitempty"`
 	// The number of milliseconds after which the query should timeout
@@ -73,7 +90,8 @@ type ConstructOptions struct {
 	Limit int `url:"limit,omitempty"`
 	// How far into the result set to offset
 	Offset int `url:"offset,omitempty"`
-	// Request query results with namespace substitution/prefix lines
+	// Request query results with namespace substitution/prefix lines. Only result formats with prefixed names
+	// (e.g. Turtle) are abbreviated; for other formats see [CompactIRI] and [BindingValue.Compact].
 	UseNamespaces bool `url:"useNamespaces,omitempty"`
 	// URI(s) to be used as the default graph (equivalent to FROM)
 	DefaultGraphURI string `url:"default-graph-uri,omitempty"`
@@ -82,6 +100,91 @@ type ConstructOptions struct {
 
 	// RDF Serialization Format for results
 	ResultFormat RDFFormat `url:"-"`
+	// Values bound to the $name parameters of the query, keyed by name without the $. See [Query].
+	Parameters map[string]any `url:"-"`
+}
+
+// DescribeOptions specifies the optional parameters to the [SPARQLService.Describe] method
+type DescribeOptions struct {
+	// Enable reasoning
+	Reasoning bool
+	// The name of the schema
+	Schema string
+	// The ID of a transaction (see [TransactionService.Begin]) to execute the query in. The query sees the changes
+	// made in the transaction before they are committed.
+	TxID string
+	// Base URI against which to resolve relative URIs
+	BaseURI string
+	// The number of milliseconds after which the query should timeout
+	Timeout int
+	// URI(s) to be used as the default graph (equivalent to FROM)
+	DefaultGraphURI string
+	// URI(s) to be used as named graphs (equivalent to FROM NAMED)
+	NamedGraphURI string
+
+	// How resources are described. If unset, the database's query.describe.strategy option is used.
+	Strategy DescribeStrategy
+
+	// RDF Serialization Format for results
+	ResultFormat RDFFormat
+	// Values bound to the $name parameters of the query, keyed by name without the $. See [Query].
+	Parameters map[string]any
+}
+
+// constructOptions returns the options to send the DESCRIBE query with, which are those of a CONSTRUCT query.
+func (o *DescribeOptions) constructOptions() *ConstructOptions {
+	if o == nil {
+		return nil
+	}
+	return &ConstructOptions{
+		Reasoning:       o.Reasoning,
+		Schema:          o.Schema,
+		TxID:            o.TxID,
+		BaseURI:         o.BaseURI,
+		Timeout:         o.Timeout,
+		DefaultGraphURI: o.DefaultGraphURI,
+		NamedGraphURI:   o.NamedGraphURI,
+		ResultFormat:    o.ResultFormat,
+		Parameters:      o.Parameters,
+	}
+}
+
+// DescribeStrategy determines which triples Stardog returns to [describe] a resource.
+// The zero value for a DescribeStrategy is [DescribeStrategyUnknown]
+//
+// [describe]: https://docs.stardog.com/query-stardog/#describe-queries
+type DescribeStrategy int
+
+// All available values for [DescribeStrategy]
+const (
+	DescribeStrategyUnknown DescribeStrategy = iota
+	// The triples with the resource as their subject
+	DescribeStrategyDefault
+	// The Concise Bounded Description of the resource: the triples with the resource as their subject,
+	// followed recursively through blank node objects
+	DescribeStrategyCBD
+	// The triples with the resource as their subject or object
+	DescribeStrategyBidirectional
+)
+
+var describeStrategyValues = [4]string{
+	DescribeStrategyUnknown:       "",
+	DescribeStrategyDefault:       "default",
+	DescribeStrategyCBD:           "cbd",
+	DescribeStrategyBidirectional: "bidirectional",
+}
+
+// Valid returns if a given DescribeStrategy is known (valid) or not.
+func (d DescribeStrategy) Valid() bool {
+	return !(d <= DescribeStrategyUnknown || int(d) >= len(describeStrategyValues))
+}
+
+// String will return the name Stardog uses for the DescribeStrategy
+func (d DescribeStrategy) String() string {
+	if !d.Valid() {
+		return describeStrategyValues[DescribeStrategyUnknown]
+	}
+	return describeStrategyValues[d]
 }
 
 // UpdateOptions specifies the optional parameters to the [SPARQLService.Update] method
@@ -90,8 +193,9 @@ type UpdateOptions struct {
 	Reasoning bool `url:"reasoning,omitempty"`
 	// The name of the schema
 	Schema string `url:"schema,omitempty"`
-	// The transaction ID
-	TxID string `url:"txid,omitempty"`
+	// The ID of a transaction (see [TransactionService.Begin]) to execute the update in. The changes are
+	// only visible outside the transaction once it's committed.
+	TxID string `url:"-"`
 	// Base URI against which to resolve relative URIs
 	BaseURI string `url:"baseURI,omitempty"`
 	// The number of milliseconds after which the query should timeout
@@ -114,9 +218,12 @@ type UpdateOptions struct {
 	InsertGraphURI string `url:"insert-graph-uri,omitempty"`
 	// URI of the graph to be removed from
 	RemoveGraphURI string `url:"remove-graph-uri,omitempty"`
+	// Values bound to the $name parameters of the update, keyed by name without the $. See [Query].
+	Parameters map[string]any `url:"-"`
 }
 
-// QueryResultFormat is the format of the Stardog query results.
+// QueryResultFormat is the format of the Stardog query results. SELECT results can be in the SPARQL results,
+// CSV, TSV or binary formats, and CONSTRUCT and DESCRIBE results in the RDF formats.
 // The zero value for a QueryResultFormat is [QueryResultFormatUnknown]
 type QueryResultFormat int
 
@@ -133,33 +240,53 @@ const (
 	QueryResultFormatSparqlResultsXML
 	QueryResultFormatCSV
 	QueryResultFormatTSV
+	// Stardog's binary format for SELECT results, the compact binary RDF results table of RDF4J
+	QueryResultFormatBinary
+	// The SPARQL-star results JSON format, for SELECT results that bind quoted triples (RDF-star)
+	QueryResultFormatSparqlStarResultsJSON
 )
 
-var queryResultFormatValues = [11]string{
-	QueryResultFormatUnknown:           "UNKNOWN",
-	QueryResultFormatTrig:              mediaTypeApplicationTrig,
-	QueryResultFormatTurtle:            mediaTypeTextTurtle,
-	QueryResultFormatRDFXML:            mediaTypeApplicationRDFXML,
-	QueryResultFormatNTriples:          mediaTypeApplicationNTriples,
-	QueryResultFormatNQuads:            mediaTypeApplicationNQuads,
-	QueryResultFormatJSONLD:            mediaTypeApplicationJSONLD,
-	QueryResultFormatSparqlResultsJSON: mediaTypeApplicationSparqlResultsJSON,
-	QueryResultFormatSparqlResultsXML:  mediaTypeApplicationSparqlResultsXML,
-	QueryResultFormatCSV:               mediaTypeTextCSV,
-	QueryResultFormatTSV:               mediaTypeTextTSV,
-}
-
-// Valid returns if a given QueryResultFormat is known (valid) or not.
+var queryResultFormatValues = [13]string{
+	QueryResultFormatUnknown:               "UNKNOWN",
+	QueryResultFormatTrig:                  mediaTypeApplicationTrig,
+	QueryResultFormatTurtle:                mediaTypeTextTurtle,
+	QueryResultFormatRDFXML:                mediaTypeApplicationRDFXML,
+	QueryResultFormatNTriples:              mediaTypeApplicationNTriples,
+	QueryResultFormatNQuads:                mediaTypeApplicationNQuads,
+	QueryResultFormatJSONLD:                mediaTypeApplicationJSONLD,
+	QueryResultFormatSparqlResultsJSON:     mediaTypeApplicationSparqlResultsJSON,
+	QueryResultFormatSparqlResultsXML:      mediaTypeApplicationSparqlResultsXML,
+	QueryResultFormatCSV:                   mediaTypeTextCSV,
+	QueryResultFormatTSV:                   mediaTypeTextTSV,
+	QueryResultFormatBinary:                mediaTypeApplicationBinaryResults,
+	QueryResultFormatSparqlStarResultsJSON: mediaTypeApplicationSparqlStarJSON,
+}
+
+// Valid returns if a given QueryResultFormat is known (valid) or not. Formats added with
+// [RegisterQueryResultFormat] are valid.
 func (q QueryResultFormat) Valid() bool {
-	return !(q <= QueryResultFormatUnknown || int(q) >= len(queryResultFormatValues))
+	if q <= QueryResultFormatUnknown {
+		return false
+	}
+	if int(q) < len(queryResultFormatValues) {
+		return true
+	}
+	_, ok := registeredQueryResultFormat(q)
+	return ok
 }
 
 // String will return the string representation of the QueryResultFormat, which is the MIME-type
 func (q QueryResultFormat) String() string {
-	if !q.Valid() {
-		return queryResultFormatValues[QueryPlanFormatUnknown]
+	if q <= QueryResultFormatUnknown {
+		return queryResultFormatValues[QueryResultFormatUnknown]
+	}
+	if int(q) < len(queryResultFormatValues) {
+		return queryResultFormatValues[q]
+	}
+	if format, ok := registeredQueryResultFormat(q); ok {
+		return format.mediaType
 	}
-	return queryResultFormatValues[q]
+	return queryResultFormatValues[QueryResultFormatUnknown]
 }
 
 // QueryPlanFormat determines the format of the [Stardog query plan].
@@ -200,6 +327,10 @@ type ExplainOptions struct {
 	Reasoning bool `url:"reasoning,omitempty"`
 	// Run the query profiler
 	Profile bool `url:"profile,omitempty"`
+	// Include additional details, such as cardinality estimates and costs, in the plan
+	Verbose bool `url:"verbose,omitempty"`
+	// URI(s) to be used as the default graph (equivalent to FROM)
+	DefaultGraphURI string `url:"default-graph-uri,omitempty"`
 
 	// Format to return query plan in ([QueryPlanFormatText] is the default)
 	QueryPlanFormat QueryPlanFormat `url:"-"`
@@ -207,16 +338,45 @@ type ExplainOptions struct {
 
 // Select performs a [SPARQL SELECT] query
 //
+// Use [SPARQLService.SelectResultSet] or [DecodeResultSet] to decode the results into a [ResultSet].
+//
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/SPARQL/operation/getSparqlQuery
 //
 // [SPARQL SELECT]: https://www.w3.org/TR/sparql11-query/#select
 func (s *SPARQLService) Select(ctx context.Context, database string, query string, opts *SelectOptions) (*bytes.Buffer, *Response, error) {
+	req, err := s.newSelectRequest(ctx, database, query, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var buf bytes.Buffer
+	resp, err := s.doQuery(ctx, database, req, &buf)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &buf, resp, err
+}
+
+// newSelectRequest validates the parameters of a SELECT query and builds the request for it.
+func (s *SPARQLService) newSelectRequest(ctx context.Context, database string, query string, opts *SelectOptions) (*http.Request, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	query, err := bindParameters(query, opts.parameters())
+	if err != nil {
+		return nil, err
+	}
+	opts = s.client.limitSelect(ctx, opts)
 	encodedQuery := url.QueryEscape(query)
-	u := fmt.Sprintf("%s/query?query=%s", database, encodedQuery)
+	u := fmt.Sprintf("%s?query=%s", sparqlPath(database, opts.txID(), "query"), encodedQuery)
 	urlWithOptions, err := addOptions(u, opts)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
+	urlWithOptions = s.client.withReasoning(ctx, urlWithOptions, opts != nil && opts.Reasoning)
 	headerOpts := requestHeaderOptions{}
 
 	if opts == nil || (opts != nil && !opts.ResultFormat.Valid()) {
@@ -227,15 +387,206 @@ func (s *SPARQLService) Select(ctx context.Context, database string, query strin
 
 	req, err := s.client.NewRequest(http.MethodGet, urlWithOptions, &headerOpts, nil)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
+	return s.client.withDeadlineTimeout(ctx, req, opts != nil && opts.Timeout > 0), nil
+}
 
-	var buf bytes.Buffer
-	resp, err := s.client.Do(ctx, req, &buf)
-	if err != nil {
-		return nil, resp, err
+// killCanceledQueryTimeout bounds how long doQuery spends killing a query whose request was canceled.
+const killCanceledQueryTimeout = 10 * time.Second
+
+// doQuery sends the request of a SPARQL query or update. If ctx is canceled or times out before the response
+// arrives and the Client's KillCanceledQueries is set, the query is killed on the server, provided it's the
+// only running query against the database with that query string: an identical query may have been sent by
+// someone else.
+func (s *SPARQLService) doQuery(ctx context.Context, database string, req *http.Request, v any) (*Response, error) {
+	resp, err := s.client.Do(ctx, req, v)
+	if err == nil || !s.client.KillCanceledQueries || ctx == nil || ctx.Err() == nil {
+		return resp, err
 	}
-	return &buf, resp, err
+	killCtx, cancel := context.WithTimeout(context.Background(), killCanceledQueryTimeout)
+	defer cancel()
+	query := req.URL.Query().Get("query")
+	if running, _, findErr := s.client.QueryManagement.Find(killCtx, database, query); findErr == nil && len(running) == 1 {
+		s.client.QueryManagement.Kill(killCtx, running[0].ID)
+	}
+	return resp, err
+}
+
+// sparqlPath returns the path of a SPARQL endpoint ("query" or "update") of a database,
+// scoped to the transaction if a transaction ID is provided.
+func sparqlPath(database string, txID string, endpoint string) string {
+	if txID == "" {
+		return fmt.Sprintf("%s/%s", database, endpoint)
+	}
+	return fmt.Sprintf("%s/%s/%s", database, url.PathEscape(txID), endpoint)
+}
+
+// reasoningKey is the context key for the reasoning override set by WithReasoning.
+type reasoningKey struct{}
+
+// WithReasoning returns a copy of ctx that enables or disables reasoning for the SPARQL queries and updates
+// made with it, overriding [Client.DefaultReasoning]. Reasoning is always enabled for requests whose options enable it.
+func WithReasoning(ctx context.Context, enabled bool) context.Context {
+	return context.WithValue(ctx, reasoningKey{}, enabled)
+}
+
+// withReasoning adds the reasoning parameter to the URL of a SPARQL request if reasoning is enabled for
+// the request by ctx or the Client's default, but not by the request's options, which add it themselves.
+func (c *Client) withReasoning(ctx context.Context, u string, requested bool) string {
+	if requested {
+		return u
+	}
+	enabled := c.DefaultReasoning
+	if ctx != nil {
+		if override, ok := ctx.Value(reasoningKey{}).(bool); ok {
+			enabled = override
+		}
+	}
+	if !enabled {
+		return u
+	}
+	if strings.Contains(u, "?") {
+		return u + "&reasoning=true"
+	}
+	return u + "?reasoning=true"
+}
+
+// withDeadlineTimeout marks a SPARQL request whose ctx has a deadline to have the timeout parameter added
+// to its URL, set to the milliseconds remaining until the deadline, so the server stops the query when the
+// Client stops waiting for it. The parameter is set by [Client.BareDo] before each attempt, so time spent
+// waiting for the rate limit or between retries isn't included. It's not added if the request's options set
+// a timeout themselves, if ctx overrides it with [WithoutDeadlineTimeout] or if the Client's
+// DisableDeadlineTimeout is set.
+func (c *Client) withDeadlineTimeout(ctx context.Context, req *http.Request, requested bool) *http.Request {
+	if requested || c.DisableDeadlineTimeout || ctx == nil {
+		return req
+	}
+	if disabled, ok := ctx.Value(deadlineTimeoutKey{}).(bool); ok && disabled {
+		return req
+	}
+	if _, ok := ctx.Deadline(); !ok {
+		return req
+	}
+	return req.WithContext(context.WithValue(req.Context(), deadlineTimeoutRequestKey{}, true))
+}
+
+// deadlineTimeoutRequestKey is the key of the request context value set by withDeadlineTimeout.
+type deadlineTimeoutRequestKey struct{}
+
+// setDeadlineTimeout sets the URL query of a request marked by withDeadlineTimeout to rawQuery, its query
+// before the timeout parameter was added, plus the milliseconds remaining until ctx's deadline.
+func setDeadlineTimeout(ctx context.Context, req *http.Request, rawQuery string) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return
+	}
+	timeout := time.Until(deadline).Milliseconds()
+	if timeout < 1 {
+		timeout = 1
+	}
+	if rawQuery != "" {
+		rawQuery += "&"
+	}
+	req.URL.RawQuery = fmt.Sprintf("%stimeout=%d", rawQuery, timeout)
+}
+
+// deadlineTimeoutKey is the context key for the opt-out set by WithoutDeadlineTimeout.
+type deadlineTimeoutKey struct{}
+
+// WithoutDeadlineTimeout returns a copy of ctx whose deadline isn't sent to Stardog as the timeout of the
+// SPARQL queries and updates made with it, leaving the server's query.timeout to apply.
+func WithoutDeadlineTimeout(ctx context.Context) context.Context {
+	return context.WithValue(ctx, deadlineTimeoutKey{}, true)
+}
+
+// unlimitedSelectKey is the context key set by withoutSelectLimit.
+type unlimitedSelectKey struct{}
+
+// withoutSelectLimit returns a copy of ctx whose SELECT queries aren't capped at the Client's MaxSelectResults,
+// for the queries the library makes itself, such as listing named graphs, which must see every result.
+func withoutSelectLimit(ctx context.Context) context.Context {
+	// a nil ctx is left for the request to reject
+	if ctx == nil {
+		return nil
+	}
+	return context.WithValue(ctx, unlimitedSelectKey{}, true)
+}
+
+// limitSelect returns the options of a SELECT query with their Limit capped at the Client's MaxSelectResults,
+// unless ctx was returned by withoutSelectLimit. The options are copied rather than modified, since callers may reuse them.
+func (c *Client) limitSelect(ctx context.Context, opts *SelectOptions) *SelectOptions {
+	maxResults := c.MaxSelectResults
+	if ctx != nil {
+		if unlimited, _ := ctx.Value(unlimitedSelectKey{}).(bool); unlimited {
+			return opts
+		}
+	}
+	if maxResults <= 0 || (opts != nil && opts.Limit > 0 && opts.Limit <= maxResults) {
+		return opts
+	}
+	limited := SelectOptions{}
+	if opts != nil {
+		limited = *opts
+	}
+	limited.Limit = maxResults
+	return &limited
+}
+
+func (o *SelectOptions) txID() string {
+	if o == nil {
+		return ""
+	}
+	return o.TxID
+}
+
+func (o *AskOptions) txID() string {
+	if o == nil {
+		return ""
+	}
+	return o.TxID
+}
+
+func (o *ConstructOptions) txID() string {
+	if o == nil {
+		return ""
+	}
+	return o.TxID
+}
+
+func (o *SelectOptions) parameters() map[string]any {
+	if o == nil {
+		return nil
+	}
+	return o.Parameters
+}
+
+func (o *AskOptions) parameters() map[string]any {
+	if o == nil {
+		return nil
+	}
+	return o.Parameters
+}
+
+func (o *ConstructOptions) parameters() map[string]any {
+	if o == nil {
+		return nil
+	}
+	return o.Parameters
+}
+
+func (o *UpdateOptions) parameters() map[string]any {
+	if o == nil {
+		return nil
+	}
+	return o.Parameters
+}
+
+func (o *UpdateOptions) txID() string {
+	if o == nil {
+		return ""
+	}
+	return o.TxID
 }
 
 // Ask performs a [SPARQL ASK] query
@@ -244,27 +595,42 @@ func (s *SPARQLService) Select(ctx context.Context, database string, query strin
 //
 // [SPARQL ASK]: https://www.w3.org/TR/sparql11-query/#ask
 func (s *SPARQLService) Ask(ctx context.Context, database string, query string, opts *AskOptions) (*bool, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+	query, err := bindParameters(query, opts.parameters())
+	if err != nil {
+		return nil, nil, err
+	}
 	encodedQuery := url.QueryEscape(query)
-	u := fmt.Sprintf("%s/query?query=%s", database, encodedQuery)
+	u := fmt.Sprintf("%s?query=%s", sparqlPath(database, opts.txID(), "query"), encodedQuery)
 	urlWithOptions, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
+	urlWithOptions = s.client.withReasoning(ctx, urlWithOptions, opts != nil && opts.Reasoning)
 	headerOpts := requestHeaderOptions{
 		Accept: mediaTypeBoolean,
 	}
+	if opts != nil && opts.ResultFormat != QueryResultFormatUnknown {
+		headerOpts.Accept = opts.ResultFormat.String()
+	}
 
 	req, err := s.client.NewRequest(http.MethodGet, urlWithOptions, &headerOpts, nil)
 	if err != nil {
 		return nil, nil, err
 	}
+	req = s.client.withDeadlineTimeout(ctx, req, opts != nil && opts.Timeout > 0)
 
 	var buf bytes.Buffer
-	resp, err := s.client.Do(ctx, req, &buf)
+	resp, err := s.doQuery(ctx, database, req, &buf)
 	if err != nil {
 		return nil, resp, err
 	}
-	b, err := strconv.ParseBool(buf.String())
+	b, err := parseAskResult(headerOpts.Accept, buf.Bytes())
 	if err != nil {
 		return nil, resp, err
 	}
@@ -272,6 +638,37 @@ func (s *SPARQLService) Ask(ctx context.Context, database string, query string,
 	return &b, resp, err
 }
 
+// parseAskResult parses the result of an ASK query, which is in the SPARQL results format it was requested in
+// or plain text.
+func parseAskResult(mediaType string, data []byte) (bool, error) {
+	switch mediaType {
+	case mediaTypeApplicationSparqlResultsJSON:
+		var result struct {
+			Boolean *bool `json:"boolean"`
+		}
+		if err := json.Unmarshal(data, &result); err != nil {
+			return false, err
+		}
+		if result.Boolean == nil {
+			return false, fmt.Errorf("ASK result has no boolean: %s", data)
+		}
+		return *result.Boolean, nil
+	case mediaTypeApplicationSparqlResultsXML:
+		var result struct {
+			Boolean *bool `xml:"boolean"`
+		}
+		if err := xml.Unmarshal(data, &result); err != nil {
+			return false, err
+		}
+		if result.Boolean == nil {
+			return false, fmt.Errorf("ASK result has no boolean: %s", data)
+		}
+		return *result.Boolean, nil
+	default:
+		return strconv.ParseBool(strings.TrimSpace(string(data)))
+	}
+}
+
 // Construct performs a [SPARQL CONSTRUCT] query.
 //
 // If ConstructOptions.ResultFormat is not specified or is not valid, results from the query will be returned as Trig.
@@ -280,12 +677,23 @@ func (s *SPARQLService) Ask(ctx context.Context, database string, query string,
 //
 // [SPARQL CONSTRUCT]: https://www.w3.org/TR/sparql11-query/#construct
 func (s *SPARQLService) Construct(ctx context.Context, database string, query string, opts *ConstructOptions) (*bytes.Buffer, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+	query, err := bindParameters(query, opts.parameters())
+	if err != nil {
+		return nil, nil, err
+	}
 	encodedQuery := url.QueryEscape(query)
-	u := fmt.Sprintf("%s/query?query=%s", database, encodedQuery)
+	u := fmt.Sprintf("%s?query=%s", sparqlPath(database, opts.txID(), "query"), encodedQuery)
 	urlWithOptions, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
+	urlWithOptions = s.client.withReasoning(ctx, urlWithOptions, opts != nil && opts.Reasoning)
 	headerOpts := requestHeaderOptions{}
 
 	if opts != nil {
@@ -302,49 +710,92 @@ func (s *SPARQLService) Construct(ctx context.Context, database string, query st
 	if err != nil {
 		return nil, nil, err
 	}
+	req = s.client.withDeadlineTimeout(ctx, req, opts != nil && opts.Timeout > 0)
 
 	var buf bytes.Buffer
-	resp, err := s.client.Do(ctx, req, &buf)
+	resp, err := s.doQuery(ctx, database, req, &buf)
 	if err != nil {
 		return nil, resp, err
 	}
 	return &buf, resp, err
 }
 
+// Describe performs a [SPARQL DESCRIBE] query, returning RDF describing the resources it identifies.
+// DescribeOptions.Strategy selects how much of each resource's context is returned, by adding a
+// describe.strategy query hint to the query.
+//
+// If DescribeOptions.ResultFormat is not specified or is not valid, results from the query will be returned as Trig.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/SPARQL/operation/getSparqlQuery
+//
+// [SPARQL DESCRIBE]: https://www.w3.org/TR/sparql11-query/#describe
+func (s *SPARQLService) Describe(ctx context.Context, database string, query string, opts *DescribeOptions) (*bytes.Buffer, *Response, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+	if opts != nil && opts.Strategy.Valid() {
+		// DESCRIBE queries may not have a WHERE clause, so the hint goes before the query rather than in it
+		query = HintDescribeStrategy(opts.Strategy).String() + "\n" + query
+	}
+	return s.Construct(ctx, database, query, opts.constructOptions())
+}
+
 // Update performs a [SPARQL UPDATE] query
 //
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/SPARQL/operation/updateGet
 //
 // [SPARQL UPDATE]: https://www.w3.org/TR/sparql11-update/
 func (s *SPARQLService) Update(ctx context.Context, database string, query string, opts *UpdateOptions) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	query, err := bindParameters(query, opts.parameters())
+	if err != nil {
+		return nil, err
+	}
 	encodedQuery := url.QueryEscape(query)
-	u := fmt.Sprintf("%s/update?query=%s", database, encodedQuery)
+	u := fmt.Sprintf("%s?query=%s", sparqlPath(database, opts.txID(), "update"), encodedQuery)
 	urlWithOptions, err := addOptions(u, opts)
 	if err != nil {
 		return nil, err
 	}
+	urlWithOptions = s.client.withReasoning(ctx, urlWithOptions, opts != nil && opts.Reasoning)
 
 	req, err := s.client.NewRequest(http.MethodGet, urlWithOptions, nil, nil)
 	if err != nil {
 		return nil, err
 	}
+	req = s.client.withDeadlineTimeout(ctx, req, opts != nil && opts.Timeout > 0)
 
-	return s.client.Do(ctx, req, nil)
+	return s.doQuery(ctx, database, req, nil)
 }
 
-// Retrieves a query plan for a given query.
+// Explain retrieves the query plan Stardog would use to evaluate a query, without evaluating it
+// unless ExplainOptions.Profile is set.
 //
+// The query is sent in the request body, so there is no limit on its length.
 // By default, if ExplainOptions.QueryPlanFormat is not specified, the text version of the plan will be returned.
 //
-// Stardog API: https://stardog-union.github.io/http-docs/#tag/SPARQL/operation/explainQueryGet
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/SPARQL/operation/explainQueryPost
 func (s *SPARQLService) Explain(ctx context.Context, database string, query string, opts *ExplainOptions) (*bytes.Buffer, *Response, error) {
-	encodedQuery := url.QueryEscape(query)
-	u := fmt.Sprintf("%s/explain?query=%s", database, encodedQuery)
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("%s/explain", database)
 	urlWithOptions, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
-	headerOpts := requestHeaderOptions{}
+	urlWithOptions = s.client.withReasoning(ctx, urlWithOptions, opts != nil && opts.Reasoning)
+	headerOpts := requestHeaderOptions{
+		ContentType: mediaTypeApplicationFormURLEncoded,
+	}
 
 	if opts == nil || (opts != nil && !opts.QueryPlanFormat.Valid()) {
 		headerOpts.Accept = QueryPlanFormatText.String()
@@ -352,10 +803,12 @@ func (s *SPARQLService) Explain(ctx context.Context, database string, query stri
 		headerOpts.Accept = opts.QueryPlanFormat.String()
 	}
 
-	req, err := s.client.NewRequest(http.MethodGet, urlWithOptions, &headerOpts, nil)
+	body := bytes.NewBufferString(url.Values{"query": {query}}.Encode())
+	req, err := s.client.NewRequest(http.MethodPost, urlWithOptions, &headerOpts, body)
 	if err != nil {
 		return nil, nil, err
 	}
+	req = s.client.withDeadlineTimeout(ctx, req, false)
 
 	var buf bytes.Buffer
 
@@ -0,0 +1,172 @@
+package stardog
+
+import (
+	"fmt"
+	"strings"
+)
+
+// QueryHint is a Stardog [query hint], a "#pragma" directive embedded in a SPARQL query to tune how Stardog
+// optimizes and evaluates it. Use [WithQueryHints] to add hints to a query.
+//
+// [query hint]: https://docs.stardog.com/operating-stardog/database-administration/managing-query-performance#query-hints
+type QueryHint struct {
+	// Name of the hint (e.g. "group.joins")
+	Name string
+	// Value of the hint, if it takes one (e.g. "off")
+	Value string
+}
+
+// String returns the hint as it appears in a query (e.g. "#pragma push.filters off").
+func (h QueryHint) String() string {
+	if h.Value == "" {
+		return "#pragma " + h.Name
+	}
+	return "#pragma " + h.Name + " " + h.Value
+}
+
+func (h QueryHint) validate() error {
+	if h.Name == "" || strings.ContainsAny(h.Name, " \t\r\n") {
+		return &ValidationError{Field: "QueryHint.Name", Value: h.Name, Constraint: "must be non-empty and not contain whitespace"}
+	}
+	if strings.ContainsAny(h.Value, "\r\n") {
+		return &ValidationError{Field: "QueryHint.Value", Value: h.Value, Constraint: "must not contain line breaks"}
+	}
+	return nil
+}
+
+// HintGroupJoins returns a hint that makes the optimizer group joins together, which can reduce the number
+// of intermediate results for queries with many join patterns.
+func HintGroupJoins() QueryHint {
+	return QueryHint{Name: "group.joins"}
+}
+
+// HintJoinOrderFixed returns a hint that makes Stardog evaluate the patterns of the query in the order they are written.
+func HintJoinOrderFixed() QueryHint {
+	return QueryHint{Name: "join.order.fixed"}
+}
+
+// HintPushFilters returns a hint that enables or disables pushing filters down into the patterns they constrain.
+func HintPushFilters(enabled bool) QueryHint {
+	if enabled {
+		return QueryHint{Name: "push.filters", Value: "on"}
+	}
+	return QueryHint{Name: "push.filters", Value: "off"}
+}
+
+// HintEquality returns a hint that tells the optimizer the given variables are compared by term identity
+// rather than value equality, allowing equality filters to be rewritten as joins. Variables are given with
+// or without the leading "?" (e.g. "?person" or "person").
+func HintEquality(variables ...string) QueryHint {
+	names := make([]string, 0, len(variables))
+	for _, variable := range variables {
+		names = append(names, "?"+strings.TrimLeft(variable, "?$"))
+	}
+	return QueryHint{Name: "equality.identity", Value: strings.Join(names, ",")}
+}
+
+// HintReasoningSchema returns a hint that selects the reasoning schema used to answer the query
+// when reasoning is enabled. See [ReasoningService.ListSchemas].
+func HintReasoningSchema(schema string) QueryHint {
+	return QueryHint{Name: "reasoning.schema", Value: schema}
+}
+
+// HintDescribeStrategy returns a hint that selects how the resources of a DESCRIBE query are described.
+// [SPARQLService.Describe] adds it for DescribeOptions.Strategy.
+func HintDescribeStrategy(strategy DescribeStrategy) QueryHint {
+	return QueryHint{Name: "describe.strategy", Value: strategy.String()}
+}
+
+// WithQueryHints returns the query with the hints added to the outermost group graph pattern of its WHERE clause,
+// where Stardog applies them to the whole query. Each hint is written on its own line so it can't
+// comment out the rest of the query.
+//
+// An error is returned if a hint is invalid or the query has no group graph pattern.
+func WithQueryHints(query string, hints ...QueryHint) (string, error) {
+	if len(hints) == 0 {
+		return query, nil
+	}
+	var pragmas strings.Builder
+	for _, hint := range hints {
+		if err := hint.validate(); err != nil {
+			return "", err
+		}
+		pragmas.WriteString("\n")
+		pragmas.WriteString(hint.String())
+	}
+	pragmas.WriteString("\n")
+
+	pos := queryHintsPosition(query)
+	if pos < 0 {
+		return "", fmt.Errorf("query has no group graph pattern to add hints to")
+	}
+	return query[:pos] + pragmas.String() + query[pos:], nil
+}
+
+// queryHintsPosition returns the position just after the opening brace of the WHERE clause's group graph pattern,
+// or -1 if there is none. Braces inside strings, IRIs and comments are ignored, as are the braces of a
+// CONSTRUCT template that precedes the WHERE keyword.
+func queryHintsPosition(query string) int {
+	depth := 0
+	firstBrace := -1
+	sawWhere := false
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case c == '#':
+			end := strings.IndexAny(query[i:], "\r\n")
+			if end < 0 {
+				return firstBrace
+			}
+			i += end
+		case c == '"' || c == '\'':
+			i = skipQueryString(query, i)
+		case c == '<':
+			// an IRI runs to the next '>' without whitespace, otherwise this is a less-than operator
+			if end := strings.IndexAny(query[i+1:], "> \t\r\n"); end >= 0 && query[i+1+end] == '>' {
+				i += end + 1
+			}
+		case c == '{':
+			if depth == 0 {
+				if sawWhere {
+					return i + 1
+				}
+				if firstBrace < 0 {
+					firstBrace = i + 1
+				}
+			}
+			depth++
+		case c == '}':
+			depth--
+		case depth == 0 && (c == 'w' || c == 'W'):
+			if len(query) >= i+5 && strings.EqualFold(query[i:i+5], "where") &&
+				(i == 0 || !isQueryNameChar(query[i-1])) && (len(query) == i+5 || !isQueryNameChar(query[i+5])) {
+				sawWhere = true
+				i += 4
+			}
+		}
+	}
+	return firstBrace
+}
+
+// skipQueryString returns the position of the closing quote of the SPARQL string literal starting at start.
+func skipQueryString(query string, start int) int {
+	quote := query[start : start+1]
+	if strings.HasPrefix(query[start:], strings.Repeat(quote, 3)) {
+		quote = strings.Repeat(quote, 3)
+	}
+	for i := start + len(quote); i < len(query); i++ {
+		if query[i] == '\\' {
+			i++
+			continue
+		}
+		if strings.HasPrefix(query[i:], quote) {
+			return i + len(quote) - 1
+		}
+	}
+	return len(query)
+}
+
+// isQueryNameChar reports whether c can be part of a SPARQL keyword, variable or prefixed name.
+func isQueryNameChar(c byte) bool {
+	return isAlphaNumeric(c) || c == '_' || c == '?' || c == '$' || c == ':' || c == '-'
+}
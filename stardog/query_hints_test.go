@@ -0,0 +1,89 @@
+package stardog
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWithQueryHints(t *testing.T) {
+	hints := []QueryHint{HintGroupJoins(), HintPushFilters(false)}
+	pragmas := "\n#pragma group.joins\n#pragma push.filters off\n"
+
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name:  "select with where",
+			query: "PREFIX ex: <http://example.com/#> SELECT * WHERE { ?s ex:p ?o }",
+			want:  "PREFIX ex: <http://example.com/#> SELECT * WHERE {" + pragmas + " ?s ex:p ?o }",
+		},
+		{
+			name:  "select without where",
+			query: "select * { ?s ?p ?o }",
+			want:  "select * {" + pragmas + " ?s ?p ?o }",
+		},
+		{
+			name:  "construct template is skipped",
+			query: "CONSTRUCT { ?s ?p '{' } where { ?s ?p ?o }",
+			want:  "CONSTRUCT { ?s ?p '{' } where {" + pragmas + " ?s ?p ?o }",
+		},
+		{
+			name:  "subquery where is ignored",
+			query: "SELECT ?s { { SELECT ?s WHERE { ?s ?p ?o } } }",
+			want:  "SELECT ?s {" + pragmas + " { SELECT ?s WHERE { ?s ?p ?o } } }",
+		},
+		{
+			name:  "comments, strings and variables are ignored",
+			query: "# where {\nSELECT (\"\"\"where {\"\"\" AS ?where) (?a < ?b AS ?c) WHERE { ?s ?p ?o }",
+			want:  "# where {\nSELECT (\"\"\"where {\"\"\" AS ?where) (?a < ?b AS ?c) WHERE {" + pragmas + " ?s ?p ?o }",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := WithQueryHints(tt.query, hints...)
+			if err != nil {
+				t.Fatalf("WithQueryHints returned error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("WithQueryHints = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithQueryHints_errors(t *testing.T) {
+	query := "SELECT * { ?s ?p ?o }"
+	if got, err := WithQueryHints(query); err != nil || got != query {
+		t.Errorf("WithQueryHints with no hints = %q, %v, want %q, nil", got, err, query)
+	}
+	if _, err := WithQueryHints("ASK", HintGroupJoins()); err == nil {
+		t.Errorf("WithQueryHints err = nil, want error for query without a group graph pattern")
+	}
+
+	var validationErr *ValidationError
+	for _, hint := range []QueryHint{{}, {Name: "group joins"}, HintReasoningSchema("s\n} DELETE")} {
+		if _, err := WithQueryHints(query, hint); !errors.As(err, &validationErr) {
+			t.Errorf("WithQueryHints(%+v) error = %v, want *ValidationError", hint, err)
+		}
+	}
+}
+
+func TestQueryHint_String(t *testing.T) {
+	tests := []struct {
+		hint QueryHint
+		want string
+	}{
+		{HintGroupJoins(), "#pragma group.joins"},
+		{HintJoinOrderFixed(), "#pragma join.order.fixed"},
+		{HintPushFilters(true), "#pragma push.filters on"},
+		{HintEquality("?a", "b", "$c"), "#pragma equality.identity ?a,?b,?c"},
+		{HintReasoningSchema("music"), "#pragma reasoning.schema music"},
+	}
+	for _, tt := range tests {
+		if got := tt.hint.String(); got != tt.want {
+			t.Errorf("QueryHint.String = %q, want %q", got, tt.want)
+		}
+	}
+}
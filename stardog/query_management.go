@@ -0,0 +1,192 @@
+package stardog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// QueryManagementService handles communication with the [query management] related methods of the Stardog API.
+//
+// [query management]: https://docs.stardog.com/operating-stardog/database-administration/managing-query-performance#managing-running-queries
+type QueryManagementService service
+
+// RunningQuery represents a query currently executing in the Stardog server.
+type RunningQuery struct {
+	// ID of the query
+	ID string `json:"id"`
+	// The query string
+	Query string `json:"query"`
+	// The database the query is running against
+	Database string `json:"db"`
+	// The user who issued the query
+	User string `json:"user"`
+	// Unix timestamp (in milliseconds) of when the query started
+	StartTime int64 `json:"startTime"`
+	// Number of milliseconds the query has been running for
+	ElapsedTime int64 `json:"elapsedTime"`
+	// Number of milliseconds after which the query will time out
+	Timeout int64 `json:"timeout"`
+	// Whether reasoning is enabled for the query
+	Reasoning bool `json:"reasoning"`
+	// ID of the kernel executing the query
+	KernelID string `json:"kernelId"`
+}
+
+// Elapsed returns how long the query has been running for.
+func (q RunningQuery) Elapsed() time.Duration {
+	return time.Duration(q.ElapsedTime) * time.Millisecond
+}
+
+// response for List
+type listRunningQueriesResponse struct {
+	Queries []RunningQuery `json:"queries"`
+}
+
+// List returns all queries currently running in the server.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Queries/operation/listQueries
+func (s *QueryManagementService) List(ctx context.Context) ([]RunningQuery, *Response, error) {
+	u := "admin/queries"
+	headerOpts := requestHeaderOptions{
+		Accept: mediaTypeApplicationJSON,
+	}
+	req, err := s.client.NewRequest(http.MethodGet, u, &headerOpts, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var listRunningQueriesResponse listRunningQueriesResponse
+	resp, err := s.client.Do(ctx, req, &listRunningQueriesResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+	return listRunningQueriesResponse.Queries, resp, nil
+}
+
+// RunningQueryFilter selects running queries for [QueryManagementService.ListFiltered] and, with its Matches
+// method, [QueryManagementService.KillAll]. Fields that aren't set don't filter queries.
+type RunningQueryFilter struct {
+	// Only select queries against this database
+	Database string
+	// Only select queries issued by this user
+	User string
+	// Only select queries that have been running for at least this long
+	MinElapsed time.Duration
+}
+
+// Matches reports whether the running query is selected by the filter. A nil filter selects every query.
+func (f *RunningQueryFilter) Matches(q RunningQuery) bool {
+	if f == nil {
+		return true
+	}
+	return (f.Database == "" || q.Database == f.Database) &&
+		(f.User == "" || q.User == f.User) &&
+		q.Elapsed() >= f.MinElapsed
+}
+
+// ListFiltered returns the queries currently running in the server that are selected by the filter.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Queries/operation/listQueries
+func (s *QueryManagementService) ListFiltered(ctx context.Context, filter *RunningQueryFilter) ([]RunningQuery, *Response, error) {
+	queries, resp, err := s.List(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	filtered := make([]RunningQuery, 0, len(queries))
+	for _, q := range queries {
+		if filter.Matches(q) {
+			filtered = append(filtered, q)
+		}
+	}
+	return filtered, resp, nil
+}
+
+// KillAll kills the running queries for which match returns true, and returns the queries it killed. For
+// example, to kill a user's queries that have been running for over an hour:
+//
+//	filter := &stardog.RunningQueryFilter{User: "etl", MinElapsed: time.Hour}
+//	killed, _, err := client.QueryManagement.KillAll(ctx, filter.Matches)
+//
+// Queries that finish before they're killed are skipped. If killing a query fails, the other queries are still
+// killed, and the errors are returned joined together.
+func (s *QueryManagementService) KillAll(ctx context.Context, match func(RunningQuery) bool) ([]RunningQuery, *Response, error) {
+	if match == nil {
+		return nil, nil, &ValidationError{Field: "match", Value: match, Constraint: "must not be nil"}
+	}
+	queries, resp, err := s.List(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	killed := make([]RunningQuery, 0)
+	var errs []error
+	for _, q := range queries {
+		if !match(q) {
+			continue
+		}
+		resp, err = s.Kill(ctx, q.ID)
+		switch {
+		case err == nil:
+			killed = append(killed, q)
+		case IsNotFound(err):
+			// the query finished after it was listed
+		default:
+			errs = append(errs, fmt.Errorf("killing query %s: %w", q.ID, err))
+		}
+	}
+	return killed, resp, errors.Join(errs...)
+}
+
+// Find returns the running queries against database whose query string is query, ignoring leading and
+// trailing white space, e.g. to find the ID of a query the application started so it can be killed with
+// [QueryManagementService.Kill].
+func (s *QueryManagementService) Find(ctx context.Context, database string, query string) ([]RunningQuery, *Response, error) {
+	queries, resp, err := s.List(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	query = strings.TrimSpace(query)
+	var found []RunningQuery
+	for _, q := range queries {
+		if q.Database == database && strings.TrimSpace(q.Query) == query {
+			found = append(found, q)
+		}
+	}
+	return found, resp, nil
+}
+
+// Get returns details for a running query.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Queries/operation/getQuery
+func (s *QueryManagementService) Get(ctx context.Context, queryID string) (*RunningQuery, *Response, error) {
+	u := fmt.Sprintf("admin/queries/%s", queryID)
+	headerOpts := requestHeaderOptions{
+		Accept: mediaTypeApplicationJSON,
+	}
+	req, err := s.client.NewRequest(http.MethodGet, u, &headerOpts, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var query RunningQuery
+	resp, err := s.client.Do(ctx, req, &query)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &query, resp, nil
+}
+
+// Kill kills a running query.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Queries/operation/killQuery
+func (s *QueryManagementService) Kill(ctx context.Context, queryID string) (*Response, error) {
+	u := fmt.Sprintf("admin/queries/%s", queryID)
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,246 @@
+package stardog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var runningQueryJSON = `{
+  "id": "7",
+  "query": "SELECT * { ?s ?p ?o }",
+  "db": "db1",
+  "user": "admin",
+  "startTime": 1673818000000,
+  "elapsedTime": 1500,
+  "timeout": 300000,
+  "reasoning": false,
+  "kernelId": "7b6a2c9e"
+}`
+
+var wantRunningQuery = RunningQuery{
+	ID:          "7",
+	Query:       "SELECT * { ?s ?p ?o }",
+	Database:    "db1",
+	User:        "admin",
+	StartTime:   1673818000000,
+	ElapsedTime: 1500,
+	Timeout:     300000,
+	Reasoning:   false,
+	KernelID:    "7b6a2c9e",
+}
+
+func TestQueryManagementService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/queries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeApplicationJSON)
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"queries": [%s]}`, runningQueryJSON)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.QueryManagement.List(ctx)
+	if err != nil {
+		t.Errorf("QueryManagement.List returned error: %v", err)
+	}
+	if want := []RunningQuery{wantRunningQuery}; !cmp.Equal(got, want) {
+		t.Errorf("QueryManagement.List = %+v, want %+v", got, want)
+	}
+
+	const methodName = "List"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.QueryManagement.List(nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+// runningQueriesJSON lists queries by different users, against different databases, that have run for different times.
+const runningQueriesJSON = `{"queries": [
+  {"id": "1", "db": "db1", "user": "etl", "elapsedTime": 7200000},
+  {"id": "2", "db": "db1", "user": "etl", "elapsedTime": 1000},
+  {"id": "3", "db": "db1", "user": "admin", "elapsedTime": 7200000},
+  {"id": "4", "db": "db2", "user": "etl", "elapsedTime": 7200000}
+]}`
+
+func TestQueryManagementService_ListFiltered(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/queries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(runningQueriesJSON))
+	})
+
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		filter  *RunningQueryFilter
+		wantIDs []string
+	}{
+		{name: "nil", filter: nil, wantIDs: []string{"1", "2", "3", "4"}},
+		{name: "database", filter: &RunningQueryFilter{Database: "db1"}, wantIDs: []string{"1", "2", "3"}},
+		{name: "user", filter: &RunningQueryFilter{User: "etl"}, wantIDs: []string{"1", "2", "4"}},
+		{name: "elapsed", filter: &RunningQueryFilter{MinElapsed: time.Hour}, wantIDs: []string{"1", "3", "4"}},
+		{name: "all", filter: &RunningQueryFilter{Database: "db1", User: "etl", MinElapsed: time.Hour}, wantIDs: []string{"1"}},
+	}
+	for _, tc := range tests {
+		got, _, err := client.QueryManagement.ListFiltered(ctx, tc.filter)
+		if err != nil {
+			t.Fatalf("%s: QueryManagement.ListFiltered returned error: %v", tc.name, err)
+		}
+		gotIDs := make([]string, len(got))
+		for i, q := range got {
+			gotIDs[i] = q.ID
+		}
+		if !cmp.Equal(gotIDs, tc.wantIDs) {
+			t.Errorf("%s: QueryManagement.ListFiltered returned queries %v, want %v", tc.name, gotIDs, tc.wantIDs)
+		}
+	}
+
+	const methodName = "ListFiltered"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.QueryManagement.ListFiltered(nil, nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestQueryManagementService_KillAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/queries", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(runningQueriesJSON))
+	})
+	var killed []string
+	mux.HandleFunc("/admin/queries/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		id := r.URL.Path[len("/admin/queries/"):]
+		switch id {
+		case "1":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "query finished"}`))
+		case "4":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"message": "unable to kill query"}`))
+		default:
+			killed = append(killed, id)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	ctx := context.Background()
+	filter := &RunningQueryFilter{MinElapsed: time.Hour}
+	got, _, err := client.QueryManagement.KillAll(ctx, filter.Matches)
+	if err == nil || !strings.Contains(err.Error(), "killing query 4") || strings.Contains(err.Error(), "query 1") {
+		t.Errorf("QueryManagement.KillAll error = %v, want only the error killing query 4", err)
+	}
+	if len(got) != 1 || got[0].ID != "3" {
+		t.Errorf("QueryManagement.KillAll = %+v, want query 3", got)
+	}
+	if want := []string{"3"}; !cmp.Equal(killed, want) {
+		t.Errorf("killed queries = %v, want %v", killed, want)
+	}
+
+	testBadOptions(t, "KillAll", func() error {
+		_, _, err := client.QueryManagement.KillAll(ctx, nil)
+		return err
+	})
+}
+
+func TestQueryManagementService_Find(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/queries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"queries": [%s, {"id": "8", "query": "ASK {}", "db": "db1"}, {"id": "9", "query": "SELECT * { ?s ?p ?o }", "db": "db2"}]}`, runningQueryJSON)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.QueryManagement.Find(ctx, "db1", "  SELECT * { ?s ?p ?o }\n")
+	if err != nil {
+		t.Errorf("QueryManagement.Find returned error: %v", err)
+	}
+	if want := []RunningQuery{wantRunningQuery}; !cmp.Equal(got, want) {
+		t.Errorf("QueryManagement.Find = %+v, want %+v", got, want)
+	}
+
+	const methodName = "Find"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.QueryManagement.Find(nil, "db1", "ASK {}")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestQueryManagementService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	queryID := "7"
+	mux.HandleFunc(fmt.Sprintf("/admin/queries/%s", queryID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeApplicationJSON)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(runningQueryJSON))
+	})
+
+	ctx := context.Background()
+	got, _, err := client.QueryManagement.Get(ctx, queryID)
+	if err != nil {
+		t.Errorf("QueryManagement.Get returned error: %v", err)
+	}
+	if want := &wantRunningQuery; !cmp.Equal(got, want) {
+		t.Errorf("QueryManagement.Get = %+v, want %+v", got, want)
+	}
+	if got, want := got.Elapsed(), 1500*time.Millisecond; got != want {
+		t.Errorf("RunningQuery.Elapsed = %v, want %v", got, want)
+	}
+
+	const methodName = "Get"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.QueryManagement.Get(nil, queryID)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestQueryManagementService_Kill(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	queryID := "7"
+	mux.HandleFunc(fmt.Sprintf("/admin/queries/%s", queryID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.QueryManagement.Kill(ctx, queryID)
+	if err != nil {
+		t.Errorf("QueryManagement.Kill returned error: %v", err)
+	}
+
+	const methodName = "Kill"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.QueryManagement.Kill(nil, queryID)
+	})
+}
@@ -0,0 +1,188 @@
+package stardog
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Query is a SPARQL query or update with parameters, written $name, that are replaced by the SPARQL syntax of
+// the values bound to them, escaped so the values can't change the structure of the query:
+//
+//	query, err := stardog.NewQuery("SELECT ?p ?o { $s ?p ?o FILTER(?o != $label) }").
+//		BindIRI("s", "http://example.com/alice").
+//		Bind("label", `"; DROP ALL; #`).
+//		Build()
+//
+// Parameters are only replaced outside of strings, IRIs and comments. In SPARQL, $name is also a variable,
+// so parameters that aren't bound are left as variables. The parameters of SelectOptions, AskOptions,
+// ConstructOptions and UpdateOptions are bound with a Query.
+type Query struct {
+	text     string
+	bindings map[string]string
+	err      error
+}
+
+// NewQuery returns a Query for the SPARQL query or update text.
+func NewQuery(text string) *Query {
+	return &Query{text: text, bindings: make(map[string]string)}
+}
+
+// Bind binds the parameter name, without its $, to the SPARQL syntax of value returned by [FormatValue]:
+// a string is a string literal, a *url.URL or [IRI] an IRI, a number or bool a typed literal and so on.
+// An error binding it is returned by Build.
+func (q *Query) Bind(name string, value any) *Query {
+	formatted, err := FormatValue(value)
+	if err != nil {
+		q.setErr(fmt.Errorf("binding $%s: %w", name, err))
+		return q
+	}
+	return q.bind(name, formatted)
+}
+
+// BindIRI binds the parameter name, without its $, to an IRI.
+func (q *Query) BindIRI(name string, iri string) *Query {
+	return q.bind(name, IRI(iri).String())
+}
+
+// BindLiteral binds the parameter name, without its $, to a literal with the given datatype, e.g.
+// http://www.w3.org/2001/XMLSchema#date. The literal is an xsd:string if datatype is empty.
+func (q *Query) BindLiteral(name string, value string, datatype string) *Query {
+	return q.bind(name, Literal{Value: value, Datatype: IRI(datatype)}.String())
+}
+
+// BindAll binds each parameter of params, keyed by name without its $, with [Query.Bind].
+func (q *Query) BindAll(params map[string]any) *Query {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		q.Bind(name, params[name])
+	}
+	return q
+}
+
+func (q *Query) bind(name string, syntax string) *Query {
+	if !sparqlVariableName.MatchString(name) {
+		q.setErr(&ValidationError{Field: "name", Value: name, Constraint: "must be a parameter name without its $"})
+		return q
+	}
+	q.bindings[name] = syntax
+	return q
+}
+
+// setErr records the first error binding a parameter.
+func (q *Query) setErr(err error) {
+	if q.err == nil {
+		q.err = err
+	}
+}
+
+// Build returns the query with its bound parameters replaced. An error is returned if a value couldn't be
+// bound or a bound parameter doesn't appear in the query, which is likely a typo.
+func (q *Query) Build() (string, error) {
+	if q.err != nil {
+		return "", q.err
+	}
+	var b strings.Builder
+	used := make(map[string]bool, len(q.bindings))
+	text := q.text
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == '"' || c == '\'':
+			end := skipSPARQLString(text, i)
+			b.WriteString(text[i:end])
+			i = end
+		case c == '<':
+			end := skipSPARQLIRI(text, i)
+			b.WriteString(text[i:end])
+			i = end
+		case c == '#':
+			end := strings.IndexByte(text[i:], '\n')
+			if end < 0 {
+				end = len(text) - i
+			}
+			b.WriteString(text[i : i+end])
+			i += end
+		case c == '$':
+			end := i + 1
+			for end < len(text) && isVariableNameByte(text[end]) {
+				end++
+			}
+			name := text[i+1 : end]
+			if syntax, ok := q.bindings[name]; ok {
+				b.WriteString(syntax)
+				used[name] = true
+			} else {
+				b.WriteString(text[i:end])
+			}
+			i = end
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	for name := range q.bindings {
+		if !used[name] {
+			return "", &ValidationError{Field: "name", Value: name, Constraint: "must be a parameter of the query"}
+		}
+	}
+	return b.String(), nil
+}
+
+// String returns the query with its bound parameters replaced, or the query as written if Build returns an error.
+func (q *Query) String() string {
+	built, err := q.Build()
+	if err != nil {
+		return q.text
+	}
+	return built
+}
+
+// isVariableNameByte reports whether c can be part of the name of a parameter.
+func isVariableNameByte(c byte) bool {
+	return isAlphaNumeric(c) || c == '_'
+}
+
+// skipSPARQLString returns the index after the string starting at start, which may be a long string
+// delimited by three quotes, and contain escaped quotes. It's len(text) if the string isn't terminated.
+func skipSPARQLString(text string, start int) int {
+	quote := text[start : start+1]
+	if strings.HasPrefix(text[start:], strings.Repeat(quote, 3)) {
+		quote = strings.Repeat(quote, 3)
+	}
+	for i := start + len(quote); i < len(text); i++ {
+		switch {
+		case text[i] == '\\':
+			i++
+		case strings.HasPrefix(text[i:], quote):
+			return i + len(quote)
+		}
+	}
+	return len(text)
+}
+
+// skipSPARQLIRI returns the index after the IRI reference starting at start, or start+1 if the < isn't an IRI
+// reference but a less-than operator or the start of a quoted triple (<<).
+func skipSPARQLIRI(text string, start int) int {
+	for i := start + 1; i < len(text); i++ {
+		switch c := text[i]; {
+		case c == '>':
+			return i + 1
+		case c <= ' ' || strings.IndexByte("<\"{}|^`\\", c) >= 0:
+			return start + 1
+		}
+	}
+	return start + 1
+}
+
+// bindParameters binds the parameters of the options of a SPARQL query or update to the query.
+func bindParameters(query string, params map[string]any) (string, error) {
+	if len(params) == 0 {
+		return query, nil
+	}
+	return NewQuery(query).BindAll(params).Build()
+}
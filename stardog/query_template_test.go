@@ -0,0 +1,127 @@
+package stardog
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestQuery_Build(t *testing.T) {
+	got, err := NewQuery(`SELECT ?p ?o { $s ?p ?o FILTER(?o != $label && ?o < $max) } # $s`).
+		BindIRI("s", "http://example.com/alice").
+		Bind("label", `"; DROP ALL; #`).
+		Bind("max", 10).
+		Build()
+	if err != nil {
+		t.Fatalf("Query.Build returned error: %v", err)
+	}
+	want := `SELECT ?p ?o { <http://example.com/alice> ?p ?o FILTER(?o != "\"; DROP ALL; #" && ?o < 10) } # $s`
+	if got != want {
+		t.Errorf("Query.Build = %s, want %s", got, want)
+	}
+}
+
+func TestQuery_Build_skipsStringsAndIRIs(t *testing.T) {
+	query := `SELECT * { ?s <urn:$s> "$s" ; <urn:p> '''it's $s''' ; <urn:q> "\"$s" . FILTER(?x<$s) $sx $s }`
+	got, err := NewQuery(query).BindLiteral("s", "v", "").Build()
+	if err != nil {
+		t.Fatalf("Query.Build returned error: %v", err)
+	}
+	want := `SELECT * { ?s <urn:$s> "$s" ; <urn:p> '''it's $s''' ; <urn:q> "\"$s" . FILTER(?x<"v") $sx "v" }`
+	if got != want {
+		t.Errorf("Query.Build = %s, want %s", got, want)
+	}
+}
+
+func TestQuery_Build_hostileBindings(t *testing.T) {
+	// values from query results are bound back into queries, so their blank node labels and language
+	// tags must not be able to end the query's group and inject an update
+	bnode := BindingValue{Type: "bnode", Value: "x } ; DROP ALL #"}
+	lang := BindingValue{Type: "literal", Value: "a", Lang: "en } DROP ALL # }"}
+	got, err := NewQuery("SELECT * { ?s ?p $o . ?o ?q $label }").Bind("o", bnode).Bind("label", &lang).Build()
+	if err != nil {
+		t.Fatalf("Query.Build returned error: %v", err)
+	}
+	want := `SELECT * { ?s ?p _:x_____DROP_ALL__ . ?o ?q "a"@enDROPALL }`
+	if got != want {
+		t.Errorf("Query.Build = %s, want %s", got, want)
+	}
+
+	got, err = bindParameters("SELECT * { ?s ?p $o }", map[string]any{"o": bnode})
+	if err != nil {
+		t.Fatalf("bindParameters returned error: %v", err)
+	}
+	if want := "SELECT * { ?s ?p _:x_____DROP_ALL__ }"; got != want {
+		t.Errorf("bindParameters = %s, want %s", got, want)
+	}
+}
+
+func TestQuery_Build_errors(t *testing.T) {
+	tests := map[string]*Query{
+		"unused":        NewQuery("SELECT * { ?s ?p $o }").Bind("0", 1),
+		"invalid name":  NewQuery("SELECT * { ?s ?p $o }").Bind("$o", 1),
+		"invalid value": NewQuery("SELECT * { ?s ?p $o }").Bind("o", struct{}{}),
+	}
+	for name, q := range tests {
+		if _, err := q.Build(); err == nil {
+			t.Errorf("%s: Query.Build returned no error", name)
+		}
+		if q.String() != q.text {
+			t.Errorf("%s: Query.String = %s, want the query as written", name, q.String())
+		}
+	}
+
+	var validationErr *ValidationError
+	if _, err := NewQuery("SELECT * { ?s ?p $o }").BindAll(map[string]any{"o": 1, "typo": 2}).Build(); !errors.As(err, &validationErr) {
+		t.Errorf("Query.Build error = %v, want *ValidationError", err)
+	}
+}
+
+func TestSparqlService_parameters(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var got []string
+	record := func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.URL.Query().Get("query"))
+		if r.Header.Get("Accept") == mediaTypeBoolean {
+			w.Write([]byte("true"))
+		}
+	}
+	mux.HandleFunc("/db1/query", record)
+	mux.HandleFunc("/db1/update", record)
+
+	ctx := context.Background()
+	alice, _ := url.Parse("http://example.com/alice")
+	params := map[string]any{"s": alice}
+	client.Sparql.Select(ctx, "db1", "SELECT * { $s ?p ?o }", &SelectOptions{Parameters: params})
+	client.Sparql.SelectResultSet(ctx, "db1", "SELECT * { $s ?p ?o }", &SelectOptions{Parameters: params})
+	client.Sparql.Ask(ctx, "db1", "ASK { $s ?p ?o }", &AskOptions{Parameters: params})
+	client.Sparql.Construct(ctx, "db1", "CONSTRUCT WHERE { $s ?p ?o }", &ConstructOptions{Parameters: params})
+	client.Sparql.Describe(ctx, "db1", "DESCRIBE $s", &DescribeOptions{Parameters: params})
+	client.Sparql.Update(ctx, "db1", "DELETE WHERE { $s ?p ?o }", &UpdateOptions{Parameters: params})
+
+	want := []string{
+		"SELECT * { <http://example.com/alice> ?p ?o }",
+		"SELECT * { <http://example.com/alice> ?p ?o }",
+		"ASK { <http://example.com/alice> ?p ?o }",
+		"CONSTRUCT WHERE { <http://example.com/alice> ?p ?o }",
+		"DESCRIBE <http://example.com/alice>",
+		"DELETE WHERE { <http://example.com/alice> ?p ?o }",
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("queries = %q, want %q", got, want)
+	}
+
+	var validationErr *ValidationError
+	if _, _, err := client.Sparql.Select(ctx, "db1", "SELECT * { ?s ?p ?o }", &SelectOptions{Parameters: params}); !errors.As(err, &validationErr) {
+		t.Errorf("Sparql.Select error = %v, want *ValidationError", err)
+	}
+	if _, err := client.Sparql.Update(ctx, "db1", "DELETE WHERE { ?s ?p ?o }", &UpdateOptions{Parameters: params}); !errors.As(err, &validationErr) {
+		t.Errorf("Sparql.Update error = %v, want *ValidationError", err)
+	}
+}
@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"github.com/google/go-cmp/cmp"
 	"net/http"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestQueryResultFormat_Valid(t *testing.T) {
@@ -317,6 +320,67 @@ func TestSparqlService_Ask_noBooleanResponse(t *testing.T) {
 	}
 }
 
+func TestSparqlService_Ask_resultFormat(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	results := map[string]string{
+		mediaTypeApplicationSparqlResultsJSON: `{"head": {}, "boolean": true}`,
+		mediaTypeApplicationSparqlResultsXML:  `<?xml version="1.0"?><sparql xmlns="http://www.w3.org/2005/sparql-results#"><head/><boolean>true</boolean></sparql>`,
+	}
+	mux.HandleFunc("/db1/query", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(results[r.Header.Get("Accept")]))
+	})
+
+	ctx := context.Background()
+	for _, format := range []QueryResultFormat{QueryResultFormatSparqlResultsJSON, QueryResultFormatSparqlResultsXML} {
+		got, _, err := client.Sparql.Ask(ctx, "db1", "ASK { ?s ?p ?o }", &AskOptions{ResultFormat: format})
+		if err != nil {
+			t.Errorf("Sparql.Ask with %s returned error: %v", format, err)
+		} else if !*got {
+			t.Errorf("Sparql.Ask with %s = false, want true", format)
+		}
+	}
+
+	testBadOptions(t, "Ask", func() error {
+		_, _, err := client.Sparql.Ask(ctx, "db1", "ASK { ?s ?p ?o }", &AskOptions{ResultFormat: QueryResultFormatCSV})
+		return err
+	})
+
+	delete(results, mediaTypeApplicationSparqlResultsJSON)
+	if _, _, err := client.Sparql.Ask(ctx, "db1", "ASK { ?s ?p ?o }", &AskOptions{ResultFormat: QueryResultFormatSparqlResultsJSON}); err == nil {
+		t.Error("Sparql.Ask should return an error for a result without a boolean")
+	}
+}
+
+func TestSparqlService_Select_resultFormats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var gotAccept string
+	mux.HandleFunc("/db1/query", func(w http.ResponseWriter, r *http.Request) {
+		gotAccept = r.Header.Get("Accept")
+	})
+
+	tests := []struct {
+		format QueryResultFormat
+		want   string
+	}{
+		{format: QueryResultFormatSparqlResultsXML, want: "application/sparql-results+xml"},
+		{format: QueryResultFormatTSV, want: "text/tab-separated-values"},
+		{format: QueryResultFormatBinary, want: "application/x-binary-rdf-results-table"},
+	}
+	for _, tc := range tests {
+		if _, _, err := client.Sparql.Select(context.Background(), "db1", "SELECT * { ?s ?p ?o }", &SelectOptions{ResultFormat: tc.format}); err != nil {
+			t.Errorf("Sparql.Select returned error: %v", err)
+		}
+		if gotAccept != tc.want {
+			t.Errorf("Sparql.Select sent Accept %q, want %q", gotAccept, tc.want)
+		}
+	}
+}
+
 func TestSparqlService_Explain(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -326,19 +390,31 @@ func TestSparqlService_Explain(t *testing.T) {
   `
 	db := "db1"
 
+	query := `
+  SELECT * { ?s a ?o }
+  `
+
 	mux.HandleFunc(fmt.Sprintf("/%s/explain", db), func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "GET")
+		testMethod(t, r, "POST")
 		testHeader(t, r, "Accept", mediaTypeApplicationJSON)
+		testHeader(t, r, "Content-Type", mediaTypeApplicationFormURLEncoded)
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("error parsing form: %v", err)
+		}
+		if got := r.PostForm.Get("query"); got != query {
+			t.Errorf("form query = %q, want %q", got, query)
+		}
+		if got, want := r.URL.RawQuery, "reasoning=true&verbose=true"; got != want {
+			t.Errorf("query parameters = %q, want %q", got, want)
+		}
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte(wantPlan))
 	})
 
 	ctx := context.Background()
-	query := `
-  SELECT * { ?s a ?o }
-  `
-
 	explainOpts := &ExplainOptions{
+		Reasoning:       true,
+		Verbose:         true,
 		QueryPlanFormat: QueryPlanFormatJSON,
 	}
 
@@ -371,7 +447,7 @@ func TestSparqlService_Explain_noPlanFormatSpecified(t *testing.T) {
 	db := "db1"
 
 	mux.HandleFunc(fmt.Sprintf("/%s/explain", db), func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "GET")
+		testMethod(t, r, "POST")
 		testHeader(t, r, "Accept", mediaTypePlainText)
 		w.WriteHeader(http.StatusOK)
 	})
@@ -420,3 +496,315 @@ func TestSparqlService_Update(t *testing.T) {
 		return client.Sparql.Update(nil, db, query, nil)
 	})
 }
+
+func TestSparqlService_Construct_turtleStar(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	wantRDF := `<< <urn:paul> <urn:memberOf> <urn:beatles> >> <urn:since> 1960 .`
+	mux.HandleFunc(fmt.Sprintf("/%s/query", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeApplicationTurtleStar)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(wantRDF))
+	})
+
+	query := `CONSTRUCT { << ?s ?p ?o >> ?q ?v } WHERE { << ?s ?p ?o >> ?q ?v }`
+	opts := &ConstructOptions{ResultFormat: RDFFormatTurtleStar}
+	got, _, err := client.Sparql.Construct(context.Background(), db, query, opts)
+	if err != nil {
+		t.Errorf("Sparql.Construct returned error: %v", err)
+	}
+	if got.String() != wantRDF {
+		t.Errorf("Sparql.Construct = %v, want %v", got, wantRDF)
+	}
+}
+
+func TestSparqlService_inTransaction(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	txID := "tx-1"
+	mux.HandleFunc(fmt.Sprintf("/%s/%s/query", db, txID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Has("txid") {
+			t.Errorf("txid query parameter should not be sent for a transaction scoped query")
+		}
+		switch r.Header.Get("Accept") {
+		case mediaTypeBoolean:
+			w.Write([]byte("true"))
+		default:
+			w.Write([]byte(`{"head": {"vars": []}, "results": {"bindings": []}}`))
+		}
+	})
+	updated := false
+	mux.HandleFunc(fmt.Sprintf("/%s/%s/update", db, txID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		updated = true
+	})
+
+	ctx := context.Background()
+	if _, err := client.Sparql.Update(ctx, db, "INSERT DATA { <urn:s> <urn:p> <urn:o> }", &UpdateOptions{TxID: txID}); err != nil {
+		t.Errorf("Sparql.Update returned error: %v", err)
+	}
+	if !updated {
+		t.Errorf("Sparql.Update was not sent to the transaction")
+	}
+	if _, _, err := client.Sparql.Select(ctx, db, "SELECT * {}", &SelectOptions{TxID: txID}); err != nil {
+		t.Errorf("Sparql.Select returned error: %v", err)
+	}
+	if _, _, err := client.Sparql.Construct(ctx, db, "CONSTRUCT WHERE {}", &ConstructOptions{TxID: txID}); err != nil {
+		t.Errorf("Sparql.Construct returned error: %v", err)
+	}
+	got, _, err := client.Sparql.Ask(ctx, db, "ASK { <urn:s> <urn:p> <urn:o> }", &AskOptions{TxID: txID})
+	if err != nil {
+		t.Errorf("Sparql.Ask returned error: %v", err)
+	}
+	if got == nil || !*got {
+		t.Errorf("Sparql.Ask = %v, want true", got)
+	}
+}
+
+func TestSparqlService_Describe(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	wantRDF := `<urn:paul> <urn:memberOf> <urn:beatles> .`
+	query := `DESCRIBE <urn:paul>`
+
+	mux.HandleFunc("/db1/query", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeApplicationNTriples)
+		q := r.URL.Query()
+		if got, want := q.Get("query"), "#pragma describe.strategy cbd\n"+query; got != want {
+			t.Errorf("query = %q, want %q", got, want)
+		}
+		if got, want := q.Get("reasoning"), "true"; got != want {
+			t.Errorf("reasoning = %q, want %q", got, want)
+		}
+		w.Write([]byte(wantRDF))
+	})
+
+	opts := &DescribeOptions{Reasoning: true, Strategy: DescribeStrategyCBD, ResultFormat: RDFFormatNTriples}
+	got, _, err := client.Sparql.Describe(context.Background(), "db1", query, opts)
+	if err != nil {
+		t.Fatalf("Sparql.Describe returned error: %v", err)
+	}
+	if got.String() != wantRDF {
+		t.Errorf("Sparql.Describe = %s, want %s", got, wantRDF)
+	}
+}
+
+func TestSparqlService_Describe_defaults(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	query := `DESCRIBE ?s WHERE { ?s a <urn:Band> }`
+	mux.HandleFunc("/db1/query", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Accept", mediaTypeApplicationTrig)
+		if got := r.URL.Query().Get("query"); got != query {
+			t.Errorf("query = %q, want %q without a strategy hint", got, query)
+		}
+	})
+
+	if _, _, err := client.Sparql.Describe(context.Background(), "db1", query, nil); err != nil {
+		t.Errorf("Sparql.Describe returned error: %v", err)
+	}
+
+	_, _, err := client.Sparql.Describe(context.Background(), "db1", query, &DescribeOptions{Strategy: DescribeStrategy(10)})
+	if err == nil {
+		t.Errorf("Sparql.Describe with an invalid strategy returned no error")
+	}
+}
+
+func TestDescribeStrategy_Valid(t *testing.T) {
+	d := DescribeStrategy(100)
+	if d.Valid() {
+		t.Errorf("should be an invalid DescribeStrategy")
+	}
+	if d.String() != DescribeStrategyUnknown.String() {
+		t.Errorf("DescribeStrategy string value should be empty")
+	}
+	if got, want := DescribeStrategyBidirectional.String(), "bidirectional"; got != want {
+		t.Errorf("DescribeStrategyBidirectional.String() = %s, want %s", got, want)
+	}
+}
+
+func TestSparqlService_defaultReasoning(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var gotReasoning []string
+	record := func(w http.ResponseWriter, r *http.Request) {
+		gotReasoning = append(gotReasoning, strings.Join(r.URL.Query()["reasoning"], ","))
+	}
+	mux.HandleFunc("/db1/query", func(w http.ResponseWriter, r *http.Request) {
+		record(w, r)
+		if r.Header.Get("Accept") == mediaTypeBoolean {
+			w.Write([]byte("true"))
+		}
+	})
+	mux.HandleFunc("/db1/update", record)
+	mux.HandleFunc("/db1/explain", record)
+
+	ctx := context.Background()
+	disabled := WithReasoning(ctx, false)
+	client.DefaultReasoning = true
+
+	client.Sparql.Select(ctx, "db1", "SELECT * {}", nil)
+	client.Sparql.Ask(ctx, "db1", "ASK {}", &AskOptions{})
+	client.Sparql.Construct(ctx, "db1", "CONSTRUCT {} WHERE {}", nil)
+	client.Sparql.Update(ctx, "db1", "INSERT DATA {}", nil)
+	client.Sparql.Explain(ctx, "db1", "SELECT * {}", nil)
+	client.Sparql.Select(disabled, "db1", "SELECT * {}", nil)
+	client.Sparql.Select(disabled, "db1", "SELECT * {}", &SelectOptions{Reasoning: true})
+
+	client.DefaultReasoning = false
+	client.Sparql.Select(ctx, "db1", "SELECT * {}", nil)
+	client.Sparql.Select(WithReasoning(ctx, true), "db1", "SELECT * {}", nil)
+
+	want := []string{"true", "true", "true", "true", "true", "", "true", "", "true"}
+	if !cmp.Equal(gotReasoning, want) {
+		t.Errorf("reasoning parameters = %q, want %q", gotReasoning, want)
+	}
+}
+
+func TestSparqlService_deadlineTimeout(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var gotTimeouts []string
+	record := func(w http.ResponseWriter, r *http.Request) {
+		gotTimeouts = append(gotTimeouts, r.URL.Query().Get("timeout"))
+		if r.Header.Get("Accept") == mediaTypeBoolean {
+			w.Write([]byte("true"))
+		}
+	}
+	mux.HandleFunc("/db1/query", record)
+	mux.HandleFunc("/db1/update", record)
+	mux.HandleFunc("/db1/explain", record)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	client.Sparql.Select(ctx, "db1", "SELECT * {}", nil)
+	client.Sparql.Ask(ctx, "db1", "ASK {}", nil)
+	client.Sparql.Construct(ctx, "db1", "CONSTRUCT {} WHERE {}", nil)
+	client.Sparql.Update(ctx, "db1", "INSERT DATA {}", nil)
+	client.Sparql.Explain(ctx, "db1", "SELECT * {}", &ExplainOptions{Profile: true})
+
+	for i, got := range gotTimeouts {
+		timeout, err := strconv.Atoi(got)
+		if err != nil || timeout <= 0 || timeout > int(time.Hour/time.Millisecond) {
+			t.Errorf("request %d timeout parameter = %q, want the milliseconds until the deadline", i, got)
+		}
+	}
+
+	gotTimeouts = nil
+	client.Sparql.Select(ctx, "db1", "SELECT * {}", &SelectOptions{Timeout: 5})
+	client.Sparql.Select(context.Background(), "db1", "SELECT * {}", nil)
+	client.Sparql.Select(WithoutDeadlineTimeout(ctx), "db1", "SELECT * {}", nil)
+	client.DisableDeadlineTimeout = true
+	client.Sparql.Select(ctx, "db1", "SELECT * {}", nil)
+
+	want := []string{"5", "", "", ""}
+	if !cmp.Equal(gotTimeouts, want) {
+		t.Errorf("timeout parameters = %q, want %q", gotTimeouts, want)
+	}
+}
+
+func TestSparqlService_deadlineTimeout_retry(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.Retry = &RetryPolicy{MaxRetries: 1, Backoff: 200 * time.Millisecond}
+
+	var gotTimeouts []int
+	mux.HandleFunc("/db1/query", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query()["timeout"]; len(got) != 1 {
+			t.Errorf("timeout parameters = %q, want one", got)
+		}
+		timeout, _ := strconv.Atoi(r.URL.Query().Get("timeout"))
+		gotTimeouts = append(gotTimeouts, timeout)
+		if len(gotTimeouts) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"head":{"vars":[]},"results":{"bindings":[]}}`))
+	})
+
+	// the time spent waiting to retry isn't included in the timeout of the retry
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	if _, _, err := client.Sparql.Select(ctx, "db1", "SELECT * {}", nil); err != nil {
+		t.Fatalf("Sparql.Select returned error: %v", err)
+	}
+	if len(gotTimeouts) != 2 || gotTimeouts[1] > gotTimeouts[0]-150 {
+		t.Errorf("timeouts = %v, want the second to exclude the 200ms backoff", gotTimeouts)
+	}
+}
+
+func TestSparqlService_killCanceledQueries(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	query := "SELECT * { ?s ?p ?o }"
+	mux.HandleFunc("/db1/query", func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+	mux.HandleFunc("/admin/queries", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"queries": [{"id": "7", "query": %q, "db": "db1"}]}`, query)
+	})
+	var killed []string
+	mux.HandleFunc("/admin/queries/7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		killed = append(killed, "7")
+	})
+
+	run := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		if _, _, err := client.Sparql.Select(ctx, "db1", query, nil); err == nil {
+			t.Errorf("Sparql.Select returned no error, want the context's")
+		}
+	}
+
+	run()
+	if len(killed) != 0 {
+		t.Errorf("queries killed without KillCanceledQueries: %v", killed)
+	}
+
+	client.KillCanceledQueries = true
+	run()
+	if want := []string{"7"}; !cmp.Equal(killed, want) {
+		t.Errorf("killed queries = %v, want %v", killed, want)
+	}
+}
+
+func TestSparqlService_maxSelectResults(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var gotLimits []string
+	mux.HandleFunc("/db1/query", func(w http.ResponseWriter, r *http.Request) {
+		gotLimits = append(gotLimits, r.URL.Query().Get("limit"))
+	})
+
+	ctx := context.Background()
+	client.Sparql.Select(ctx, "db1", "SELECT * {}", nil)
+
+	client.MaxSelectResults = 100
+	opts := &SelectOptions{Limit: 1000}
+	client.Sparql.Select(ctx, "db1", "SELECT * {}", nil)
+	client.Sparql.Select(ctx, "db1", "SELECT * {}", &SelectOptions{})
+	client.Sparql.Select(ctx, "db1", "SELECT * {}", &SelectOptions{Limit: 10})
+	client.Sparql.Select(ctx, "db1", "SELECT * {}", opts)
+
+	want := []string{"", "100", "100", "10", "100"}
+	if !cmp.Equal(gotLimits, want) {
+		t.Errorf("limit parameters = %q, want %q", gotLimits, want)
+	}
+	if opts.Limit != 1000 {
+		t.Errorf("SelectOptions.Limit = %d after the query, want it unchanged", opts.Limit)
+	}
+}
@@ -0,0 +1,28 @@
+package stardog
+
+import "context"
+
+// RateLimiter limits the rate of requests a [Client] sends. It's implemented by *rate.Limiter from
+// golang.org/x/time/rate, so a limiter can be shared by several clients to cap their combined rate:
+//
+//	client.RateLimiter = rate.NewLimiter(rate.Limit(10), 1) // 10 requests per second
+type RateLimiter interface {
+	// Wait blocks until a request may be sent, returning an error if ctx is done first or the
+	// request can never be sent.
+	Wait(ctx context.Context) error
+}
+
+// waitForRateLimit blocks until the Client's RateLimiter, if it has one, allows a request to be sent.
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+	if c.RateLimiter == nil {
+		return nil
+	}
+	if err := c.RateLimiter.Wait(ctx); err != nil {
+		// the context's error is more useful than the limiter's wrapping of it
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package stardog
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type testRateLimiter struct {
+	waits int
+	err   error
+}
+
+func (l *testRateLimiter) Wait(ctx context.Context) error {
+	l.waits++
+	return l.err
+}
+
+func TestClient_RateLimiter(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	limiter := &testRateLimiter{}
+	client.RateLimiter = limiter
+	client.Retry = &RetryPolicy{MaxRetries: 1, Backoff: time.Millisecond}
+
+	attempts := 0
+	mux.HandleFunc("/admin/alive", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	})
+
+	if _, _, err := client.ServerAdmin.IsAlive(context.Background()); err != nil {
+		t.Fatalf("ServerAdmin.IsAlive returned error: %v", err)
+	}
+	if limiter.waits != 2 {
+		t.Errorf("RateLimiter.Wait called %d times, want 2 (once per attempt)", limiter.waits)
+	}
+}
+
+func TestClient_RateLimiter_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	limitErr := errors.New("rate: Wait(n=1) would exceed context deadline")
+	client.RateLimiter = &testRateLimiter{err: limitErr}
+
+	mux.HandleFunc("/admin/alive", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request sent despite the rate limiter returning an error")
+	})
+
+	_, _, err := client.ServerAdmin.IsAlive(context.Background())
+	if !errors.Is(err, limitErr) {
+		t.Errorf("ServerAdmin.IsAlive error = %v, want %v", err, limitErr)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, _, err = client.ServerAdmin.IsAlive(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ServerAdmin.IsAlive with canceled context error = %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,204 @@
+package stardog
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// rdfSniffSize is the number of leading bytes of data that DetectRDFFormat is given by readers.
+const rdfSniffSize = 16 << 10
+
+// turtlePrefixedName matches a Turtle prefixed name, or an empty prefix, at the start of a statement.
+var turtlePrefixedName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*:|^:`)
+
+// errUnknownRDFContent is returned by DetectRDFFormat when the data isn't recognized as RDF.
+var errUnknownRDFContent = errors.New("unable to determine the RDF Format from the data")
+
+// DetectRDFFormat determines the RDFFormat of RDF data from its leading bytes, for data whose format can't be
+// determined from its file extension (see [GetRDFFormatFromExtension]), such as temporary files and readers.
+// The data may be gzip or bzip2 compressed, and only needs to include the first few statements.
+//
+// RDF/XML and JSON-LD are recognized by their markup, N-Triples and N-Quads by their first statements, and
+// Turtle and TriG by their directives or statements, with TriG only recognized by a graph within the data.
+// Turtle-star and TriG-star are detected if the data has quoted triples.
+func DetectRDFFormat(data []byte) (RDFFormat, error) {
+	if compression := detectCompression(data); compression != CompressionUnknown {
+		decompressed, _, err := Decompress(bytes.NewReader(data))
+		if err != nil {
+			return RDFFormatUnknown, err
+		}
+		// data may be truncated, so the error reading past its end is ignored
+		data, _ = io.ReadAll(io.LimitReader(decompressed, rdfSniffSize))
+	}
+	text := skipRDFPreamble(string(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	switch {
+	case text == "":
+		return RDFFormatUnknown, errUnknownRDFContent
+	case strings.HasPrefix(text, "<?") || strings.HasPrefix(text, "<!"):
+		return RDFFormatRDFXML, nil
+	case text[0] == '<' && !strings.HasPrefix(text, "<<") && skipSPARQLIRI(text, 0) == 1:
+		// an element rather than an IRI, e.g. <rdf:RDF xmlns:rdf="...">
+		return RDFFormatRDFXML, nil
+	case isJSONStart(text):
+		return RDFFormatJSONLD, nil
+	}
+	if format, ok := detectLineBasedRDF(text); ok {
+		return format, nil
+	}
+	if !isTurtleStart(text) {
+		return RDFFormatUnknown, errUnknownRDFContent
+	}
+	graphs, quoted := scanTurtle(text)
+	switch {
+	case graphs && quoted:
+		return RDFFormatTrigStar, nil
+	case graphs:
+		return RDFFormatTrig, nil
+	case quoted:
+		return RDFFormatTurtleStar, nil
+	}
+	return RDFFormatTurtle, nil
+}
+
+// sniffRDFFormat determines the RDFFormat of the data read from r with DetectRDFFormat. The returned reader
+// reads all of the data, including the bytes read to determine its format.
+func sniffRDFFormat(r io.Reader) (RDFFormat, io.Reader, error) {
+	buffered := bufio.NewReaderSize(r, rdfSniffSize)
+	data, err := buffered.Peek(rdfSniffSize)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return RDFFormatUnknown, buffered, err
+	}
+	format, err := DetectRDFFormat(data)
+	return format, buffered, err
+}
+
+// skipRDFPreamble returns text without its leading whitespace and comments.
+func skipRDFPreamble(text string) string {
+	for {
+		text = strings.TrimLeft(text, " \t\r\n")
+		if !strings.HasPrefix(text, "#") {
+			return text
+		}
+		end := strings.IndexByte(text, '\n')
+		if end < 0 {
+			return ""
+		}
+		text = text[end:]
+	}
+}
+
+// isJSONStart reports whether text starts with a JSON object or array, rather than a TriG graph or Turtle
+// blank node.
+func isJSONStart(text string) bool {
+	if text[0] != '{' && text[0] != '[' {
+		return false
+	}
+	rest := strings.TrimLeft(text[1:], " \t\r\n")
+	if rest == "" {
+		return false
+	}
+	if text[0] == '{' {
+		return rest[0] == '"' || rest[0] == '}'
+	}
+	return rest[0] == '{' || rest[0] == ']' && strings.TrimLeft(rest[1:], " \t\r\n") == ""
+}
+
+// detectLineBasedRDF reports whether the complete lines of text are N-Triples, or N-Quads if any of them
+// has a graph. The last line is ignored if it's incomplete, since the text may be truncated.
+func detectLineBasedRDF(text string) (RDFFormat, bool) {
+	lines := strings.Split(text, "\n")
+	format := RDFFormatUnknown
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lineFormat, ok := nQuadsLineFormat(line)
+		if !ok {
+			if i == len(lines)-1 && format != RDFFormatUnknown {
+				break
+			}
+			return RDFFormatUnknown, false
+		}
+		if format != RDFFormatNQuads {
+			format = lineFormat
+		}
+	}
+	return format, format != RDFFormatUnknown
+}
+
+// nQuadsLineFormat reports whether line is an N-Triples statement, or an N-Quads statement with a graph.
+func nQuadsLineFormat(line string) (RDFFormat, bool) {
+	p := &nTriplesLexer{input: line}
+	if _, err := p.triple(); err != nil {
+		return RDFFormatUnknown, false
+	}
+	format := RDFFormatNTriples
+	p.skipSpace()
+	if !strings.HasPrefix(p.input[p.pos:], ".") {
+		graph, err := p.term()
+		if err != nil || (graph.kind != rdfTermIRI && graph.kind != rdfTermBlankNode) {
+			return RDFFormatUnknown, false
+		}
+		format = RDFFormatNQuads
+		p.skipSpace()
+	}
+	if !strings.HasPrefix(p.input[p.pos:], ".") {
+		return RDFFormatUnknown, false
+	}
+	p.pos++
+	p.skipSpace()
+	if rest := p.input[p.pos:]; rest != "" && !strings.HasPrefix(rest, "#") {
+		return RDFFormatUnknown, false
+	}
+	return format, true
+}
+
+// isTurtleStart reports whether text starts with a Turtle or TriG directive or statement.
+func isTurtleStart(text string) bool {
+	keyword := strings.TrimPrefix(text, "@")
+	if end := strings.IndexAny(keyword, " \t\r\n"); end >= 0 {
+		keyword = keyword[:end]
+	}
+	keyword = strings.ToUpper(keyword)
+	if keyword == "PREFIX" || keyword == "BASE" || keyword == "GRAPH" {
+		return true
+	}
+	switch text[0] {
+	case '<', '[', '(', '{':
+		return true
+	}
+	return strings.HasPrefix(text, "_:") || turtlePrefixedName.MatchString(text)
+}
+
+// scanTurtle reports whether Turtle or TriG text has a graph, i.e. a { that isn't an RDF-star annotation {|,
+// and whether it has quoted triples. Strings, IRIs and comments are skipped.
+func scanTurtle(text string) (graphs bool, quoted bool) {
+	for i := 0; i < len(text); {
+		switch c := text[i]; {
+		case c == '"' || c == '\'':
+			i = skipSPARQLString(text, i)
+		case strings.HasPrefix(text[i:], "<<"):
+			quoted = true
+			i += 2
+		case c == '<':
+			i = skipSPARQLIRI(text, i)
+		case c == '#':
+			end := strings.IndexByte(text[i:], '\n')
+			if end < 0 {
+				return graphs, quoted
+			}
+			i += end
+		case c == '{' && !strings.HasPrefix(text[i:], "{|"):
+			graphs = true
+			i++
+		default:
+			i++
+		}
+	}
+	return graphs, quoted
+}
@@ -0,0 +1,69 @@
+package stardog
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestDetectRDFFormat(t *testing.T) {
+	beatles, err := os.ReadFile("./test-resources/beatles.ttl")
+	if err != nil {
+		t.Fatalf("unexpected error reading test resource: %v", err)
+	}
+	var gzipped bytes.Buffer
+	gz := gzip.NewWriter(&gzipped)
+	gz.Write([]byte("<urn:s> <urn:p> <urn:o> <urn:g> .\n"))
+	gz.Close()
+
+	tests := []struct {
+		name string
+		data []byte
+		want RDFFormat
+	}{
+		{name: "turtle resource", data: beatles, want: RDFFormatTurtle},
+		{name: "turtle prefix", data: []byte("# comment\n@prefix ex: <http://example.com/> .\nex:a ex:b \"{not a graph}\" ."), want: RDFFormatTurtle},
+		{name: "sparql prefix", data: []byte("PREFIX ex: <http://example.com/>\nex:a ex:b ex:c ."), want: RDFFormatTurtle},
+		{name: "prefixed name", data: []byte("ex:a a ex:B ."), want: RDFFormatTurtle},
+		{name: "blank node list", data: []byte("[ <urn:p> <urn:o> ] ."), want: RDFFormatTurtle},
+		{name: "turtle-star", data: []byte("<< <urn:s> <urn:p> <urn:o> >> <urn:q> \"1\" ;\n  <urn:r> <urn:x> ."), want: RDFFormatTurtleStar},
+		{name: "annotation", data: []byte("@prefix : <urn:> .\n:s :p :o {| :q :r |} ."), want: RDFFormatTurtle},
+		{name: "trig", data: []byte("@prefix ex: <http://example.com/> .\nex:g { ex:a ex:b ex:c . }"), want: RDFFormatTrig},
+		{name: "trig default graph", data: []byte("{ <urn:a> <urn:b> <urn:c> . }"), want: RDFFormatTrig},
+		{name: "trig-star", data: []byte("GRAPH <urn:g> { << <urn:s> <urn:p> <urn:o> >> <urn:q> 1 . }"), want: RDFFormatTrigStar},
+		{name: "ntriples", data: []byte("<urn:s> <urn:p> \"o\"@en .\n_:b <urn:p> <urn:o> .\n"), want: RDFFormatNTriples},
+		{name: "ntriples truncated", data: []byte("<urn:s> <urn:p> \"o\" .\n<urn:s> <urn:p> \"tru"), want: RDFFormatNTriples},
+		{name: "nquads", data: []byte("<urn:s> <urn:p> <urn:o> .\n<urn:s> <urn:p> <urn:o> <urn:g> .\n"), want: RDFFormatNQuads},
+		{name: "gzipped nquads", data: gzipped.Bytes(), want: RDFFormatNQuads},
+		{name: "rdf/xml declaration", data: []byte("\xef\xbb\xbf<?xml version=\"1.0\"?>\n<rdf:RDF/>"), want: RDFFormatRDFXML},
+		{name: "rdf/xml element", data: []byte("<rdf:RDF xmlns:rdf=\"http://www.w3.org/1999/02/22-rdf-syntax-ns#\"/>"), want: RDFFormatRDFXML},
+		{name: "json-ld object", data: []byte(`{"@context": {}, "@id": "urn:a"}`), want: RDFFormatJSONLD},
+		{name: "json-ld array", data: []byte(` [ {"@id": "urn:a"} ]`), want: RDFFormatJSONLD},
+	}
+	for _, tc := range tests {
+		got, err := DetectRDFFormat(tc.data)
+		if err != nil || got != tc.want {
+			t.Errorf("%s: DetectRDFFormat = %v, %v, want %v", tc.name, got, err, tc.want)
+		}
+	}
+
+	for _, data := range []string{"", "  # only a comment\n", "id,title\n1,Help!\n", "%PDF-1.7"} {
+		if got, err := DetectRDFFormat([]byte(data)); err == nil {
+			t.Errorf("DetectRDFFormat(%q) = %v, want an error", data, got)
+		}
+	}
+}
+
+func TestSniffRDFFormat(t *testing.T) {
+	data := "<urn:s> <urn:p> <urn:o> .\n" + strings.Repeat("<urn:s> <urn:p> \"a long literal\" .\n", rdfSniffSize/30)
+	format, r, err := sniffRDFFormat(strings.NewReader(data))
+	if err != nil || format != RDFFormatNTriples {
+		t.Errorf("sniffRDFFormat = %v, %v, want %v", format, err, RDFFormatNTriples)
+	}
+	var got bytes.Buffer
+	if _, err := got.ReadFrom(r); err != nil || got.String() != data {
+		t.Errorf("sniffRDFFormat reader read %d bytes, %v, want all %d bytes of the data", got.Len(), err, len(data))
+	}
+}
@@ -1,7 +1,6 @@
 package stardog
 
 import (
-	"errors"
 	"fmt"
 	"path/filepath"
 	"strings"
@@ -22,36 +21,87 @@ const (
 	RDFFormatNTriples
 	RDFFormatNQuads
 	RDFFormatJSONLD
+	// Turtle-star, for databases with edge properties (RDF-star) enabled
+	RDFFormatTurtleStar
+	// TriG-star, for databases with edge properties (RDF-star) enabled
+	RDFFormatTrigStar
+	// Notation3, of which Stardog reads and writes the subset that is RDF
+	RDFFormatN3
 )
 
-var rdfFormatValues = [7]string{
-	RDFFormatUnknown:  "UNKNOWN",
-	RDFFormatTrig:     mediaTypeApplicationTrig,
-	RDFFormatTurtle:   mediaTypeTextTurtle,
-	RDFFormatRDFXML:   mediaTypeApplicationRDFXML,
-	RDFFormatNTriples: mediaTypeApplicationNTriples,
-	RDFFormatNQuads:   mediaTypeApplicationNQuads,
-	RDFFormatJSONLD:   mediaTypeApplicationJSONLD,
+var rdfFormatValues = [10]string{
+	RDFFormatUnknown:    "UNKNOWN",
+	RDFFormatTrig:       mediaTypeApplicationTrig,
+	RDFFormatTurtle:     mediaTypeTextTurtle,
+	RDFFormatRDFXML:     mediaTypeApplicationRDFXML,
+	RDFFormatNTriples:   mediaTypeApplicationNTriples,
+	RDFFormatNQuads:     mediaTypeApplicationNQuads,
+	RDFFormatJSONLD:     mediaTypeApplicationJSONLD,
+	RDFFormatTurtleStar: mediaTypeApplicationTurtleStar,
+	RDFFormatTrigStar:   mediaTypeApplicationTrigStar,
+	RDFFormatN3:         mediaTypeTextN3,
 }
 
-// Valid returns if a given RDFFormat is known (valid) or not.
+// rdfFormatExtensions maps each RDFFormat to the file extension used for it
+var rdfFormatExtensions = [10]string{
+	RDFFormatUnknown:    "",
+	RDFFormatTrig:       "trig",
+	RDFFormatTurtle:     "ttl",
+	RDFFormatRDFXML:     "rdf",
+	RDFFormatNTriples:   "nt",
+	RDFFormatNQuads:     "nq",
+	RDFFormatJSONLD:     "jsonld",
+	RDFFormatTurtleStar: "ttls",
+	RDFFormatTrigStar:   "trigs",
+	RDFFormatN3:         "n3",
+}
+
+// Valid returns if a given RDFFormat is known (valid) or not. Formats added with [RegisterRDFFormat] are valid.
 func (r RDFFormat) Valid() bool {
-	return !(r <= RDFFormatUnknown || int(r) >= len(rdfFormatValues))
+	if r <= RDFFormatUnknown {
+		return false
+	}
+	if int(r) < len(rdfFormatValues) {
+		return true
+	}
+	_, ok := registeredRDFFormat(r)
+	return ok
 }
 
 // String will return the string representation of the RDFFormat, which is the [MIME-type]
 //
 // [MIME-type]: https://developer.mozilla.org/en-US/docs/Web/HTTP/Basics_of_HTTP/MIME_types
 func (r RDFFormat) String() string {
-	if !r.Valid() {
+	if r <= RDFFormatUnknown {
 		return rdfFormatValues[RDFFormatUnknown]
 	}
-	return rdfFormatValues[r]
+	if int(r) < len(rdfFormatValues) {
+		return rdfFormatValues[r]
+	}
+	if format, ok := registeredRDFFormat(r); ok {
+		return format.mediaType
+	}
+	return rdfFormatValues[RDFFormatUnknown]
+}
+
+// extension returns the file extension, without a leading dot, for files in the format.
+func (r RDFFormat) extension() string {
+	if r <= RDFFormatUnknown {
+		return rdfFormatExtensions[RDFFormatUnknown]
+	}
+	if int(r) < len(rdfFormatExtensions) {
+		return rdfFormatExtensions[r]
+	}
+	if format, ok := registeredRDFFormat(r); ok && len(format.extensions) > 0 {
+		return format.extensions[0]
+	}
+	return rdfFormatExtensions[RDFFormatUnknown]
 }
 
 // helper function to get a string representation of the RDFFormat that [DatabaseAdminService.ExportData]
 // and [DatabaseAdminService.ExportObfuscatedData] need to satisfy the Stardog API.
-func (r RDFFormat) toExportFormat() (string, error) {
+// field names the option the format was given in, for the ValidationError returned if it can't be exported.
+func (r RDFFormat) toExportFormat(field string) (string, error) {
 	switch r {
 	case RDFFormatTrig:
 		return "trig", nil
@@ -66,13 +116,19 @@ func (r RDFFormat) toExportFormat() (string, error) {
 	case RDFFormatRDFXML:
 		return "rdfxml", nil
 	default:
-		return "", errors.New("supported RDF formats for export are Trig, Turtle, JSONLD, NQUADS, NTRIPLES, and RDFXML")
+		return "", &ValidationError{Field: field, Value: r, Constraint: "must be one of Trig, Turtle, JSONLD, NQUADS, NTRIPLES, or RDFXML for export"}
 	}
 }
 
-// GetRDFFormatFromExtension attempts to determine the RDFFormat from a given filepath.
+// GetRDFFormatFromExtension attempts to determine the RDFFormat from a given filepath, including the
+// extensions of formats added with [RegisterRDFFormat].
+// The extension of a compressed file (see [GetCompressionFromExtension]) is ignored, so "data.ttl.gz" is Turtle.
 func GetRDFFormatFromExtension(path string) (RDFFormat, error) {
-	extension := strings.TrimPrefix(filepath.Ext(path), ".")
+	name := path
+	if GetCompressionFromExtension(name) != CompressionUnknown {
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+	extension := strings.TrimPrefix(filepath.Ext(name), ".")
 	switch extension {
 	case "ttl":
 		return RDFFormatTurtle, nil
@@ -86,7 +142,16 @@ func GetRDFFormatFromExtension(path string) (RDFFormat, error) {
 		return RDFFormatNQuads, nil
 	case "nt":
 		return RDFFormatNTriples, nil
+	case "ttls":
+		return RDFFormatTurtleStar, nil
+	case "trigs":
+		return RDFFormatTrigStar, nil
+	case "n3":
+		return RDFFormatN3, nil
 	default:
+		if format, ok := registeredRDFFormatForExtension(extension); ok {
+			return format, nil
+		}
 		return RDFFormatUnknown, fmt.Errorf("unable to determine the RDF Format from file: %s", path)
 	}
 }
@@ -16,6 +16,38 @@ func TestRDFFormat_Valid(t *testing.T) {
 	}
 }
 
+func TestRDFFormat_mediaTypesAndExtensions(t *testing.T) {
+	tests := []struct {
+		format        RDFFormat
+		wantMediaType string
+		wantExtension string
+	}{
+		{format: RDFFormatTrig, wantMediaType: "application/trig", wantExtension: "trig"},
+		{format: RDFFormatTurtle, wantMediaType: "text/turtle", wantExtension: "ttl"},
+		{format: RDFFormatRDFXML, wantMediaType: "application/rdf+xml", wantExtension: "rdf"},
+		{format: RDFFormatNTriples, wantMediaType: "application/n-triples", wantExtension: "nt"},
+		{format: RDFFormatNQuads, wantMediaType: "application/n-quads", wantExtension: "nq"},
+		{format: RDFFormatJSONLD, wantMediaType: "application/ld+json", wantExtension: "jsonld"},
+		{format: RDFFormatTurtleStar, wantMediaType: "application/x-turtlestar", wantExtension: "ttls"},
+		{format: RDFFormatTrigStar, wantMediaType: "application/x-trigstar", wantExtension: "trigs"},
+		{format: RDFFormatN3, wantMediaType: "text/n3", wantExtension: "n3"},
+	}
+	for _, tc := range tests {
+		if !tc.format.Valid() {
+			t.Errorf("%s should be a valid RDFFormat", tc.wantMediaType)
+		}
+		if got := tc.format.String(); got != tc.wantMediaType {
+			t.Errorf("RDFFormat.String() = %q, want %q", got, tc.wantMediaType)
+		}
+		if got := tc.format.extension(); got != tc.wantExtension {
+			t.Errorf("%s: RDFFormat.extension() = %q, want %q", tc.wantMediaType, got, tc.wantExtension)
+		}
+		if got, err := GetRDFFormatFromExtension("data." + tc.wantExtension); err != nil || got != tc.format {
+			t.Errorf("GetRDFFormatFromExtension(%q) = %v, %v, want %v", "data."+tc.wantExtension, got, err, tc.format)
+		}
+	}
+}
+
 func TestRDFFormat_GetRDFFormatFromExtension(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -28,6 +60,14 @@ func TestRDFFormat_GetRDFFormatFromExtension(t *testing.T) {
 		{name: "ntriples", input: "file.nt", want: RDFFormatNTriples},
 		{name: "nquads", input: "file.nq", want: RDFFormatNQuads},
 		{name: "jsonld", input: "file.jsonld", want: RDFFormatJSONLD},
+		{name: "turtlestar", input: "file.ttls", want: RDFFormatTurtleStar},
+		{name: "trigstar", input: "file.trigs", want: RDFFormatTrigStar},
+		{name: "n3", input: "file.n3", want: RDFFormatN3},
+		{name: "json", input: "file.json", want: RDFFormatJSONLD},
+		{name: "gzipped turtle", input: "file.ttl.gz", want: RDFFormatTurtle},
+		{name: "gzipped trig", input: "dir/file.trig.gzip", want: RDFFormatTrig},
+		{name: "bzipped nquads", input: "file.nq.bz2", want: RDFFormatNQuads},
+		{name: "zipped rdfxml", input: "file.rdf.zip", want: RDFFormatRDFXML},
 	}
 
 	for _, tc := range tests {
@@ -45,6 +85,12 @@ func TestRDFFormat_GetRDFFormatFromExtension(t *testing.T) {
 	if err == nil {
 		t.Errorf("RDFFormat.GetRDFFormatFromExtension failure: %s should not have an extension that matches an RDF Format.", fileWithoutRDFExtension)
 	}
+
+	for _, compressedWithoutRDFExtension := range []string{"file.gz", "file.pdf.bz2"} {
+		if _, err := GetRDFFormatFromExtension(compressedWithoutRDFExtension); err == nil {
+			t.Errorf("RDFFormat.GetRDFFormatFromExtension failure: %s should not have an extension that matches an RDF Format.", compressedWithoutRDFExtension)
+		}
+	}
 }
 
 func TestRDFFormat_toExportFormat(t *testing.T) {
@@ -62,7 +108,7 @@ func TestRDFFormat_toExportFormat(t *testing.T) {
 	}
 
 	for _, tc := range tests {
-		got, err := tc.input.toExportFormat()
+		got, err := tc.input.toExportFormat("ExportDataOptions.Format")
 		if err != nil {
 			t.Errorf("RDFFormat.toExportFormat unexpected failure: %v: ", err)
 		}
@@ -72,8 +118,14 @@ func TestRDFFormat_toExportFormat(t *testing.T) {
 	}
 
 	unknownRDFFormat := RDFFormatUnknown
-	_, err := unknownRDFFormat.toExportFormat()
+	_, err := unknownRDFFormat.toExportFormat("ExportDataOptions.Format")
 	if err == nil {
 		t.Errorf("RDFFormat.toExportFormat failure: %s should have failed because this is not a known format", unknownRDFFormat)
 	}
+
+	for _, format := range []RDFFormat{RDFFormatTurtleStar, RDFFormatTrigStar, RDFFormatN3} {
+		if _, err := format.toExportFormat("ExportDataOptions.Format"); err == nil {
+			t.Errorf("RDFFormat.toExportFormat failure: %s should have failed because it can't be exported server side", format)
+		}
+	}
 }
@@ -0,0 +1,226 @@
+package stardog
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Term is an RDF term: an [IRI], [Literal], [BNode] or quoted [Triple] (RDF-star). Its String method returns
+// its syntax, escaped, which is the same in SPARQL, Turtle and N-Triples, so terms can be placed in queries
+// and RDF payloads without escaping them by hand. Terms are also accepted by [FormatValue].
+//
+//	query := fmt.Sprintf("SELECT ?s { ?s %s %s }", stardog.IRI(rdfsLabel), stardog.Literal{Value: label, Lang: "en"})
+type Term interface {
+	String() string
+	isTerm()
+}
+
+// IRI is an RDF IRI, written in angle brackets with the characters not allowed in an IRI reference
+// percent-encoded (see [EscapeIRI]).
+type IRI string
+
+func (IRI) isTerm() {}
+
+// String returns the IRI in angle brackets.
+func (i IRI) String() string {
+	return quoteIRI(string(i))
+}
+
+// BNode is an RDF blank node, identified by its label. Labels only need to be unique within a query or a
+// payload of RDF: the server assigns blank nodes new identifiers.
+type BNode string
+
+func (BNode) isTerm() {}
+
+// String returns the blank node's label prefixed with _:. Characters not allowed in a label are replaced by
+// underscores.
+func (b BNode) String() string {
+	label := []byte(b)
+	for i, c := range label {
+		if !isAlphaNumeric(c) && c != '_' && c != '-' && (c != '.' || i == 0 || i == len(label)-1) {
+			label[i] = '_'
+		}
+	}
+	if len(label) == 0 {
+		return "_:b"
+	}
+	return "_:" + string(label)
+}
+
+// Literal is an RDF literal. A literal with a Lang is a language-tagged string, and one with neither a Lang
+// nor a Datatype is an xsd:string.
+type Literal struct {
+	// The lexical form of the literal
+	Value string
+	// The datatype IRI of the literal, e.g. http://www.w3.org/2001/XMLSchema#integer. Ignored if Lang is set.
+	Datatype IRI
+	// The language tag of the literal, e.g. "en"
+	Lang string
+}
+
+func (Literal) isTerm() {}
+
+// String returns the literal quoted, with its language tag or datatype, if any. Characters not allowed in a
+// language tag are removed from it.
+func (l Literal) String() string {
+	literal := quoteLiteral(l.Value)
+	lang := strings.Map(func(r rune) rune {
+		if r < 0x80 && (isAlphaNumeric(byte(r)) || r == '-') {
+			return r
+		}
+		return -1
+	}, l.Lang)
+	switch {
+	case lang != "":
+		return literal + "@" + lang
+	case l.Datatype != "" && l.Datatype != xsdNamespace+"string":
+		return literal + "^^" + l.Datatype.String()
+	}
+	return literal
+}
+
+// Triple is an RDF triple. As a Term, it's a quoted triple (RDF-star).
+type Triple struct {
+	Subject   Term
+	Predicate Term
+	Object    Term
+}
+
+func (Triple) isTerm() {}
+
+// String returns the quoted triple, i.e. the triple between << and >>.
+func (t Triple) String() string {
+	return fmt.Sprintf("<< %s %s %s >>", termString(t.Subject), termString(t.Predicate), termString(t.Object))
+}
+
+// validate checks that the terms are allowed in their positions: a subject is an IRI, blank node or quoted
+// triple, and a predicate is an IRI.
+func (t Triple) validate() error {
+	switch t.Subject.(type) {
+	case IRI, BNode, Triple:
+	default:
+		return &ValidationError{Field: "Triple.Subject", Value: t.Subject, Constraint: "must be an IRI, BNode or Triple"}
+	}
+	if _, ok := t.Predicate.(IRI); !ok {
+		return &ValidationError{Field: "Triple.Predicate", Value: t.Predicate, Constraint: "must be an IRI"}
+	}
+	if t.Object == nil {
+		return &ValidationError{Field: "Triple.Object", Value: t.Object, Constraint: "must not be nil"}
+	}
+	if quoted, ok := t.Subject.(Triple); ok {
+		if err := quoted.validate(); err != nil {
+			return err
+		}
+	}
+	if quoted, ok := t.Object.(Triple); ok {
+		return quoted.validate()
+	}
+	return nil
+}
+
+// Quad is an RDF triple in a graph, for building the data passed to methods such as [TransactionService.Add]
+// with [WriteNQuads].
+type Quad struct {
+	Subject   Term
+	Predicate Term
+	Object    Term
+	// The graph of the triple, an IRI or blank node. The default graph if nil.
+	Graph Term
+}
+
+// String returns the quad as an N-Quads statement, without the line break.
+func (q Quad) String() string {
+	statement := fmt.Sprintf("%s %s %s", termString(q.Subject), termString(q.Predicate), termString(q.Object))
+	if q.Graph != nil {
+		statement += " " + q.Graph.String()
+	}
+	return statement + " ."
+}
+
+// WriteNQuads writes quads to w in the N-Quads format ([RDFFormatNQuads]). An error is returned, before
+// anything is written, if a term isn't allowed in its position, e.g. a literal subject. Quoted triples are
+// written in the N-Quads-star syntax; use [WriteTrigStar] for data sent to a server as [RDFFormatTrigStar].
+func WriteNQuads(w io.Writer, quads ...Quad) error {
+	var b strings.Builder
+	for _, q := range quads {
+		if err := q.validate(); err != nil {
+			return err
+		}
+		b.WriteString(q.String())
+		b.WriteByte('\n')
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteTrigStar writes quads to w in the TriG-star format ([RDFFormatTrigStar]), which databases with edge
+// properties enabled read, so quads with quoted triples can be passed to methods such as [TransactionService.Add].
+// Quads in the default graph are written as triples and the others in a graph block each. An error is returned,
+// before anything is written, if a term isn't allowed in its position.
+func WriteTrigStar(w io.Writer, quads ...Quad) error {
+	var b strings.Builder
+	for _, q := range quads {
+		if err := q.validate(); err != nil {
+			return err
+		}
+		triple := fmt.Sprintf("%s %s %s .", q.Subject, q.Predicate, q.Object)
+		if q.Graph != nil {
+			triple = fmt.Sprintf("%s { %s }", q.Graph, triple)
+		}
+		b.WriteString(triple)
+		b.WriteByte('\n')
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// validate returns a ValidationError if a term of the quad isn't allowed in its position.
+func (q Quad) validate() error {
+	if err := (Triple{Subject: q.Subject, Predicate: q.Predicate, Object: q.Object}).validate(); err != nil {
+		return err
+	}
+	switch q.Graph.(type) {
+	case nil, IRI, BNode:
+		return nil
+	}
+	return &ValidationError{Field: "Quad.Graph", Value: q.Graph, Constraint: "must be an IRI, BNode or nil"}
+}
+
+// termString returns the syntax of a term, which may be nil.
+func termString(t Term) string {
+	if t == nil {
+		return "<nil>"
+	}
+	return t.String()
+}
+
+// Term returns the RDF term the BindingValue represents.
+func (t BindingValue) Term() (Term, error) {
+	switch t.Type {
+	case "uri":
+		return IRI(t.Value), nil
+	case "bnode":
+		return BNode(t.Value), nil
+	case "literal", "typed-literal":
+		if t.Lang != "" {
+			return Literal{Value: t.Value, Lang: t.Lang}, nil
+		}
+		return Literal{Value: t.Value, Datatype: IRI(t.Datatype)}, nil
+	case "triple":
+		if t.Triple == nil {
+			return nil, fmt.Errorf("unable to convert a quoted triple without its terms")
+		}
+		var terms [3]Term
+		for i, value := range []BindingValue{t.Triple.Subject, t.Triple.Predicate, t.Triple.Object} {
+			term, err := value.Term()
+			if err != nil {
+				return nil, err
+			}
+			terms[i] = term
+		}
+		return Triple{Subject: terms[0], Predicate: terms[1], Object: terms[2]}, nil
+	default:
+		return nil, fmt.Errorf("unable to convert a term of type %q", t.Type)
+	}
+}
@@ -0,0 +1,147 @@
+package stardog
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTerm_String(t *testing.T) {
+	tests := []struct {
+		term Term
+		want string
+	}{
+		{IRI("http://example.com/a b>"), "<http://example.com/a%20b%3E>"},
+		{BNode("b1"), "_:b1"},
+		{BNode("a b."), "_:a_b_"},
+		{BNode(""), "_:b"},
+		{Literal{Value: `say "hi"` + "\n"}, `"say \"hi\"\n"`},
+		{Literal{Value: "chat", Lang: "fr"}, `"chat"@fr`},
+		{Literal{Value: "chat", Lang: "en-GB } DROP ALL"}, `"chat"@en-GBDROPALL`},
+		{Literal{Value: "1", Datatype: xsdNamespace + "integer"}, `"1"^^<http://www.w3.org/2001/XMLSchema#integer>`},
+		{Literal{Value: "s", Datatype: xsdNamespace + "string"}, `"s"`},
+		{Triple{Subject: IRI("urn:s"), Predicate: IRI("urn:p"), Object: Literal{Value: "o"}}, `<< <urn:s> <urn:p> "o" >>`},
+	}
+	for _, tt := range tests {
+		if got := tt.term.String(); got != tt.want {
+			t.Errorf("%#v.String() = %s, want %s", tt.term, got, tt.want)
+		}
+	}
+}
+
+func TestFormatValue_term(t *testing.T) {
+	got, err := FormatValue(Literal{Value: "chat", Lang: "fr"})
+	if err != nil {
+		t.Fatalf("FormatValue returned error: %v", err)
+	}
+	if want := `"chat"@fr`; got != want {
+		t.Errorf("FormatValue = %s, want %s", got, want)
+	}
+
+	_, err = FormatValue(Triple{Subject: Literal{Value: "s"}, Predicate: IRI("urn:p"), Object: IRI("urn:o")})
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Errorf("FormatValue error = %v, want *ValidationError", err)
+	}
+}
+
+func TestWriteNQuads(t *testing.T) {
+	var b strings.Builder
+	err := WriteNQuads(&b,
+		Quad{Subject: IRI("urn:s"), Predicate: IRI("urn:p"), Object: Literal{Value: "o"}},
+		Quad{Subject: BNode("b1"), Predicate: IRI("urn:p"), Object: IRI("urn:o"), Graph: IRI("urn:g")},
+		Quad{Subject: Triple{Subject: IRI("urn:s"), Predicate: IRI("urn:p"), Object: IRI("urn:o")}, Predicate: IRI("urn:certainty"), Object: Literal{Value: "0.9", Datatype: xsdNamespace + "decimal"}},
+	)
+	if err != nil {
+		t.Fatalf("WriteNQuads returned error: %v", err)
+	}
+	want := `<urn:s> <urn:p> "o" .
+_:b1 <urn:p> <urn:o> <urn:g> .
+<< <urn:s> <urn:p> <urn:o> >> <urn:certainty> "0.9"^^<http://www.w3.org/2001/XMLSchema#decimal> .
+`
+	if got := b.String(); got != want {
+		t.Errorf("WriteNQuads wrote %q, want %q", got, want)
+	}
+
+	invalid := []Quad{
+		{Subject: Literal{Value: "s"}, Predicate: IRI("urn:p"), Object: IRI("urn:o")},
+		{Subject: IRI("urn:s"), Predicate: BNode("p"), Object: IRI("urn:o")},
+		{Subject: IRI("urn:s"), Predicate: IRI("urn:p")},
+		{Subject: IRI("urn:s"), Predicate: IRI("urn:p"), Object: IRI("urn:o"), Graph: Literal{Value: "g"}},
+		{Subject: Triple{Subject: IRI("urn:s"), Predicate: Literal{}, Object: IRI("urn:o")}, Predicate: IRI("urn:p"), Object: IRI("urn:o")},
+	}
+	for _, q := range invalid {
+		b.Reset()
+		var validationErr *ValidationError
+		if err := WriteNQuads(&b, q); !errors.As(err, &validationErr) {
+			t.Errorf("WriteNQuads(%v) error = %v, want *ValidationError", q, err)
+		}
+		if b.Len() != 0 {
+			t.Errorf("WriteNQuads(%v) wrote %q, want nothing", q, b.String())
+		}
+	}
+}
+
+func TestWriteTrigStar(t *testing.T) {
+	var b strings.Builder
+	edge := Triple{Subject: IRI("urn:paul"), Predicate: IRI("urn:memberOf"), Object: IRI("urn:beatles")}
+	err := WriteTrigStar(&b,
+		Quad{Subject: edge, Predicate: IRI("urn:since"), Object: Literal{Value: "1960", Datatype: xsdNamespace + "integer"}},
+		Quad{Subject: BNode("b1"), Predicate: IRI("urn:p"), Object: edge, Graph: IRI("urn:g")},
+	)
+	if err != nil {
+		t.Fatalf("WriteTrigStar returned error: %v", err)
+	}
+	want := `<< <urn:paul> <urn:memberOf> <urn:beatles> >> <urn:since> "1960"^^<http://www.w3.org/2001/XMLSchema#integer> .
+<urn:g> { _:b1 <urn:p> << <urn:paul> <urn:memberOf> <urn:beatles> >> . }
+`
+	if got := b.String(); got != want {
+		t.Errorf("WriteTrigStar wrote %q, want %q", got, want)
+	}
+
+	b.Reset()
+	var validationErr *ValidationError
+	if err := WriteTrigStar(&b, Quad{Subject: edge, Predicate: IRI("urn:p"), Object: IRI("urn:o"), Graph: edge}); !errors.As(err, &validationErr) {
+		t.Errorf("WriteTrigStar error = %v, want *ValidationError", err)
+	}
+	if b.Len() != 0 {
+		t.Errorf("WriteTrigStar wrote %q, want nothing", b.String())
+	}
+}
+
+func TestBindingValue_Term(t *testing.T) {
+	tests := []struct {
+		value BindingValue
+		want  Term
+	}{
+		{BindingValue{Type: "uri", Value: "urn:a"}, IRI("urn:a")},
+		{BindingValue{Type: "bnode", Value: "b0"}, BNode("b0")},
+		{BindingValue{Type: "literal", Value: "chat", Lang: "fr", Datatype: "ignored"}, Literal{Value: "chat", Lang: "fr"}},
+		{BindingValue{Type: "literal", Value: "1", Datatype: xsdNamespace + "integer"}, Literal{Value: "1", Datatype: xsdNamespace + "integer"}},
+		{
+			BindingValue{Type: "triple", Triple: &QuotedTriple{
+				Subject:   BindingValue{Type: "uri", Value: "urn:s"},
+				Predicate: BindingValue{Type: "uri", Value: "urn:p"},
+				Object:    BindingValue{Type: "literal", Value: "o"},
+			}},
+			Triple{Subject: IRI("urn:s"), Predicate: IRI("urn:p"), Object: Literal{Value: "o"}},
+		},
+	}
+	for _, tt := range tests {
+		got, err := tt.value.Term()
+		if err != nil {
+			t.Fatalf("BindingValue.Term returned error: %v", err)
+		}
+		if !cmp.Equal(got, tt.want) {
+			t.Errorf("BindingValue.Term = %#v, want %#v", got, tt.want)
+		}
+	}
+
+	for _, value := range []BindingValue{{Type: "triple"}, {Type: "unknown"}} {
+		if _, err := value.Term(); err == nil {
+			t.Errorf("BindingValue.Term of %+v returned no error", value)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package stardog
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ReasoningService handles communication with the [reasoning] related methods of the Stardog API.
+//
+// [reasoning]: https://docs.stardog.com/inference-engine
+type ReasoningService service
+
+// databaseOptionReasoningSchemas is the database configuration option holding the named reasoning schemas
+// of a database as a list of "name=graph" entries.
+const databaseOptionReasoningSchemas = "reasoning.schemas"
+
+// ConsistencyOptions specifies the optional parameters to the [ReasoningService.IsConsistent] method
+type ConsistencyOptions struct {
+	// The named graph to check the consistency of
+	NamedGraph string `url:"graph-uri,omitempty"`
+	// The name of the reasoning schema to use
+	Schema string `url:"schema,omitempty"`
+}
+
+// ExplainInferenceOptions specifies the optional parameters to the [ReasoningService.Explain] method
+type ExplainInferenceOptions struct {
+	// Return all proofs of the inference instead of only the first one
+	Multiple bool `url:"multiple,omitempty"`
+	// The name of the reasoning schema to use
+	Schema string `url:"schema,omitempty"`
+	// The transaction ID
+	TxID string `url:"txid,omitempty"`
+}
+
+// Proof is a node of a proof tree returned by [ReasoningService.Explain]. The children of a
+// proof are the premises the expression was inferred from.
+type Proof struct {
+	// The axiom or triple proved by this node
+	Expression string `json:"expression"`
+	// Whether the expression was asserted or inferred (e.g. "ASSERTED", "INFERRED")
+	Status string `json:"status"`
+	// The premises of the expression
+	Children []Proof `json:"children,omitempty"`
+}
+
+// response for Explain
+type explainInferenceResponse struct {
+	Proofs []Proof `json:"proofs"`
+}
+
+// IsConsistent checks whether the database (or a named graph in it) is logically consistent
+// with respect to its reasoning schema.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Reasoning/operation/isConsistent
+func (s *ReasoningService) IsConsistent(ctx context.Context, database string, opts *ConsistencyOptions) (*bool, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("%s/reasoning/consistency", database)
+	urlWithOptions, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	headerOpts := requestHeaderOptions{
+		Accept: mediaTypeBoolean,
+	}
+	req, err := s.client.NewRequest(http.MethodGet, urlWithOptions, &headerOpts, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var buf bytes.Buffer
+	resp, err := s.client.Do(ctx, req, &buf)
+	if err != nil {
+		return nil, resp, err
+	}
+	consistent, err := strconv.ParseBool(strings.TrimSpace(buf.String()))
+	if err != nil {
+		return nil, resp, err
+	}
+	return &consistent, resp, nil
+}
+
+// Explain returns the proofs of the inferred triples, serialized in the provided RDF format.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Reasoning/operation/explainInference
+func (s *ReasoningService) Explain(ctx context.Context, database string, triples io.Reader, format RDFFormat, opts *ExplainInferenceOptions) ([]Proof, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
+	if !format.Valid() {
+		return nil, nil, &ValidationError{Field: "format", Value: format, Constraint: "must be a known value"}
+	}
+	if triples == nil {
+		return nil, nil, &ValidationError{Field: "triples", Value: triples, Constraint: "must not be nil"}
+	}
+	var body bytes.Buffer
+	if _, err := body.ReadFrom(triples); err != nil {
+		return nil, nil, err
+	}
+
+	u := fmt.Sprintf("%s/reasoning/explain", database)
+	urlWithOptions, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	headerOpts := requestHeaderOptions{
+		ContentType: format.String(),
+		Accept:      mediaTypeApplicationJSON,
+	}
+	req, err := s.client.NewRequest(http.MethodPost, urlWithOptions, &headerOpts, &body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var explainInferenceResponse explainInferenceResponse
+	resp, err := s.client.Do(ctx, req, &explainInferenceResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+	return explainInferenceResponse.Proofs, resp, nil
+}
+
+// ListSchemas returns the named reasoning schemas of the database, mapping each schema name to the
+// graphs that make up the schema. The schemas are read from the "reasoning.schemas" database option,
+// so admin rights on the database are required.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/getDatabaseOptions
+func (s *ReasoningService) ListSchemas(ctx context.Context, database string) (map[string][]string, *Response, error) {
+	metadata, resp, err := s.client.DatabaseAdmin.Metadata(ctx, database, []string{databaseOptionReasoningSchemas})
+	if err != nil {
+		return nil, resp, err
+	}
+	schemas, err := parseReasoningSchemas(metadata[databaseOptionReasoningSchemas])
+	if err != nil {
+		return nil, resp, err
+	}
+	return schemas, resp, nil
+}
+
+// AddSchema adds a named reasoning schema made up of the graphs to the database, replacing
+// any existing schema with the same name.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/setDatabaseOption
+func (s *ReasoningService) AddSchema(ctx context.Context, database string, name string, graphs []string) (*Response, error) {
+	if name == "" || strings.Contains(name, "=") {
+		return nil, &ValidationError{Field: "name", Value: name, Constraint: "must not be empty or contain '='"}
+	}
+	if len(graphs) == 0 {
+		return nil, &ValidationError{Field: "graphs", Value: graphs, Constraint: "must not be empty"}
+	}
+	return s.updateSchemas(ctx, database, func(schemas map[string][]string) {
+		schemas[name] = graphs
+	})
+}
+
+// RemoveSchema removes a named reasoning schema from the database.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/setDatabaseOption
+func (s *ReasoningService) RemoveSchema(ctx context.Context, database string, name string) (*Response, error) {
+	return s.updateSchemas(ctx, database, func(schemas map[string][]string) {
+		delete(schemas, name)
+	})
+}
+
+// updateSchemas reads the reasoning schemas of the database, applies update and writes them back.
+func (s *ReasoningService) updateSchemas(ctx context.Context, database string, update func(map[string][]string)) (*Response, error) {
+	schemas, resp, err := s.ListSchemas(ctx, database)
+	if err != nil {
+		return resp, err
+	}
+	update(schemas)
+	return s.client.DatabaseAdmin.SetMetadata(ctx, database, map[string]any{
+		databaseOptionReasoningSchemas: formatReasoningSchemas(schemas),
+	})
+}
+
+// parseReasoningSchemas parses the value of the "reasoning.schemas" database option, which is
+// a list of "name=graph" entries (or a single comma separated string of them).
+func parseReasoningSchemas(value any) (map[string][]string, error) {
+	var entries []string
+	switch v := value.(type) {
+	case nil:
+	case string:
+		if v != "" {
+			entries = strings.Split(v, ",")
+		}
+	case []any:
+		for _, entry := range v {
+			s, ok := entry.(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected %s entry %#v", databaseOptionReasoningSchemas, entry)
+			}
+			entries = append(entries, s)
+		}
+	default:
+		return nil, fmt.Errorf("unexpected %s value %#v", databaseOptionReasoningSchemas, value)
+	}
+
+	schemas := make(map[string][]string)
+	for _, entry := range entries {
+		name, graph, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok {
+			return nil, fmt.Errorf("unexpected %s entry %q", databaseOptionReasoningSchemas, entry)
+		}
+		schemas[name] = append(schemas[name], graph)
+	}
+	return schemas, nil
+}
+
+// formatReasoningSchemas formats schemas as the value of the "reasoning.schemas" database option.
+func formatReasoningSchemas(schemas map[string][]string) []string {
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	entries := []string{}
+	for _, name := range names {
+		for _, graph := range schemas[name] {
+			entries = append(entries, name+"="+graph)
+		}
+	}
+	return entries
+}
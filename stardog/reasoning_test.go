@@ -0,0 +1,188 @@
+package stardog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestReasoningService_IsConsistent(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	mux.HandleFunc(fmt.Sprintf("/%s/reasoning/consistency", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeBoolean)
+		if got, want := r.URL.Query().Get("graph-uri"), "urn:graph:people"; got != want {
+			t.Errorf("graph-uri = %q, want %q", got, want)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("true"))
+	})
+
+	ctx := context.Background()
+	opts := &ConsistencyOptions{NamedGraph: "urn:graph:people"}
+	got, _, err := client.Reasoning.IsConsistent(ctx, db, opts)
+	if err != nil {
+		t.Errorf("Reasoning.IsConsistent returned error: %v", err)
+	}
+	if want := newTrue(); !cmp.Equal(got, want) {
+		t.Errorf("Reasoning.IsConsistent = %+v, want %+v", got, want)
+	}
+
+	const methodName = "IsConsistent"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Reasoning.IsConsistent(ctx, "", opts)
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Reasoning.IsConsistent(nil, db, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestReasoningService_Explain(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	triple := `<urn:paul> a <urn:Person> .`
+	responseJSON := `{"proofs": [{
+  "expression": "paul a Person",
+  "status": "INFERRED",
+  "children": [
+    {"expression": "Musician subClassOf Person", "status": "ASSERTED"},
+    {"expression": "paul a Musician", "status": "ASSERTED"}
+  ]
+}]}`
+	mux.HandleFunc(fmt.Sprintf("/%s/reasoning/explain", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", RDFFormatTurtle.String())
+		testHeader(t, r, "Accept", mediaTypeApplicationJSON)
+		testBody(t, r, triple)
+		if got, want := r.URL.Query().Get("multiple"), "true"; got != want {
+			t.Errorf("multiple = %q, want %q", got, want)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(responseJSON))
+	})
+
+	ctx := context.Background()
+	opts := &ExplainInferenceOptions{Multiple: true}
+	got, _, err := client.Reasoning.Explain(ctx, db, strings.NewReader(triple), RDFFormatTurtle, opts)
+	if err != nil {
+		t.Errorf("Reasoning.Explain returned error: %v", err)
+	}
+	want := []Proof{{
+		Expression: "paul a Person",
+		Status:     "INFERRED",
+		Children: []Proof{
+			{Expression: "Musician subClassOf Person", Status: "ASSERTED"},
+			{Expression: "paul a Musician", Status: "ASSERTED"},
+		},
+	}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Reasoning.Explain = %+v, want %+v", got, want)
+	}
+
+	const methodName = "Explain"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Reasoning.Explain(ctx, db, strings.NewReader(triple), RDFFormatUnknown, opts)
+		return err
+	})
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Reasoning.Explain(ctx, db, nil, RDFFormatTurtle, opts)
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Reasoning.Explain(nil, db, strings.NewReader(triple), RDFFormatTurtle, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestReasoningService_Schemas(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	stored := []string{"people=urn:graph:people", "music=urn:graph:bands", "music=urn:graph:songs"}
+	mux.HandleFunc(fmt.Sprintf("/admin/databases/%s/options", db), func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(map[string]any{databaseOptionReasoningSchemas: stored})
+		case http.MethodPost:
+			var v map[string][]string
+			json.NewDecoder(r.Body).Decode(&v)
+			stored = v[databaseOptionReasoningSchemas]
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Reasoning.ListSchemas(ctx, db)
+	if err != nil {
+		t.Errorf("Reasoning.ListSchemas returned error: %v", err)
+	}
+	want := map[string][]string{
+		"people": {"urn:graph:people"},
+		"music":  {"urn:graph:bands", "urn:graph:songs"},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Reasoning.ListSchemas = %+v, want %+v", got, want)
+	}
+
+	if _, err := client.Reasoning.AddSchema(ctx, db, "movies", []string{"urn:graph:movies"}); err != nil {
+		t.Errorf("Reasoning.AddSchema returned error: %v", err)
+	}
+	if _, err := client.Reasoning.RemoveSchema(ctx, db, "people"); err != nil {
+		t.Errorf("Reasoning.RemoveSchema returned error: %v", err)
+	}
+	wantStored := []string{"movies=urn:graph:movies", "music=urn:graph:bands", "music=urn:graph:songs"}
+	if !cmp.Equal(stored, wantStored) {
+		t.Errorf("stored %s = %+v, want %+v", databaseOptionReasoningSchemas, stored, wantStored)
+	}
+
+	const methodName = "AddSchema"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Reasoning.AddSchema(ctx, db, "a=b", []string{"urn:g"})
+		return err
+	})
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Reasoning.AddSchema(ctx, db, "movies", nil)
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Reasoning.AddSchema(nil, db, "movies", []string{"urn:graph:movies"})
+	})
+}
+
+func Test_parseReasoningSchemas(t *testing.T) {
+	got, err := parseReasoningSchemas("a=urn:g1, b=urn:g2")
+	if err != nil {
+		t.Errorf("parseReasoningSchemas returned error: %v", err)
+	}
+	if want := map[string][]string{"a": {"urn:g1"}, "b": {"urn:g2"}}; !cmp.Equal(got, want) {
+		t.Errorf("parseReasoningSchemas = %+v, want %+v", got, want)
+	}
+
+	for _, value := range []any{"no-equals", []any{1}, 1.5} {
+		if _, err := parseReasoningSchemas(value); err == nil {
+			t.Errorf("parseReasoningSchemas(%#v) err = nil, want error", value)
+		}
+	}
+}
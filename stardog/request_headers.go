@@ -0,0 +1,87 @@
+package stardog
+
+import (
+	"context"
+	"net/http"
+)
+
+// RequestPriority is the urgency of a request, sent as an [RFC 9218] Priority header so that proxies,
+// load balancers and gateways in front of Stardog that support workload management can favor interactive
+// requests over batch jobs. Stardog itself does not act on the header.
+// The zero value for a RequestPriority is [RequestPriorityUnknown], which sends no header.
+//
+// [RFC 9218]: https://www.rfc-editor.org/rfc/rfc9218
+type RequestPriority int
+
+// All available values for [RequestPriority]
+const (
+	RequestPriorityUnknown RequestPriority = iota
+	// For interactive requests, such as dashboard queries
+	RequestPriorityHigh
+	// The default priority of requests
+	RequestPriorityNormal
+	// For batch jobs that can wait
+	RequestPriorityLow
+)
+
+// requestPriorityValues maps each RequestPriority to its RFC 9218 urgency
+var requestPriorityValues = [4]string{
+	RequestPriorityUnknown: "",
+	RequestPriorityHigh:    "u=1",
+	RequestPriorityNormal:  "u=3",
+	RequestPriorityLow:     "u=6",
+}
+
+// Valid returns if a given RequestPriority is known (valid) or not.
+func (p RequestPriority) Valid() bool {
+	return !(p <= RequestPriorityUnknown || int(p) >= len(requestPriorityValues))
+}
+
+// String will return the value of the Priority header for the RequestPriority
+func (p RequestPriority) String() string {
+	if !p.Valid() {
+		return requestPriorityValues[RequestPriorityUnknown]
+	}
+	return requestPriorityValues[p]
+}
+
+type requestHeadersKey struct{}
+
+// WithRequestHeader returns a copy of ctx that makes every request sent with it carry the header,
+// overriding any value the client would otherwise set. Calls can be chained to add several headers.
+func WithRequestHeader(ctx context.Context, key string, value string) context.Context {
+	headers := make(http.Header)
+	if parent, ok := ctx.Value(requestHeadersKey{}).(http.Header); ok {
+		headers = parent.Clone()
+	}
+	headers.Set(key, value)
+	return context.WithValue(ctx, requestHeadersKey{}, headers)
+}
+
+// WithPriority returns a copy of ctx that makes every request sent with it carry the priority. For example,
+// a batch job can mark all of its requests as low priority:
+//
+//	ctx = stardog.WithPriority(ctx, stardog.RequestPriorityLow)
+func WithPriority(ctx context.Context, priority RequestPriority) context.Context {
+	if !priority.Valid() {
+		return ctx
+	}
+	return WithRequestHeader(ctx, "Priority", priority.String())
+}
+
+// withContextHeaders returns the request with the headers added to ctx by WithRequestHeader.
+// The request's headers are copied first so the caller's request is not modified.
+func withContextHeaders(ctx context.Context, req *http.Request) *http.Request {
+	headers, ok := ctx.Value(requestHeadersKey{}).(http.Header)
+	if !ok {
+		return req
+	}
+	req.Header = req.Header.Clone()
+	if req.Header == nil {
+		req.Header = make(http.Header)
+	}
+	for key, values := range headers {
+		req.Header[key] = values
+	}
+	return req
+}
@@ -0,0 +1,73 @@
+package stardog
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestWithPriority(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var gotPriority, gotJob string
+	mux.HandleFunc("/admin/alive", func(w http.ResponseWriter, r *http.Request) {
+		gotPriority = r.Header.Get("Priority")
+		gotJob = r.Header.Get("X-Job")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := WithRequestHeader(context.Background(), "X-Job", "nightly-export")
+	ctx = WithPriority(ctx, RequestPriorityLow)
+	if _, _, err := client.ServerAdmin.IsAlive(ctx); err != nil {
+		t.Fatalf("ServerAdmin.IsAlive returned error: %v", err)
+	}
+	if gotPriority != "u=6" || gotJob != "nightly-export" {
+		t.Errorf("headers = Priority: %q, X-Job: %q, want u=6 and nightly-export", gotPriority, gotJob)
+	}
+
+	// a derived context overrides the priority without affecting its parent
+	if _, _, err := client.ServerAdmin.IsAlive(WithPriority(ctx, RequestPriorityHigh)); err != nil {
+		t.Fatalf("ServerAdmin.IsAlive returned error: %v", err)
+	}
+	if gotPriority != "u=1" {
+		t.Errorf("Priority header = %q, want u=1", gotPriority)
+	}
+	if _, _, err := client.ServerAdmin.IsAlive(ctx); err != nil {
+		t.Fatalf("ServerAdmin.IsAlive returned error: %v", err)
+	}
+	if gotPriority != "u=6" {
+		t.Errorf("Priority header = %q, want u=6", gotPriority)
+	}
+
+	if _, _, err := client.ServerAdmin.IsAlive(WithPriority(context.Background(), RequestPriorityUnknown)); err != nil {
+		t.Fatalf("ServerAdmin.IsAlive returned error: %v", err)
+	}
+	if gotPriority != "" {
+		t.Errorf("Priority header = %q, want none", gotPriority)
+	}
+}
+
+func Test_withContextHeaders_doesNotModifyRequest(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost:5820/admin/alive", nil)
+	req.Header.Set("Accept", "text/plain")
+	ctx := WithPriority(context.Background(), RequestPriorityNormal)
+
+	got := withContextHeaders(ctx, req.WithContext(ctx))
+	if got.Header.Get("Priority") != "u=3" || got.Header.Get("Accept") != "text/plain" {
+		t.Errorf("withContextHeaders headers = %v, want Priority and Accept", got.Header)
+	}
+	if req.Header.Get("Priority") != "" {
+		t.Errorf("withContextHeaders modified the original request")
+	}
+}
+
+func TestRequestPriority_Valid(t *testing.T) {
+	p := RequestPriority(100)
+	if p.Valid() {
+		t.Errorf("should be an invalid RequestPriority")
+	}
+	if p.String() != RequestPriorityUnknown.String() {
+		t.Errorf("RequestPriority string value should be unknown")
+	}
+}
@@ -0,0 +1,143 @@
+package stardog
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy configures how a [Client] retries requests that fail with a transient error.
+//
+// Only idempotent requests (GET, HEAD, OPTIONS, PUT and DELETE) are retried, and only when they fail with a
+// network error or a 429, 502, 503 or 504 response. Requests whose body can't be sent again are not retried,
+// and neither are requests to endpoints that change data whatever their method, such as SPARQL updates (which
+// are sent as GET), transactions, and copying or renaming databases.
+type RetryPolicy struct {
+	// The maximum number of times a request is retried
+	MaxRetries int
+	// How long to wait before the first retry. The wait doubles for every retry after that.
+	// If a response has a Retry-After header, its value is used instead.
+	Backoff time.Duration
+	// The longest to wait before a retry, including waits requested with Retry-After (default 30 seconds)
+	MaxBackoff time.Duration
+}
+
+const defaultMaxBackoff = 30 * time.Second
+
+// retryableStatusCodes are the response status codes that indicate a transient error
+var retryableStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+// idempotentMethods are the request methods that are safe to retry
+var idempotentMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	http.MethodPut:     true,
+	http.MethodDelete:  true,
+}
+
+// nonIdempotentEndpoints are the last path segments of endpoints that aren't safe to retry even though they're
+// requested with an idempotent method: "update" for SPARQL updates, in or out of a transaction, and "copy" and
+// "rename" for the database admin endpoints, which fail if they already succeeded.
+var nonIdempotentEndpoints = map[string]bool{
+	"update": true,
+	"copy":   true,
+	"rename": true,
+}
+
+// isIdempotent reports whether the request can safely be sent more than once.
+func isIdempotent(req *http.Request) bool {
+	if !idempotentMethods[req.Method] || strings.Contains(req.URL.Path, "/transaction/") {
+		return false
+	}
+	return !nonIdempotentEndpoints[path.Base(req.URL.Path)]
+}
+
+// retryDelay reports whether a request should be retried after the attempt (counting from 0) failed
+// with resp or err, and how long to wait before retrying.
+func (p *RetryPolicy) retryDelay(ctx context.Context, req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxRetries || ctx.Err() != nil || !isIdempotent(req) {
+		return 0, false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return 0, false
+	}
+	if err == nil && !retryableStatusCodes[resp.StatusCode] {
+		return 0, false
+	}
+
+	maxBackoff := p.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMaxBackoff
+	}
+	delay := maxBackoff
+	// avoid overflowing the shift for very large MaxRetries
+	if backoff := p.Backoff << attempt; attempt < 32 && backoff >= 0 && backoff < maxBackoff {
+		delay = backoff
+	}
+	if resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			delay = retryAfter
+			if delay > maxBackoff {
+				delay = maxBackoff
+			}
+		}
+	}
+	return delay, true
+}
+
+// parseRetryAfter parses a Retry-After header, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
+
+// prepareRetry discards the failed response and rewinds the request body so the request can be sent again.
+func prepareRetry(req *http.Request, resp *http.Response) error {
+	if resp != nil && resp.Body != nil {
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = body
+	return nil
+}
+
+// sleepContext waits for the delay, returning early with ctx.Err() if ctx is done.
+func sleepContext(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
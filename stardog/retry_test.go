@@ -0,0 +1,194 @@
+package stardog
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClient_Retry(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.Retry = &RetryPolicy{MaxRetries: 3, Backoff: time.Millisecond}
+
+	attempts := 0
+	mux.HandleFunc("/admin/users/u", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		switch attempts {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.Write([]byte(`{"username": "u"}`))
+		}
+	})
+
+	got, _, err := client.User.Get(context.Background(), "u")
+	if err != nil {
+		t.Fatalf("User.Get returned error: %v", err)
+	}
+	if got.Username == nil || *got.Username != "u" || attempts != 3 {
+		t.Errorf("User.Get = %+v after %d attempts, want u after 3", got, attempts)
+	}
+}
+
+func TestClient_Retry_exhausted(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.Retry = &RetryPolicy{MaxRetries: 2, Backoff: time.Millisecond}
+
+	attempts := 0
+	mux.HandleFunc("/admin/alive", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, resp, err := client.ServerAdmin.IsAlive(context.Background())
+	if err == nil || resp.StatusCode != http.StatusBadGateway {
+		t.Errorf("ServerAdmin.IsAlive = %v, %v, want a 502 error", resp, err)
+	}
+	if attempts != 3 {
+		t.Errorf("attempts = %d, want 3", attempts)
+	}
+}
+
+func TestClient_Retry_rewindsBody(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.Retry = &RetryPolicy{MaxRetries: 1, Backoff: time.Millisecond}
+
+	attempts := 0
+	mux.HandleFunc("/admin/users/u/enabled", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		testMethod(t, r, "PUT")
+		if body, _ := io.ReadAll(r.Body); len(body) == 0 {
+			t.Errorf("attempt %d sent an empty body", attempts)
+		}
+		if attempts == 1 {
+			w.WriteHeader(http.StatusGatewayTimeout)
+		}
+	})
+
+	if _, err := client.User.Enable(context.Background(), "u"); err != nil {
+		t.Errorf("User.Enable returned error: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("attempts = %d, want 2", attempts)
+	}
+}
+
+func TestClient_Retry_notIdempotent(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.Retry = &RetryPolicy{MaxRetries: 3, Backoff: time.Millisecond}
+
+	attempts := 0
+	mux.HandleFunc("/db1/transaction/begin", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	if _, _, err := client.Transaction.Begin(context.Background(), "db1"); err == nil {
+		t.Errorf("Transaction.Begin err = nil, want error")
+	}
+	if attempts != 1 {
+		t.Errorf("attempts = %d, want POST requests not to be retried", attempts)
+	}
+}
+
+func TestClient_Retry_update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.Retry = &RetryPolicy{MaxRetries: 3, Backoff: time.Millisecond}
+
+	attempts := 0
+	mux.HandleFunc("/db1/update", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	mux.HandleFunc("/admin/databases/db1/copy", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Sparql.Update(ctx, "db1", "INSERT DATA { <urn:s> <urn:p> <urn:o> }", nil); err == nil {
+		t.Errorf("Sparql.Update err = nil, want error")
+	}
+	if attempts != 1 {
+		t.Errorf("attempts = %d, want SPARQL updates not to be retried", attempts)
+	}
+
+	attempts = 0
+	if _, err := client.DatabaseAdmin.copy(ctx, "db1", "db2"); err == nil {
+		t.Errorf("DatabaseAdmin.copy err = nil, want error")
+	}
+	if attempts != 1 {
+		t.Errorf("attempts = %d, want copying a database not to be retried", attempts)
+	}
+}
+
+func TestClient_Retry_contextCanceled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.Retry = &RetryPolicy{MaxRetries: 3, Backoff: time.Hour}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	mux.HandleFunc("/admin/alive", func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	if _, _, err := client.ServerAdmin.IsAlive(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("ServerAdmin.IsAlive error = %v, want context.Canceled", err)
+	}
+}
+
+func TestRetryPolicy_retryDelay(t *testing.T) {
+	ctx := context.Background()
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost:5820/admin/alive", nil)
+	unavailable := func(retryAfter string) *http.Response {
+		resp := &http.Response{StatusCode: http.StatusServiceUnavailable, Header: make(http.Header)}
+		if retryAfter != "" {
+			resp.Header.Set("Retry-After", retryAfter)
+		}
+		return resp
+	}
+	policy := &RetryPolicy{MaxRetries: 10, Backoff: time.Second, MaxBackoff: 5 * time.Second}
+
+	tests := []struct {
+		name      string
+		policy    *RetryPolicy
+		resp      *http.Response
+		err       error
+		attempt   int
+		wantDelay time.Duration
+		wantRetry bool
+	}{
+		{name: "no policy", resp: unavailable("")},
+		{name: "exponential", policy: policy, resp: unavailable(""), attempt: 2, wantDelay: 4 * time.Second, wantRetry: true},
+		{name: "capped", policy: policy, resp: unavailable(""), attempt: 3, wantDelay: 5 * time.Second, wantRetry: true},
+		{name: "retry after seconds", policy: policy, resp: unavailable("2"), wantDelay: 2 * time.Second, wantRetry: true},
+		{name: "retry after capped", policy: policy, resp: unavailable("120"), wantDelay: 5 * time.Second, wantRetry: true},
+		{name: "retry after past date", policy: policy, resp: unavailable("Mon, 02 Jan 2006 15:04:05 GMT"), wantDelay: 0, wantRetry: true},
+		{name: "network error", policy: policy, err: errors.New("connection reset"), wantDelay: time.Second, wantRetry: true},
+		{name: "success", policy: policy, resp: &http.Response{StatusCode: http.StatusOK}},
+		{name: "client error", policy: policy, resp: &http.Response{StatusCode: http.StatusBadRequest}},
+		{name: "out of retries", policy: policy, resp: unavailable(""), attempt: 10},
+		{name: "huge attempt", policy: &RetryPolicy{MaxRetries: 100, Backoff: time.Second}, resp: unavailable(""), attempt: 70, wantDelay: defaultMaxBackoff, wantRetry: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, retry := tt.policy.retryDelay(ctx, req, tt.resp, tt.err, tt.attempt)
+			if delay != tt.wantDelay || retry != tt.wantRetry {
+				t.Errorf("retryDelay = %v, %v, want %v, %v", delay, retry, tt.wantDelay, tt.wantRetry)
+			}
+		})
+	}
+}
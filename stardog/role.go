@@ -43,6 +43,29 @@ type DeleteRoleOptions struct {
 	Force bool `url:"force"`
 }
 
+// RenameRoleOptions specifies the optional parameters to the [RoleService.Rename] method.
+type RenameRoleOptions struct {
+	// Delete the old role once everything has been copied to the new role.
+	// The old role is deleted even if it is still assigned to users.
+	DeleteOld bool
+}
+
+// RenameRoleSummary reports what [RoleService.Rename] did.
+type RenameRoleSummary struct {
+	// The role that was renamed
+	OldRolename string
+	// The role that was created
+	NewRolename string
+	// Whether the new role was created
+	Created bool
+	// The permissions granted to the new role
+	PermissionsCopied []Permission
+	// The users the new role was assigned to
+	UsersAssigned []string
+	// Whether the old role was deleted
+	OldRoleDeleted bool
+}
+
 // ListNames returns the names of all roles in the system
 //
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/GetRoles/operation/listRoles
@@ -83,6 +106,12 @@ func (s *RoleService) List(ctx context.Context) ([]Role, *Response, error) {
 	return listRolesResponse.Roles, resp, nil
 }
 
+// ListWithDetails returns all Roles in the system with their permissions. It's the same as [RoleService.List],
+// named to match [UserService] for code that manages users and roles alike.
+func (s *RoleService) ListWithDetails(ctx context.Context) ([]Role, *Response, error) {
+	return s.List(ctx)
+}
+
 // Create adds a role to the system.
 //
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/Roles/operation/addRole
@@ -151,6 +180,14 @@ func (s *RoleService) RevokePermission(ctx context.Context, rolename string, per
 	return s.client.Do(ctx, req, nil)
 }
 
+// UsersAssigned returns the names of the users assigned the role. It's equivalent to
+// [UserService.ListNamesAssignedRole].
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Roles/operation/getUsersWithRole
+func (s *RoleService) UsersAssigned(ctx context.Context, rolename string) ([]string, *Response, error) {
+	return s.client.User.ListNamesAssignedRole(ctx, rolename)
+}
+
 // Delete deletes the role from the system.
 //
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/Roles/operation/deleteRole
@@ -169,3 +206,53 @@ func (s *RoleService) Delete(ctx context.Context, rolename string, opts *DeleteR
 	}
 	return s.client.Do(ctx, req, nil)
 }
+
+// Rename "renames" a role. Stardog has no native rename for roles, so Rename creates a new role, grants it the old role's
+// permissions, assigns it to every user assigned the old role and, if RenameRoleOptions.DeleteOld is true,
+// deletes the old role.
+//
+// If an error occurs part way through, the returned summary reports the steps that completed so the
+// caller can finish or undo them.
+func (s *RoleService) Rename(ctx context.Context, oldRolename string, newRolename string, opts *RenameRoleOptions) (*RenameRoleSummary, *Response, error) {
+	summary := &RenameRoleSummary{OldRolename: oldRolename, NewRolename: newRolename}
+
+	permissions, resp, err := s.Permissions(ctx, oldRolename)
+	if err != nil {
+		return summary, resp, err
+	}
+	users, resp, err := s.client.User.ListNamesAssignedRole(ctx, oldRolename)
+	if err != nil {
+		return summary, resp, err
+	}
+
+	resp, err = s.Create(ctx, newRolename)
+	if err != nil {
+		return summary, resp, err
+	}
+	summary.Created = true
+
+	for _, permission := range permissions {
+		resp, err = s.GrantPermission(ctx, newRolename, permission)
+		if err != nil {
+			return summary, resp, err
+		}
+		summary.PermissionsCopied = append(summary.PermissionsCopied, permission)
+	}
+
+	for _, user := range users {
+		resp, err = s.client.User.AssignRole(ctx, user, newRolename)
+		if err != nil {
+			return summary, resp, err
+		}
+		summary.UsersAssigned = append(summary.UsersAssigned, user)
+	}
+
+	if opts != nil && opts.DeleteOld {
+		resp, err = s.Delete(ctx, oldRolename, &DeleteRoleOptions{Force: true})
+		if err != nil {
+			return summary, resp, err
+		}
+		summary.OldRoleDeleted = true
+	}
+	return summary, resp, nil
+}
@@ -306,3 +306,142 @@ func TestRoleService_Delete(t *testing.T) {
 		return client.Role.Delete(nil, "writer", opt)
 	})
 }
+
+func TestRoleService_Rename(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	oldRolename := "reader"
+	newRolename := "viewer"
+	permission := Permission{
+		Action:       PermissionActionRead,
+		ResourceType: PermissionResourceTypeDatabase,
+		Resource:     []string{"db1"},
+	}
+	var assigned []string
+	deleted := false
+
+	mux.HandleFunc(fmt.Sprintf("/admin/permissions/role/%s", oldRolename), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"permissions": [{"action":"READ","resource_type":"db","resource":["db1"]}]}`))
+	})
+	mux.HandleFunc(fmt.Sprintf("/admin/roles/%s/users", oldRolename), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"users": ["frodo", "sam"]}`))
+	})
+	mux.HandleFunc("/admin/roles", func(w http.ResponseWriter, r *http.Request) {
+		v := new(createRoleRequest)
+		json.NewDecoder(r.Body).Decode(v)
+		testMethod(t, r, "POST")
+		if want := (&createRoleRequest{Rolename: newRolename}); !cmp.Equal(v, want) {
+			t.Errorf("Request body = %+v, want %+v", v, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+	mux.HandleFunc(fmt.Sprintf("/admin/permissions/role/%s", newRolename), func(w http.ResponseWriter, r *http.Request) {
+		v := new(Permission)
+		json.NewDecoder(r.Body).Decode(v)
+		testMethod(t, r, "PUT")
+		if !cmp.Equal(v, &permission) {
+			t.Errorf("Request body = %+v, want %+v", v, permission)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+	for _, user := range []string{"frodo", "sam"} {
+		user := user
+		mux.HandleFunc(fmt.Sprintf("/admin/users/%s/roles", user), func(w http.ResponseWriter, r *http.Request) {
+			v := new(assignRoleRequest)
+			json.NewDecoder(r.Body).Decode(v)
+			testMethod(t, r, "POST")
+			if v.Rolename != newRolename {
+				t.Errorf("assigned role = %v, want %v", v.Rolename, newRolename)
+			}
+			assigned = append(assigned, user)
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+	mux.HandleFunc(fmt.Sprintf("/admin/roles/%s", oldRolename), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		if got := r.URL.Query().Get("force"); got != "true" {
+			t.Errorf("force query parameter = %v, want true", got)
+		}
+		deleted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Role.Rename(ctx, oldRolename, newRolename, &RenameRoleOptions{DeleteOld: true})
+	if err != nil {
+		t.Errorf("Role.Rename returned error: %v", err)
+	}
+	want := &RenameRoleSummary{
+		OldRolename:       oldRolename,
+		NewRolename:       newRolename,
+		Created:           true,
+		PermissionsCopied: []Permission{permission},
+		UsersAssigned:     []string{"frodo", "sam"},
+		OldRoleDeleted:    true,
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Role.Rename = %+v, want %+v", got, want)
+	}
+	if !cmp.Equal(assigned, want.UsersAssigned) || !deleted {
+		t.Errorf("assigned = %+v, deleted = %v; want %+v, true", assigned, deleted, want.UsersAssigned)
+	}
+
+	const methodName = "Rename"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		_, resp, err := client.Role.Rename(nil, oldRolename, newRolename, nil)
+		return resp, err
+	})
+}
+
+func TestRoleService_UsersAssigned(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	rolename := "reader"
+	mux.HandleFunc(fmt.Sprintf("/admin/roles/%s/users", rolename), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeApplicationJSON)
+		w.Write([]byte(`{"users": ["admin", "charlie"]}`))
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Role.UsersAssigned(ctx, rolename)
+	if err != nil {
+		t.Errorf("Role.UsersAssigned returned error: %v", err)
+	}
+	if want := []string{"admin", "charlie"}; !cmp.Equal(got, want) {
+		t.Errorf("Role.UsersAssigned = %+v, want %+v", got, want)
+	}
+
+	const methodName = "UsersAssigned"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Role.UsersAssigned(nil, rolename)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRoleService_ListWithDetails(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/roles/list", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`{"roles": [{"rolename": "reader", "permissions": []}]}`))
+	})
+
+	got, _, err := client.Role.ListWithDetails(context.Background())
+	if err != nil {
+		t.Errorf("Role.ListWithDetails returned error: %v", err)
+	}
+	if want := []Role{{Name: "reader", Permissions: []Permission{}}}; !cmp.Equal(got, want) {
+		t.Errorf("Role.ListWithDetails = %+v, want %+v", got, want)
+	}
+}
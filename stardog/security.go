@@ -0,0 +1,222 @@
+package stardog
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// SecurityService handles communication with the authentication related methods of the Stardog API.
+type SecurityService service
+
+// Token is a JSON Web Token issued by Stardog, for use with [BearerAuthTransport].
+type Token struct {
+	// The encoded JWT
+	Token string `json:"token"`
+	// When the token was issued, from its "iat" claim. Zero if the token has no "iat" claim.
+	IssuedAt time.Time `json:"-"`
+	// When the token expires, from its "exp" claim. Zero if the token has no "exp" claim.
+	ExpiresAt time.Time `json:"-"`
+}
+
+// Expired reports whether the token expires within the leeway of now. A token without an
+// expiry never expires.
+func (t *Token) Expired(leeway time.Duration) bool {
+	if t.ExpiresAt.IsZero() {
+		return false
+	}
+	return !time.Now().Add(leeway).Before(t.ExpiresAt)
+}
+
+// jwtClaims are the registered JWT claims used for Token metadata.
+type jwtClaims struct {
+	IssuedAt  *int64 `json:"iat"`
+	ExpiresAt *int64 `json:"exp"`
+}
+
+// parseTokenClaims fills in the token's metadata from its claims. The token's signature is not
+// verified; that's up to the server it's sent to.
+func (t *Token) parseTokenClaims() error {
+	parts := strings.Split(t.Token, ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("malformed JWT: expected 3 parts, got %d", len(parts))
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return fmt.Errorf("malformed JWT payload: %w", err)
+	}
+	var claims jwtClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return fmt.Errorf("malformed JWT claims: %w", err)
+	}
+	if claims.IssuedAt != nil {
+		t.IssuedAt = time.Unix(*claims.IssuedAt, 0)
+	}
+	if claims.ExpiresAt != nil {
+		t.ExpiresAt = time.Unix(*claims.ExpiresAt, 0)
+	}
+	return nil
+}
+
+// GetToken returns a JWT for the authenticated user, such as a client configured with [BasicAuthTransport].
+// The token can be used with [BearerAuthTransport] until Token.ExpiresAt.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Users/operation/getToken
+func (s *SecurityService) GetToken(ctx context.Context) (*Token, *Response, error) {
+	u := "admin/token"
+	headerOpts := requestHeaderOptions{
+		Accept: mediaTypeApplicationJSON,
+	}
+	req, err := s.client.NewRequest(http.MethodGet, u, &headerOpts, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var token Token
+	resp, err := s.client.Do(ctx, req, &token)
+	if err != nil {
+		return nil, resp, err
+	}
+	if err := token.parseTokenClaims(); err != nil {
+		return nil, resp, err
+	}
+	return &token, resp, nil
+}
+
+// Server properties holding the password constraints
+const (
+	passwordLengthMinProperty = "password.length.min"
+	passwordLengthMaxProperty = "password.length.max"
+	passwordRegexProperty     = "password.regex"
+)
+
+// PasswordPolicy is the server's password constraint configuration, which passwords of new and
+// changed users must satisfy.
+type PasswordPolicy struct {
+	// The minimum password length. Zero if the server doesn't report one.
+	MinLength int
+	// The maximum password length. Zero if the server doesn't report one.
+	MaxLength int
+	// The (Java) regular expression passwords must match in full. Empty if the server doesn't report one.
+	Regex string
+}
+
+// Check reports whether the password satisfies the policy, returning a [ValidationError] if it doesn't.
+// The regular expression is evaluated with Go's regexp package, which supports the syntax of the
+// default policy but not every Java construct; an expression it can't compile is returned as an error.
+func (p *PasswordPolicy) Check(password string) error {
+	length := utf8.RuneCountInString(password)
+	if p.MinLength > 0 && length < p.MinLength {
+		return &ValidationError{Field: "password", Value: "<redacted>", Constraint: fmt.Sprintf("must be at least %d characters", p.MinLength)}
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		return &ValidationError{Field: "password", Value: "<redacted>", Constraint: fmt.Sprintf("must be at most %d characters", p.MaxLength)}
+	}
+	if p.Regex == "" {
+		return nil
+	}
+	re, err := regexp.Compile(`^(?:` + p.Regex + `)$`)
+	if err != nil {
+		return fmt.Errorf("password policy regex %q: %w", p.Regex, err)
+	}
+	if !re.MatchString(password) {
+		return &ValidationError{Field: "password", Value: "<redacted>", Constraint: fmt.Sprintf("must match %s", p.Regex)}
+	}
+	return nil
+}
+
+// PasswordPolicy returns the server's password constraints, read from its configuration properties.
+// The authenticated user must be able to read server properties (typically a superuser).
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Server-Admin/operation/getProperties
+func (s *SecurityService) PasswordPolicy(ctx context.Context) (*PasswordPolicy, *Response, error) {
+	properties, resp, err := s.client.ServerAdmin.GetProperties(ctx, passwordLengthMinProperty, passwordLengthMaxProperty, passwordRegexProperty)
+	if err != nil {
+		return nil, resp, err
+	}
+	policy := PasswordPolicy{Regex: properties[passwordRegexProperty]}
+	if policy.MinLength, err = parseIntProperty(properties, passwordLengthMinProperty); err != nil {
+		return nil, resp, err
+	}
+	if policy.MaxLength, err = parseIntProperty(properties, passwordLengthMaxProperty); err != nil {
+		return nil, resp, err
+	}
+	return &policy, resp, nil
+}
+
+// parseIntProperty returns the named property as an int, or zero if it's not set.
+func parseIntProperty(properties map[string]string, name string) (int, error) {
+	value, ok := properties[name]
+	if !ok || value == "" {
+		return 0, nil
+	}
+	i, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("server property %s: %w", name, err)
+	}
+	return i, nil
+}
+
+// CredentialsCheck is the result of [SecurityService.ValidateCredentials].
+type CredentialsCheck struct {
+	// Whether the server accepted the credentials
+	Valid bool
+	// The authenticated user's username, if Valid
+	Username string
+}
+
+// ValidateCredentials reports whether the server accepts the username and password, without
+// changing anything, so that credentials can be verified before they're stored. It authenticates
+// a single whoami request with the credentials, using the client's server and underlying transport
+// but not its authentication.
+//
+// Rejected credentials are reported with CredentialsCheck.Valid set to false rather than as an
+// error; any other failure, such as the server being unreachable, is returned as an error.
+func (s *SecurityService) ValidateCredentials(ctx context.Context, username, password string) (*CredentialsCheck, *Response, error) {
+	httpClient := &http.Client{
+		Transport: &BasicAuthTransport{
+			Username:  username,
+			Password:  password,
+			Transport: unauthenticatedTransport(s.client.client.Transport),
+		},
+		Timeout: s.client.client.Timeout,
+	}
+	checker, err := NewClient(s.client.baseURL.String(), httpClient)
+	if err != nil {
+		return nil, nil, err
+	}
+	checker.UserAgent = s.client.UserAgent
+	whoami, resp, err := checker.User.WhoAmI(ctx)
+	if err != nil {
+		var errorResponse *ErrorResponse
+		if errors.As(err, &errorResponse) && errorResponse.Response != nil && errorResponse.Response.StatusCode == http.StatusUnauthorized {
+			return &CredentialsCheck{Valid: false}, resp, nil
+		}
+		return nil, resp, err
+	}
+	return &CredentialsCheck{Valid: true, Username: strings.TrimSpace(*whoami)}, resp, nil
+}
+
+// unauthenticatedTransport returns the transport underneath any of this package's authenticating
+// transports, so that requests can be authenticated differently.
+func unauthenticatedTransport(transport http.RoundTripper) http.RoundTripper {
+	switch t := transport.(type) {
+	case *BasicAuthTransport:
+		return t.transport()
+	case *BearerAuthTransport:
+		return t.transport()
+	case *TokenRefreshTransport:
+		return t.transport()
+	case nil:
+		return http.DefaultTransport
+	default:
+		return transport
+	}
+}
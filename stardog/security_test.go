@@ -0,0 +1,214 @@
+package stardog
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// newTestJWT returns an unsigned JWT with the claims.
+func newTestJWT(claims string) string {
+	encode := base64.RawURLEncoding.EncodeToString
+	return encode([]byte(`{"alg":"HS256","typ":"JWT"}`)) + "." + encode([]byte(claims)) + ".signature"
+}
+
+func TestSecurityService_GetToken(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	jwt := newTestJWT(`{"sub":"admin","iat":1700000000,"exp":1700003600}`)
+	mux.HandleFunc("/admin/token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeApplicationJSON)
+		w.Write([]byte(`{"token": "` + jwt + `"}`))
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Security.GetToken(ctx)
+	if err != nil {
+		t.Fatalf("Security.GetToken returned error: %v", err)
+	}
+	want := &Token{Token: jwt, IssuedAt: time.Unix(1700000000, 0), ExpiresAt: time.Unix(1700003600, 0)}
+	if got.Token != want.Token || !got.IssuedAt.Equal(want.IssuedAt) || !got.ExpiresAt.Equal(want.ExpiresAt) {
+		t.Errorf("Security.GetToken = %+v, want %+v", got, want)
+	}
+	if !got.Expired(0) {
+		t.Errorf("Token.Expired = false, want true for a token that expired in 2023")
+	}
+
+	const methodName = "GetToken"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Security.GetToken(nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityService_GetToken_malformed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"token": "not-a-jwt"}`))
+	})
+	if _, _, err := client.Security.GetToken(context.Background()); err == nil {
+		t.Errorf("Security.GetToken err = nil, want error for malformed token")
+	}
+}
+
+func TestToken_Expired(t *testing.T) {
+	if (&Token{}).Expired(time.Hour) {
+		t.Errorf("Token.Expired = true, want false for a token without an expiry")
+	}
+	token := &Token{ExpiresAt: time.Now().Add(time.Minute)}
+	if token.Expired(0) {
+		t.Errorf("Token.Expired(0) = true, want false")
+	}
+	if !token.Expired(2 * time.Minute) {
+		t.Errorf("Token.Expired(2m) = false, want true")
+	}
+
+	for _, claims := range []string{`{}`, `not json`} {
+		token := &Token{Token: newTestJWT(claims)}
+		err := token.parseTokenClaims()
+		if (claims == `{}`) != (err == nil) {
+			t.Errorf("parseTokenClaims(%s) err = %v", claims, err)
+		}
+	}
+}
+
+func TestSecurityService_PasswordPolicy(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/properties", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.Query()["name"], []string{"password.length.min", "password.length.max", "password.regex"}; !cmp.Equal(got, want) {
+			t.Errorf("name parameters = %v, want %v", got, want)
+		}
+		w.Write([]byte(`{"password.length.min": "4", "password.length.max": "20", "password.regex": "[\\w@#$%]+"}`))
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Security.PasswordPolicy(ctx)
+	if err != nil {
+		t.Fatalf("Security.PasswordPolicy returned error: %v", err)
+	}
+	want := &PasswordPolicy{MinLength: 4, MaxLength: 20, Regex: `[\w@#$%]+`}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Security.PasswordPolicy = %+v, want %+v", got, want)
+	}
+
+	const methodName = "PasswordPolicy"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Security.PasswordPolicy(nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityService_PasswordPolicy_invalidProperty(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/properties", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"password.length.min": "four"}`))
+	})
+
+	if _, _, err := client.Security.PasswordPolicy(context.Background()); err == nil {
+		t.Errorf("Security.PasswordPolicy returned nil error for a non-numeric length")
+	}
+}
+
+func TestPasswordPolicy_Check(t *testing.T) {
+	policy := &PasswordPolicy{MinLength: 4, MaxLength: 8, Regex: `[\w@#$%]+`}
+	tests := []struct {
+		password string
+		wantErr  bool
+	}{
+		{"pass", false},
+		{"p@ss#w0r", false},
+		{"abc", true},
+		{"abcdefghi", true},
+		{"pass word", true},
+	}
+	for _, tt := range tests {
+		err := policy.Check(tt.password)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("PasswordPolicy.Check(%q) = %v, wantErr %v", tt.password, err, tt.wantErr)
+		}
+		var validationErr *ValidationError
+		if err != nil && !errors.As(err, &validationErr) {
+			t.Errorf("PasswordPolicy.Check(%q) error = %T, want *ValidationError", tt.password, err)
+		}
+	}
+
+	if err := (&PasswordPolicy{}).Check(""); err != nil {
+		t.Errorf("empty PasswordPolicy.Check returned error: %v", err)
+	}
+	if err := (&PasswordPolicy{Regex: `(?<=a)b`}).Check("ab"); err == nil {
+		t.Errorf("PasswordPolicy.Check returned nil error for an unsupported regex")
+	}
+}
+
+func TestSecurityService_ValidateCredentials(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.client = (&BearerAuthTransport{BearerToken: "admin-token"}).Client()
+
+	mux.HandleFunc("/admin/status/whoami", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "frodo" || password != "mellon" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte("frodo"))
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Security.ValidateCredentials(ctx, "frodo", "mellon")
+	if err != nil {
+		t.Fatalf("Security.ValidateCredentials returned error: %v", err)
+	}
+	if want := (&CredentialsCheck{Valid: true, Username: "frodo"}); !cmp.Equal(got, want) {
+		t.Errorf("Security.ValidateCredentials = %+v, want %+v", got, want)
+	}
+
+	got, resp, err := client.Security.ValidateCredentials(ctx, "frodo", "wrong")
+	if err != nil {
+		t.Fatalf("Security.ValidateCredentials returned error for rejected credentials: %v", err)
+	}
+	if want := (&CredentialsCheck{Valid: false}); !cmp.Equal(got, want) {
+		t.Errorf("Security.ValidateCredentials = %+v, want %+v", got, want)
+	}
+	if resp == nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Security.ValidateCredentials response = %v, want status 401", resp)
+	}
+}
+
+func TestSecurityService_ValidateCredentials_serverError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/status/whoami", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	got, _, err := client.Security.ValidateCredentials(context.Background(), "frodo", "mellon")
+	if err == nil {
+		t.Errorf("Security.ValidateCredentials returned nil error for a server error")
+	}
+	if got != nil {
+		t.Errorf("Security.ValidateCredentials = %+v, want nil", got)
+	}
+}
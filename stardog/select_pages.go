@@ -0,0 +1,145 @@
+package stardog
+
+import "context"
+
+// defaultSelectPageSize is the number of results requested per page by [SPARQLService.SelectPages] if no page size is given.
+const defaultSelectPageSize = 1000
+
+// ResultIterator iterates over the solutions of a SELECT query, requesting them from the server a page at a
+// time so that large result sets can be consumed without holding them in memory. It's used like a
+// bufio.Scanner:
+//
+//	results := client.Sparql.SelectPages(ctx, db, "SELECT ?s { ?s a :Person } ORDER BY ?s", 1000, nil)
+//	for results.Next() {
+//		binding := results.Binding()
+//		// ...
+//	}
+//	if err := results.Err(); err != nil {
+//		// ...
+//	}
+type ResultIterator struct {
+	service  *SPARQLService
+	ctx      context.Context
+	database string
+	query    string
+	opts     SelectOptions
+	pageSize int
+	// the number of results left to return if the options limit them, or -1
+	remaining int
+
+	vars     []string
+	page     []Binding
+	index    int
+	lastPage bool
+	resp     *Response
+	err      error
+}
+
+// SelectPages returns an iterator over the solutions of a [SPARQL SELECT] query that requests pageSize
+// solutions at a time, with the limit and offset parameters, until a page comes back short. If pageSize
+// isn't positive, 1000 is used, and it's lowered to the Client's MaxSelectResults if that's smaller, since
+// each page is a separate query. Stardog has no server-side cursors, so each page re-runs the query: it
+// should have an ORDER BY clause so the pages are consistent, and run in a transaction (SelectOptions.TxID)
+// if the data may change while it's being read.
+//
+// SelectOptions.Offset is where the first page starts, and SelectOptions.Limit, if set, is the most solutions
+// returned across all pages. The results are requested in the [SPARQL Query Results JSON Format] by default, and
+// SelectOptions.ResultFormat, if provided, must be [QueryResultFormatSparqlResultsJSON] or
+// [QueryResultFormatSparqlStarResultsJSON].
+//
+// [SPARQL SELECT]: https://www.w3.org/TR/sparql11-query/#select
+// [SPARQL Query Results JSON Format]: https://www.w3.org/TR/sparql11-results-json/
+func (s *SPARQLService) SelectPages(ctx context.Context, database string, query string, pageSize int, opts *SelectOptions) *ResultIterator {
+	if pageSize <= 0 {
+		pageSize = defaultSelectPageSize
+	}
+	if maxResults := s.client.limitSelect(ctx, &SelectOptions{Limit: pageSize}).Limit; maxResults < pageSize {
+		pageSize = maxResults
+	}
+	it := &ResultIterator{service: s, ctx: ctx, database: database, query: query, pageSize: pageSize, remaining: -1}
+	if opts != nil {
+		it.opts = *opts
+		if opts.Limit > 0 {
+			it.remaining = opts.Limit
+		}
+	}
+	return it
+}
+
+// Next advances the iterator to the next solution, requesting the next page if needed, and reports
+// whether there is one. It returns false at the end of the results or on an error, which Err returns.
+func (it *ResultIterator) Next() bool {
+	if it.err != nil || it.remaining == 0 {
+		return false
+	}
+	if it.index+1 < len(it.page) {
+		it.index++
+		it.consume()
+		return true
+	}
+	if it.lastPage {
+		return false
+	}
+	if !it.fetch() || len(it.page) == 0 {
+		return false
+	}
+	it.consume()
+	return true
+}
+
+// consume counts the current solution against the options' limit.
+func (it *ResultIterator) consume() {
+	if it.remaining > 0 {
+		it.remaining--
+	}
+}
+
+// fetch requests the next page, reporting whether it succeeded.
+func (it *ResultIterator) fetch() bool {
+	limit := it.pageSize
+	if it.remaining > 0 && it.remaining < limit {
+		limit = it.remaining
+	}
+	opts := it.opts
+	opts.Limit = limit
+	// a short page is only the last one if it's shorter than the limit actually sent
+	limit = it.service.client.limitSelect(it.ctx, &opts).Limit
+	results, resp, err := it.service.SelectResultSet(it.ctx, it.database, it.query, &opts)
+	it.resp = resp
+	if err != nil {
+		it.err = err
+		return false
+	}
+	if it.vars == nil {
+		it.vars = results.Vars
+	}
+	it.page = results.Bindings
+	it.index = 0
+	it.opts.Offset += len(results.Bindings)
+	it.lastPage = len(results.Bindings) < limit
+	return true
+}
+
+// Binding returns the current solution. It's only valid after a call to Next returns true.
+func (it *ResultIterator) Binding() Binding {
+	if it.index >= len(it.page) {
+		return nil
+	}
+	return it.page[it.index]
+}
+
+// Vars returns the variables of the query, in the order the server reported them, once the first page
+// has been requested.
+func (it *ResultIterator) Vars() []string {
+	return it.vars
+}
+
+// Response returns the response to the most recent page request.
+func (it *ResultIterator) Response() *Response {
+	return it.resp
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ResultIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,168 @@
+package stardog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// handlePagedSelect serves a SELECT query over total solutions of ?n, honoring the limit and offset
+// parameters, and records the requested pages.
+func handlePagedSelect(t *testing.T, mux *http.ServeMux, db string, total int, pages *[]string) {
+	t.Helper()
+	mux.HandleFunc(fmt.Sprintf("/%s/query", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		*pages = append(*pages, fmt.Sprintf("%d+%d", offset, limit))
+		var bindings []string
+		for n := offset; n < total && n < offset+limit; n++ {
+			bindings = append(bindings, fmt.Sprintf(`{"n": {"type": "literal", "value": "%d"}}`, n))
+		}
+		w.Header().Set("Content-Type", mediaTypeApplicationSparqlResultsJSON)
+		fmt.Fprintf(w, `{"head": {"vars": ["n"]}, "results": {"bindings": [%s]}}`, strings.Join(bindings, ","))
+	})
+}
+
+func collectPages(t *testing.T, it *ResultIterator) []string {
+	t.Helper()
+	var got []string
+	for it.Next() {
+		got = append(got, it.Binding()["n"].Value)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("ResultIterator.Err returned error: %v", err)
+	}
+	return got
+}
+
+func TestSPARQLService_SelectPages(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	var pages []string
+	handlePagedSelect(t, mux, db, 5, &pages)
+
+	it := client.Sparql.SelectPages(context.Background(), db, "SELECT ?n {} ORDER BY ?n", 2, nil)
+	got := collectPages(t, it)
+	want := []string{"0", "1", "2", "3", "4"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("ResultIterator solutions = %v, want %v", got, want)
+	}
+	if wantPages := []string{"0+2", "2+2", "4+2"}; !cmp.Equal(pages, wantPages) {
+		t.Errorf("requested pages = %v, want %v", pages, wantPages)
+	}
+	if !cmp.Equal(it.Vars(), []string{"n"}) {
+		t.Errorf("ResultIterator.Vars = %v, want [n]", it.Vars())
+	}
+	if it.Response() == nil {
+		t.Errorf("ResultIterator.Response = nil, want the last page's response")
+	}
+	if it.Next() {
+		t.Errorf("ResultIterator.Next after the end = true, want false")
+	}
+}
+
+func TestSPARQLService_SelectPages_exactPages(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	var pages []string
+	handlePagedSelect(t, mux, db, 4, &pages)
+
+	got := collectPages(t, client.Sparql.SelectPages(context.Background(), db, "SELECT ?n {}", 2, nil))
+	if want := []string{"0", "1", "2", "3"}; !cmp.Equal(got, want) {
+		t.Errorf("ResultIterator solutions = %v, want %v", got, want)
+	}
+	if wantPages := []string{"0+2", "2+2", "4+2"}; !cmp.Equal(pages, wantPages) {
+		t.Errorf("requested pages = %v, want %v", pages, wantPages)
+	}
+}
+
+func TestSPARQLService_SelectPages_limitAndOffset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	var pages []string
+	handlePagedSelect(t, mux, db, 10, &pages)
+
+	opts := &SelectOptions{Offset: 1, Limit: 5}
+	got := collectPages(t, client.Sparql.SelectPages(context.Background(), db, "SELECT ?n {}", 2, opts))
+	if want := []string{"1", "2", "3", "4", "5"}; !cmp.Equal(got, want) {
+		t.Errorf("ResultIterator solutions = %v, want %v", got, want)
+	}
+	if wantPages := []string{"1+2", "3+2", "5+1"}; !cmp.Equal(pages, wantPages) {
+		t.Errorf("requested pages = %v, want %v", pages, wantPages)
+	}
+	if opts.Offset != 1 || opts.Limit != 5 {
+		t.Errorf("SelectPages modified the options: %+v", opts)
+	}
+}
+
+func TestSPARQLService_SelectPages_defaultPageSize(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	var pages []string
+	handlePagedSelect(t, mux, db, 3, &pages)
+
+	got := collectPages(t, client.Sparql.SelectPages(context.Background(), db, "SELECT ?n {}", 0, nil))
+	if want := []string{"0", "1", "2"}; !cmp.Equal(got, want) {
+		t.Errorf("ResultIterator solutions = %v, want %v", got, want)
+	}
+	if wantPages := []string{fmt.Sprintf("0+%d", defaultSelectPageSize)}; !cmp.Equal(pages, wantPages) {
+		t.Errorf("requested pages = %v, want %v", pages, wantPages)
+	}
+}
+
+func TestSPARQLService_SelectPages_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/db1/query", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message": "boom"}`, http.StatusInternalServerError)
+	})
+
+	it := client.Sparql.SelectPages(context.Background(), "db1", "SELECT ?n {}", 2, nil)
+	if it.Next() {
+		t.Errorf("ResultIterator.Next = true, want false")
+	}
+	if it.Err() == nil {
+		t.Errorf("ResultIterator.Err = nil, want error")
+	}
+	if it.Binding() != nil {
+		t.Errorf("ResultIterator.Binding = %v, want nil", it.Binding())
+	}
+
+	it = client.Sparql.SelectPages(context.Background(), "", "SELECT ?n {}", 2, nil)
+	if it.Next() || it.Err() == nil {
+		t.Errorf("ResultIterator with an invalid database returned no error")
+	}
+}
+
+func TestSPARQLService_SelectPages_maxSelectResults(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	var pages []string
+	handlePagedSelect(t, mux, db, 5, &pages)
+	client.MaxSelectResults = 2
+
+	got := collectPages(t, client.Sparql.SelectPages(context.Background(), db, "SELECT ?n {}", 3, nil))
+	if want := []string{"0", "1", "2", "3", "4"}; !cmp.Equal(got, want) {
+		t.Errorf("ResultIterator solutions = %v, want %v", got, want)
+	}
+	if wantPages := []string{"0+2", "2+2", "4+2"}; !cmp.Equal(pages, wantPages) {
+		t.Errorf("requested pages = %v, want %v", pages, wantPages)
+	}
+}
@@ -0,0 +1,165 @@
+package stardog
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+)
+
+// ResultSet is the result of a SPARQL SELECT query decoded from the [SPARQL Query Results JSON Format].
+//
+// [SPARQL Query Results JSON Format]: https://www.w3.org/TR/sparql11-results-json/
+type ResultSet struct {
+	// The variables of the query in the order the server reported them
+	Vars []string
+	// The solutions of the query, one per result row
+	Bindings []Binding
+}
+
+// Binding is a single solution of a SELECT query, mapping variable names to their values.
+// Variables that are unbound in the solution are absent from the map.
+type Binding map[string]BindingValue
+
+// BindingValue is an RDF term bound to a variable in the SPARQL 1.1 Query Results JSON Format.
+type BindingValue struct {
+	// The kind of term: "uri", "literal", "bnode" or "triple"
+	Type string `json:"type"`
+	// The IRI, blank node label or lexical form of a literal. Empty if Type is "triple".
+	Value string `json:"value"`
+	// The datatype IRI of a literal
+	Datatype string `json:"datatype,omitempty"`
+	// The language tag of a literal
+	Lang string `json:"xml:lang,omitempty"`
+	// The quoted triple of a term whose Type is "triple" (SPARQL-star)
+	Triple *QuotedTriple `json:"-"`
+}
+
+// QuotedTriple is a quoted triple in the SPARQL-star Query Results JSON Format.
+type QuotedTriple struct {
+	Subject   BindingValue `json:"subject"`
+	Predicate BindingValue `json:"predicate"`
+	Object    BindingValue `json:"object"`
+}
+
+// bindingValueJSON is the wire format of a BindingValue, whose value is an object rather than a string if it's a quoted triple.
+type bindingValueJSON struct {
+	Type     string          `json:"type"`
+	Value    json.RawMessage `json:"value"`
+	Datatype string          `json:"datatype,omitempty"`
+	Lang     string          `json:"xml:lang,omitempty"`
+}
+
+// UnmarshalJSON decodes a term, whose value is an object rather than a string if it's a quoted triple. Some servers
+// omit the value of a quoted triple and put its subject, predicate and object in the term itself, and a missing
+// value of any other term is decoded as empty.
+func (t *BindingValue) UnmarshalJSON(data []byte) error {
+	var term bindingValueJSON
+	if err := json.Unmarshal(data, &term); err != nil {
+		return err
+	}
+	*t = BindingValue{Type: term.Type, Datatype: term.Datatype, Lang: term.Lang}
+	if term.Type == "triple" {
+		t.Triple = &QuotedTriple{}
+		if len(term.Value) == 0 {
+			return json.Unmarshal(data, t.Triple)
+		}
+		return json.Unmarshal(term.Value, t.Triple)
+	}
+	if len(term.Value) == 0 {
+		return nil
+	}
+	return json.Unmarshal(term.Value, &t.Value)
+}
+
+// MarshalJSON encodes a term in the SPARQL-star Query Results JSON Format.
+func (t BindingValue) MarshalJSON() ([]byte, error) {
+	var value any = t.Value
+	if t.Type == "triple" {
+		value = t.Triple
+	}
+	encodedValue, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(bindingValueJSON{Type: t.Type, Value: encodedValue, Datatype: t.Datatype, Lang: t.Lang})
+}
+
+// resultSetJSON is the wire format of a ResultSet.
+type resultSetJSON struct {
+	Head struct {
+		Vars []string `json:"vars"`
+	} `json:"head"`
+	Results struct {
+		Bindings []Binding `json:"bindings"`
+	} `json:"results"`
+}
+
+// UnmarshalJSON decodes a ResultSet from the SPARQL Query Results JSON Format.
+func (r *ResultSet) UnmarshalJSON(data []byte) error {
+	var decoded resultSetJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*r = ResultSet{Vars: decoded.Head.Vars, Bindings: decoded.Results.Bindings}
+	return nil
+}
+
+// MarshalJSON encodes a ResultSet in the SPARQL Query Results JSON Format.
+func (r ResultSet) MarshalJSON() ([]byte, error) {
+	var encoded resultSetJSON
+	encoded.Head.Vars = r.Vars
+	encoded.Results.Bindings = r.Bindings
+	return json.Marshal(encoded)
+}
+
+// DecodeResultSet decodes SELECT query results in the [SPARQL Query Results JSON Format], such as those
+// returned by [SPARQLService.Select] when no SelectOptions.ResultFormat is provided.
+//
+// [SPARQL Query Results JSON Format]: https://www.w3.org/TR/sparql11-results-json/
+func DecodeResultSet(r io.Reader) (*ResultSet, error) {
+	var resultSet ResultSet
+	if err := json.NewDecoder(r).Decode(&resultSet); err != nil {
+		return nil, err
+	}
+	return &resultSet, nil
+}
+
+// SelectResultSet performs a [SPARQL SELECT] query like [SPARQLService.Select], and decodes the results into a ResultSet.
+//
+// The results are requested in the [SPARQL Query Results JSON Format] by default. If SelectOptions.ResultFormat is provided,
+// it must be [QueryResultFormatSparqlResultsJSON] or [QueryResultFormatSparqlStarResultsJSON].
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/SPARQL/operation/getSparqlQuery
+//
+// [SPARQL SELECT]: https://www.w3.org/TR/sparql11-query/#select
+// [SPARQL Query Results JSON Format]: https://www.w3.org/TR/sparql11-results-json/
+func (s *SPARQLService) SelectResultSet(ctx context.Context, database string, query string, opts *SelectOptions) (*ResultSet, *Response, error) {
+	if err := validateResultSetFormat(opts); err != nil {
+		return nil, nil, err
+	}
+	req, err := s.newSelectRequest(ctx, database, query, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resultSet ResultSet
+	resp, err := s.doQuery(ctx, database, req, &resultSet)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &resultSet, resp, nil
+}
+
+// validateResultSetFormat returns a ValidationError if the SelectOptions request results in a format other than
+// the SPARQL Query Results JSON Format, with or without quoted triples.
+func validateResultSetFormat(opts *SelectOptions) error {
+	if opts == nil {
+		return nil
+	}
+	switch opts.ResultFormat {
+	case QueryResultFormatUnknown, QueryResultFormatSparqlResultsJSON, QueryResultFormatSparqlStarResultsJSON:
+		return nil
+	}
+	return &ValidationError{Field: "SelectOptions.ResultFormat", Value: opts.ResultFormat,
+		Constraint: "must be QueryResultFormatSparqlResultsJSON or QueryResultFormatSparqlStarResultsJSON"}
+}
@@ -0,0 +1,173 @@
+package stardog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var resultSetJSONText = `{
+  "head": {"vars": ["s", "name", "edge"]},
+  "results": {
+    "bindings": [
+      {
+        "s": {"type": "uri", "value": "http://example.com/paul"},
+        "name": {"type": "literal", "value": "Paul", "xml:lang": "en"},
+        "edge": {"type": "triple", "value": {
+          "subject": {"type": "uri", "value": "urn:s"},
+          "predicate": {"type": "uri", "value": "urn:p"},
+          "object": {"type": "literal", "value": "42", "datatype": "http://www.w3.org/2001/XMLSchema#integer"}
+        }}
+      },
+      {
+        "s": {"type": "bnode", "value": "b0"}
+      }
+    ]
+  }
+}`
+
+var wantResultSet = &ResultSet{
+	Vars: []string{"s", "name", "edge"},
+	Bindings: []Binding{
+		{
+			"s":    {Type: "uri", Value: "http://example.com/paul"},
+			"name": {Type: "literal", Value: "Paul", Lang: "en"},
+			"edge": {Type: "triple", Triple: &QuotedTriple{
+				Subject:   BindingValue{Type: "uri", Value: "urn:s"},
+				Predicate: BindingValue{Type: "uri", Value: "urn:p"},
+				Object:    BindingValue{Type: "literal", Value: "42", Datatype: "http://www.w3.org/2001/XMLSchema#integer"},
+			}},
+		},
+		{
+			"s": {Type: "bnode", Value: "b0"},
+		},
+	},
+}
+
+func TestSPARQLService_SelectResultSet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	query := "SELECT * { ?s ?p ?o }"
+	mux.HandleFunc(fmt.Sprintf("/%s/query", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeApplicationSparqlResultsJSON)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(resultSetJSONText))
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Sparql.SelectResultSet(ctx, db, query, nil)
+	if err != nil {
+		t.Fatalf("Sparql.SelectResultSet returned error: %v", err)
+	}
+	if !cmp.Equal(got, wantResultSet) {
+		t.Errorf("Sparql.SelectResultSet = %+v, want %+v", got, wantResultSet)
+	}
+
+	const methodName = "SelectResultSet"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Sparql.SelectResultSet(ctx, "", query, nil)
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Sparql.SelectResultSet(nil, db, query, nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSPARQLService_SelectResultSet_sparqlStar(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/db1/query", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Accept", mediaTypeApplicationSparqlStarJSON)
+		w.Write([]byte(resultSetJSONText))
+	})
+
+	opts := &SelectOptions{ResultFormat: QueryResultFormatSparqlStarResultsJSON}
+	got, _, err := client.Sparql.SelectResultSet(context.Background(), "db1", "SELECT * { ?s ?p ?o }", opts)
+	if err != nil {
+		t.Fatalf("Sparql.SelectResultSet returned error: %v", err)
+	}
+	if !cmp.Equal(got, wantResultSet) {
+		t.Errorf("Sparql.SelectResultSet = %+v, want %+v", got, wantResultSet)
+	}
+}
+
+func TestSPARQLService_SelectResultSet_invalidResultFormat(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	opts := &SelectOptions{ResultFormat: QueryResultFormatTSV}
+	_, _, err := client.Sparql.SelectResultSet(context.Background(), "db1", "SELECT * {}", opts)
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Errorf("Sparql.SelectResultSet error = %v, want *ValidationError", err)
+	}
+}
+
+func TestDecodeResultSet(t *testing.T) {
+	got, err := DecodeResultSet(strings.NewReader(resultSetJSONText))
+	if err != nil {
+		t.Fatalf("DecodeResultSet returned error: %v", err)
+	}
+	if !cmp.Equal(got, wantResultSet) {
+		t.Errorf("DecodeResultSet = %+v, want %+v", got, wantResultSet)
+	}
+
+	if _, err := DecodeResultSet(strings.NewReader(`{"results": {"bindings": [{"s": {"type": "uri", "value": 1}}]}}`)); err == nil {
+		t.Errorf("DecodeResultSet err = nil, want error")
+	}
+}
+
+func TestBindingValue_UnmarshalJSON_missingValue(t *testing.T) {
+	tests := []struct {
+		data string
+		want BindingValue
+	}{
+		{
+			`{"type": "triple", "subject": {"type": "uri", "value": "urn:s"}, "predicate": {"type": "uri", "value": "urn:p"}, "object": {"type": "bnode", "value": "b0"}}`,
+			BindingValue{Type: "triple", Triple: &QuotedTriple{
+				Subject:   BindingValue{Type: "uri", Value: "urn:s"},
+				Predicate: BindingValue{Type: "uri", Value: "urn:p"},
+				Object:    BindingValue{Type: "bnode", Value: "b0"},
+			}},
+		},
+		{`{"type": "literal", "xml:lang": "en"}`, BindingValue{Type: "literal", Lang: "en"}},
+	}
+	for _, tc := range tests {
+		var got BindingValue
+		if err := json.Unmarshal([]byte(tc.data), &got); err != nil {
+			t.Errorf("json.Unmarshal(%s) returned error: %v", tc.data, err)
+			continue
+		}
+		if !cmp.Equal(got, tc.want) {
+			t.Errorf("json.Unmarshal(%s) = %+v, want %+v", tc.data, got, tc.want)
+		}
+	}
+}
+
+func TestResultSet_MarshalJSON(t *testing.T) {
+	encoded, err := json.Marshal(wantResultSet)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+	var got ResultSet
+	if err := json.Unmarshal(encoded, &got); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if !cmp.Equal(&got, wantResultSet) {
+		t.Errorf("round tripped ResultSet = %+v, want %+v", got, wantResultSet)
+	}
+}
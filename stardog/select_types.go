@@ -0,0 +1,294 @@
+package stardog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// VariableType is the type inferred for a SELECT query variable by [SPARQLService.SelectWithTypes].
+// The zero value for a VariableType is [VariableTypeUnknown], which is reported for variables that are never bound.
+type VariableType int
+
+// All available values for [VariableType]
+const (
+	VariableTypeUnknown VariableType = iota
+	// Every value is an IRI
+	VariableTypeIRI
+	// Every value is a blank node
+	VariableTypeBlankNode
+	// Every value is a literal with an integer datatype (e.g. xsd:integer, xsd:int, xsd:long)
+	VariableTypeInteger
+	// Every value is a numeric literal and at least one is not an integer (e.g. xsd:decimal, xsd:double)
+	VariableTypeDecimal
+	// Every value is an xsd:boolean literal
+	VariableTypeBoolean
+	// Every value is an xsd:dateTime or xsd:date literal
+	VariableTypeDateTime
+	// Every value is a literal with any other datatype or a language tag
+	VariableTypeString
+	// The values are a mix of the other types
+	VariableTypeMixed
+	// Every value is a quoted triple (RDF-star)
+	VariableTypeTriple
+)
+
+var variableTypeValues = [10]string{
+	VariableTypeUnknown:   "unknown",
+	VariableTypeIRI:       "iri",
+	VariableTypeBlankNode: "bnode",
+	VariableTypeInteger:   "integer",
+	VariableTypeDecimal:   "decimal",
+	VariableTypeBoolean:   "boolean",
+	VariableTypeDateTime:  "dateTime",
+	VariableTypeString:    "string",
+	VariableTypeMixed:     "mixed",
+	VariableTypeTriple:    "triple",
+}
+
+var variableTypeGoTypes = [10]string{
+	VariableTypeUnknown:   "any",
+	VariableTypeIRI:       "string",
+	VariableTypeBlankNode: "string",
+	VariableTypeInteger:   "int64",
+	VariableTypeDecimal:   "float64",
+	VariableTypeBoolean:   "bool",
+	VariableTypeDateTime:  "time.Time",
+	VariableTypeString:    "string",
+	VariableTypeMixed:     "any",
+	VariableTypeTriple:    "any",
+}
+
+// Valid returns if a given VariableType is known (valid) or not.
+func (v VariableType) Valid() bool {
+	return !(v <= VariableTypeUnknown || int(v) >= len(variableTypeValues))
+}
+
+// String will return the string representation of the VariableType
+func (v VariableType) String() string {
+	if !v.Valid() {
+		return variableTypeValues[VariableTypeUnknown]
+	}
+	return variableTypeValues[v]
+}
+
+// GoType returns the name of the Go type best suited to hold values of the VariableType (e.g. "int64").
+func (v VariableType) GoType() string {
+	if !v.Valid() {
+		return variableTypeGoTypes[VariableTypeUnknown]
+	}
+	return variableTypeGoTypes[v]
+}
+
+// merge returns the type of a variable whose values are of types v and other.
+func (v VariableType) merge(other VariableType) VariableType {
+	switch {
+	case v == VariableTypeUnknown:
+		return other
+	case other == VariableTypeUnknown, v == other:
+		return v
+	case v.numeric() && other.numeric():
+		return VariableTypeDecimal
+	default:
+		return VariableTypeMixed
+	}
+}
+
+func (v VariableType) numeric() bool {
+	return v == VariableTypeInteger || v == VariableTypeDecimal
+}
+
+// VariableTypeInfo describes the values bound to a SELECT query variable.
+type VariableTypeInfo struct {
+	// The type inferred from the bound values
+	Type VariableType
+	// Number of solutions in which the variable is bound
+	Bound int
+	// Number of solutions in which the variable is unbound
+	Unbound int
+}
+
+// SelectTypeReport is the report of inferred variable types returned by [SPARQLService.SelectWithTypes].
+type SelectTypeReport struct {
+	// The variables of the query in the order the server reported them
+	Variables []string
+	// The inferred type information for each variable
+	Types map[string]VariableTypeInfo
+	// Number of solutions in the results
+	Solutions int
+}
+
+const xsdNamespace = "http://www.w3.org/2001/XMLSchema#"
+
+var xsdVariableTypes = map[string]VariableType{
+	"integer":            VariableTypeInteger,
+	"int":                VariableTypeInteger,
+	"long":               VariableTypeInteger,
+	"short":              VariableTypeInteger,
+	"byte":               VariableTypeInteger,
+	"nonNegativeInteger": VariableTypeInteger,
+	"positiveInteger":    VariableTypeInteger,
+	"nonPositiveInteger": VariableTypeInteger,
+	"negativeInteger":    VariableTypeInteger,
+	"unsignedLong":       VariableTypeInteger,
+	"unsignedInt":        VariableTypeInteger,
+	"unsignedShort":      VariableTypeInteger,
+	"unsignedByte":       VariableTypeInteger,
+	"decimal":            VariableTypeDecimal,
+	"double":             VariableTypeDecimal,
+	"float":              VariableTypeDecimal,
+	"boolean":            VariableTypeBoolean,
+	"dateTime":           VariableTypeDateTime,
+	"date":               VariableTypeDateTime,
+}
+
+// variableType returns the VariableType of a single term.
+func (t BindingValue) variableType() VariableType {
+	switch t.Type {
+	case "uri":
+		return VariableTypeIRI
+	case "bnode":
+		return VariableTypeBlankNode
+	case "triple":
+		return VariableTypeTriple
+	case "literal", "typed-literal":
+		if localName, ok := strings.CutPrefix(t.Datatype, xsdNamespace); ok {
+			if variableType, ok := xsdVariableTypes[localName]; ok {
+				return variableType
+			}
+		}
+		return VariableTypeString
+	default:
+		return VariableTypeMixed
+	}
+}
+
+// SelectWithTypes performs a [SPARQL SELECT] query like [SPARQLService.Select], and also infers the type of
+// each variable from the values bound to it. The types are computed while the results are streamed from the
+// server, which is useful for generating code or CSV schemas from query results.
+//
+// The results are returned in the [SPARQL Query Results JSON Format] by default. If SelectOptions.ResultFormat is provided,
+// it must be [QueryResultFormatSparqlResultsJSON] or [QueryResultFormatSparqlStarResultsJSON].
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/SPARQL/operation/getSparqlQuery
+//
+// [SPARQL SELECT]: https://www.w3.org/TR/sparql11-query/#select
+// [SPARQL Query Results JSON Format]: https://www.w3.org/TR/sparql11-results-json/
+func (s *SPARQLService) SelectWithTypes(ctx context.Context, database string, query string, opts *SelectOptions) (*bytes.Buffer, *SelectTypeReport, *Response, error) {
+	if err := validateResultSetFormat(opts); err != nil {
+		return nil, nil, nil, err
+	}
+	req, err := s.newSelectRequest(ctx, database, query, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	resp, err := s.client.BareDo(ctx, req)
+	if err != nil {
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+		return nil, nil, resp, err
+	}
+	defer resp.Body.Close()
+
+	var buf bytes.Buffer
+	body := io.TeeReader(resp.Body, &buf)
+	report, err := inferSelectTypes(json.NewDecoder(body))
+	if err != nil {
+		return nil, nil, resp, err
+	}
+	// read anything trailing the results so the returned buffer is complete
+	if _, err := io.Copy(io.Discard, body); err != nil {
+		return nil, nil, resp, err
+	}
+	resp.RawBody = buf.Bytes()
+	return &buf, report, resp, nil
+}
+
+// inferSelectTypes decodes SPARQL JSON results one solution at a time, building a SelectTypeReport.
+func inferSelectTypes(decoder *json.Decoder) (*SelectTypeReport, error) {
+	report := &SelectTypeReport{Types: make(map[string]VariableTypeInfo)}
+	if err := expectDelim(decoder, '{'); err != nil {
+		return nil, err
+	}
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch key {
+		case "head":
+			var head struct {
+				Vars []string `json:"vars"`
+			}
+			if err := decoder.Decode(&head); err != nil {
+				return nil, err
+			}
+			report.Variables = head.Vars
+		case "results":
+			if err := inferSelectTypesFromResults(decoder, report); err != nil {
+				return nil, err
+			}
+		default:
+			var skip json.RawMessage
+			if err := decoder.Decode(&skip); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := expectDelim(decoder, '}'); err != nil {
+		return nil, err
+	}
+
+	// variables are unbound in every solution they don't appear in
+	for _, variable := range report.Variables {
+		info := report.Types[variable]
+		info.Unbound = report.Solutions - info.Bound
+		report.Types[variable] = info
+	}
+	return report, nil
+}
+
+// inferSelectTypesFromResults decodes the "results" member of SPARQL JSON results.
+func inferSelectTypesFromResults(decoder *json.Decoder, report *SelectTypeReport) error {
+	if err := expectDelim(decoder, '{'); err != nil {
+		return err
+	}
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		if key != "bindings" {
+			var skip json.RawMessage
+			if err := decoder.Decode(&skip); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := expectDelim(decoder, '['); err != nil {
+			return err
+		}
+		for decoder.More() {
+			var solution map[string]BindingValue
+			if err := decoder.Decode(&solution); err != nil {
+				return fmt.Errorf("decoding solution %d: %w", report.Solutions+1, err)
+			}
+			report.Solutions++
+			for variable, term := range solution {
+				info := report.Types[variable]
+				info.Type = info.Type.merge(term.variableType())
+				info.Bound++
+				report.Types[variable] = info
+			}
+		}
+		if err := expectDelim(decoder, ']'); err != nil {
+			return err
+		}
+	}
+	return expectDelim(decoder, '}')
+}
@@ -0,0 +1,154 @@
+package stardog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSPARQLService_SelectWithTypes(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	query := "SELECT ?person ?age ?height ?name ?node ?misc { ?s ?p ?o }"
+	results := `{
+  "head": {"vars": ["person", "age", "height", "name", "node", "misc", "never"]},
+  "results": {
+    "bindings": [
+      {
+        "person": {"type": "uri", "value": "http://example.com/paul"},
+        "age": {"type": "literal", "value": "80", "datatype": "http://www.w3.org/2001/XMLSchema#integer"},
+        "height": {"type": "literal", "value": "1", "datatype": "http://www.w3.org/2001/XMLSchema#int"},
+        "name": {"type": "literal", "value": "Paul", "xml:lang": "en"},
+        "node": {"type": "bnode", "value": "b0"},
+        "misc": {"type": "uri", "value": "http://example.com/thing"}
+      },
+      {
+        "person": {"type": "uri", "value": "http://example.com/john"},
+        "height": {"type": "literal", "value": "1.79", "datatype": "http://www.w3.org/2001/XMLSchema#decimal"},
+        "name": {"type": "literal", "value": "John"},
+        "node": {"type": "bnode", "value": "b1"},
+        "misc": {"type": "literal", "value": "true", "datatype": "http://www.w3.org/2001/XMLSchema#boolean"}
+      }
+    ]
+  }
+}`
+
+	mux.HandleFunc(fmt.Sprintf("/%s/query", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeApplicationSparqlResultsJSON)
+		if got := r.URL.Query().Get("query"); got != query {
+			t.Errorf("query = %q, want %q", got, query)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(results))
+	})
+
+	ctx := context.Background()
+	buf, report, _, err := client.Sparql.SelectWithTypes(ctx, db, query, nil)
+	if err != nil {
+		t.Fatalf("Sparql.SelectWithTypes returned error: %v", err)
+	}
+	if got, want := buf.String(), results; got != want {
+		t.Errorf("Sparql.SelectWithTypes results = %v, want %v", got, want)
+	}
+
+	want := &SelectTypeReport{
+		Variables: []string{"person", "age", "height", "name", "node", "misc", "never"},
+		Types: map[string]VariableTypeInfo{
+			"person": {Type: VariableTypeIRI, Bound: 2},
+			"age":    {Type: VariableTypeInteger, Bound: 1, Unbound: 1},
+			"height": {Type: VariableTypeDecimal, Bound: 2},
+			"name":   {Type: VariableTypeString, Bound: 2},
+			"node":   {Type: VariableTypeBlankNode, Bound: 2},
+			"misc":   {Type: VariableTypeMixed, Bound: 2},
+			"never":  {Type: VariableTypeUnknown, Unbound: 2},
+		},
+		Solutions: 2,
+	}
+	if !cmp.Equal(report, want) {
+		t.Errorf("Sparql.SelectWithTypes report = %+v, want %+v", report, want)
+	}
+
+	const methodName = "SelectWithTypes"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, _, err = client.Sparql.SelectWithTypes(ctx, "", query, nil)
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, _, resp, err := client.Sparql.SelectWithTypes(nil, db, query, nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSPARQLService_SelectWithTypes_invalidResultFormat(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	opts := &SelectOptions{ResultFormat: QueryResultFormatCSV}
+	_, _, _, err := client.Sparql.SelectWithTypes(context.Background(), "db1", "SELECT * {}", opts)
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Errorf("Sparql.SelectWithTypes error = %v, want *ValidationError", err)
+	}
+}
+
+func Test_inferSelectTypes_malformed(t *testing.T) {
+	inputs := []string{
+		`[]`,
+		`{"results": {"bindings": {}}}`,
+		`{"results": {"bindings": [1]}}`,
+	}
+	for _, input := range inputs {
+		if _, err := inferSelectTypes(json.NewDecoder(strings.NewReader(input))); err == nil {
+			t.Errorf("inferSelectTypes(%s) err = nil, want error", input)
+		}
+	}
+}
+
+func Test_inferSelectTypes_quotedTriples(t *testing.T) {
+	input := `{
+  "head": {"vars": ["edge"]},
+  "results": {"bindings": [{
+    "edge": {"type": "triple", "value": {
+      "subject": {"type": "uri", "value": "urn:paul"},
+      "predicate": {"type": "uri", "value": "urn:memberOf"},
+      "object": {"type": "uri", "value": "urn:beatles"}
+    }}
+  }]}
+}`
+	got, err := inferSelectTypes(json.NewDecoder(strings.NewReader(input)))
+	if err != nil {
+		t.Fatalf("inferSelectTypes returned error: %v", err)
+	}
+	want := map[string]VariableTypeInfo{"edge": {Type: VariableTypeTriple, Bound: 1}}
+	if !cmp.Equal(got.Types, want) {
+		t.Errorf("inferSelectTypes types = %+v, want %+v", got.Types, want)
+	}
+
+}
+
+func TestVariableType(t *testing.T) {
+	if got, want := VariableTypeInteger.String(), "integer"; got != want {
+		t.Errorf("VariableType.String = %q, want %q", got, want)
+	}
+	if got, want := VariableTypeDateTime.GoType(), "time.Time"; got != want {
+		t.Errorf("VariableType.GoType = %q, want %q", got, want)
+	}
+	if got, want := VariableType(100).String(), "unknown"; got != want {
+		t.Errorf("VariableType.String = %q, want %q", got, want)
+	}
+	if got, want := VariableType(100).GoType(), "any"; got != want {
+		t.Errorf("VariableType.GoType = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,140 @@
+package stardog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Database options configuring sensitive properties
+const (
+	// the IRIs of the database's sensitive properties
+	databaseSensitivePropertiesOption = "security.properties.sensitive"
+	// the function that masks the values of sensitive properties for users who can't read them
+	databaseMaskingFunctionOption = "security.masking.function"
+)
+
+// SensitiveProperties returns the IRIs of the database's [sensitive properties], whose values are masked
+// for users without read permission on the database's [PermissionResourceTypeSensitiveProperty] resource.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/getDatabaseOptions
+//
+// [sensitive properties]: https://docs.stardog.com/operating-stardog/security/data-security#sensitive-properties
+func (s *SecurityService) SensitiveProperties(ctx context.Context, database string) ([]string, *Response, error) {
+	metadata, resp, err := s.client.DatabaseAdmin.Metadata(ctx, database, []string{databaseSensitivePropertiesOption})
+	if err != nil {
+		return nil, resp, err
+	}
+	properties, err := stringListOption(metadata, databaseSensitivePropertiesOption)
+	if err != nil {
+		return nil, resp, err
+	}
+	return properties, resp, nil
+}
+
+// SetSensitiveProperties replaces the database's sensitive properties with the properties, given as IRIs.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/setDatabaseOption
+func (s *SecurityService) SetSensitiveProperties(ctx context.Context, database string, properties []string) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	for i, property := range properties {
+		if property == "" {
+			return nil, &ValidationError{Field: fmt.Sprintf("properties[%d]", i), Value: property, Constraint: "must not be empty"}
+		}
+	}
+	if properties == nil {
+		properties = []string{}
+	}
+	return s.client.DatabaseAdmin.SetMetadata(ctx, database, map[string]any{databaseSensitivePropertiesOption: properties})
+}
+
+// AddSensitiveProperties marks the properties, given as IRIs, as sensitive in the database. Properties that are
+// already sensitive are left as is. The database's sensitive properties are read and then written back, so
+// concurrent changes to them may be lost.
+func (s *SecurityService) AddSensitiveProperties(ctx context.Context, database string, properties ...string) (*Response, error) {
+	current, resp, err := s.SensitiveProperties(ctx, database)
+	if err != nil {
+		return resp, err
+	}
+	for _, property := range properties {
+		if indexOf(current, property) < 0 {
+			current = append(current, property)
+		}
+	}
+	return s.SetSensitiveProperties(ctx, database, current)
+}
+
+// RemoveSensitiveProperties stops treating the properties, given as IRIs, as sensitive in the database. It's not
+// an error if a property isn't sensitive. The database's sensitive properties are read and then written back, so
+// concurrent changes to them may be lost.
+func (s *SecurityService) RemoveSensitiveProperties(ctx context.Context, database string, properties ...string) (*Response, error) {
+	current, resp, err := s.SensitiveProperties(ctx, database)
+	if err != nil {
+		return resp, err
+	}
+	remaining := make([]string, 0, len(current))
+	for _, property := range current {
+		if indexOf(properties, property) < 0 {
+			remaining = append(remaining, property)
+		}
+	}
+	return s.SetSensitiveProperties(ctx, database, remaining)
+}
+
+// MaskingFunction returns the name of the function the database masks the values of sensitive properties with,
+// or an empty string if it uses the server's default.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/getDatabaseOptions
+func (s *SecurityService) MaskingFunction(ctx context.Context, database string) (string, *Response, error) {
+	metadata, resp, err := s.client.DatabaseAdmin.Metadata(ctx, database, []string{databaseMaskingFunctionOption})
+	if err != nil {
+		return "", resp, err
+	}
+	function, _ := metadata[databaseMaskingFunctionOption].(string)
+	return function, resp, nil
+}
+
+// SetMaskingFunction sets the function the database masks the values of sensitive properties with, given as
+// the name or IRI of a SPARQL function that takes the value and returns its masked form.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/setDatabaseOption
+func (s *SecurityService) SetMaskingFunction(ctx context.Context, database string, function string) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	if function == "" {
+		return nil, &ValidationError{Field: "function", Value: function, Constraint: "must not be empty"}
+	}
+	return s.client.DatabaseAdmin.SetMetadata(ctx, database, map[string]any{databaseMaskingFunctionOption: function})
+}
+
+// stringListOption returns the value of a database option holding a list of strings, which the server returns
+// as a JSON array or, for older servers, a comma separated string.
+func stringListOption(metadata map[string]any, option string) ([]string, error) {
+	switch value := metadata[option].(type) {
+	case nil:
+		return []string{}, nil
+	case string:
+		values := []string{}
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+		return values, nil
+	case []any:
+		values := make([]string, 0, len(value))
+		for _, v := range value {
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("database option %s: unexpected value %v", option, v)
+			}
+			values = append(values, s)
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("database option %s: unexpected value %v", option, value)
+	}
+}
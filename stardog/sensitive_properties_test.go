@@ -0,0 +1,157 @@
+package stardog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// handleSensitiveOptions serves the database options of db, recording the options set on it.
+func handleSensitiveOptions(t *testing.T, mux *http.ServeMux, db string, optionsJSON string, set *map[string]any) {
+	t.Helper()
+	mux.HandleFunc(fmt.Sprintf("/admin/databases/%s/options", db), func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			w.Write([]byte(optionsJSON))
+		case http.MethodPost:
+			if err := json.NewDecoder(r.Body).Decode(set); err != nil {
+				t.Errorf("unable to decode options: %v", err)
+			}
+		default:
+			t.Errorf("Request method: %v, want PUT or POST", r.Method)
+		}
+	})
+}
+
+func TestSecurityService_SensitiveProperties(t *testing.T) {
+	tests := []struct {
+		name        string
+		optionsJSON string
+		want        []string
+	}{
+		{"list", `{"security.properties.sensitive": ["http://example.com/ssn", "http://example.com/salary"]}`, []string{"http://example.com/ssn", "http://example.com/salary"}},
+		{"comma separated", `{"security.properties.sensitive": "http://example.com/ssn, http://example.com/salary"}`, []string{"http://example.com/ssn", "http://example.com/salary"}},
+		{"unset", `{}`, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+			var set map[string]any
+			handleSensitiveOptions(t, mux, "db1", tt.optionsJSON, &set)
+
+			got, _, err := client.Security.SensitiveProperties(context.Background(), "db1")
+			if err != nil {
+				t.Fatalf("Security.SensitiveProperties returned error: %v", err)
+			}
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("Security.SensitiveProperties = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	client, _, _, teardown := setup()
+	defer teardown()
+	const methodName = "SensitiveProperties"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Security.SensitiveProperties(nil, "db1")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityService_AddSensitiveProperties(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var set map[string]any
+	handleSensitiveOptions(t, mux, "db1", `{"security.properties.sensitive": ["http://example.com/ssn"]}`, &set)
+
+	ctx := context.Background()
+	if _, err := client.Security.AddSensitiveProperties(ctx, "db1", "http://example.com/ssn", "http://example.com/salary"); err != nil {
+		t.Fatalf("Security.AddSensitiveProperties returned error: %v", err)
+	}
+	want := map[string]any{"security.properties.sensitive": []any{"http://example.com/ssn", "http://example.com/salary"}}
+	if !cmp.Equal(set, want) {
+		t.Errorf("options set = %v, want %v", set, want)
+	}
+}
+
+func TestSecurityService_RemoveSensitiveProperties(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var set map[string]any
+	handleSensitiveOptions(t, mux, "db1", `{"security.properties.sensitive": ["http://example.com/ssn", "http://example.com/salary"]}`, &set)
+
+	ctx := context.Background()
+	if _, err := client.Security.RemoveSensitiveProperties(ctx, "db1", "http://example.com/ssn", "http://example.com/unknown"); err != nil {
+		t.Fatalf("Security.RemoveSensitiveProperties returned error: %v", err)
+	}
+	want := map[string]any{"security.properties.sensitive": []any{"http://example.com/salary"}}
+	if !cmp.Equal(set, want) {
+		t.Errorf("options set = %v, want %v", set, want)
+	}
+}
+
+func TestSecurityService_SetSensitiveProperties(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var set map[string]any
+	handleSensitiveOptions(t, mux, "db1", `{}`, &set)
+
+	ctx := context.Background()
+	if _, err := client.Security.SetSensitiveProperties(ctx, "db1", nil); err != nil {
+		t.Fatalf("Security.SetSensitiveProperties returned error: %v", err)
+	}
+	if want := map[string]any{"security.properties.sensitive": []any{}}; !cmp.Equal(set, want) {
+		t.Errorf("options set = %v, want %v", set, want)
+	}
+	if _, err := client.Security.SetSensitiveProperties(ctx, "db1", []string{""}); err == nil {
+		t.Errorf("Security.SetSensitiveProperties returned nil error for an empty property")
+	}
+
+	const methodName = "SetSensitiveProperties"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Security.SetSensitiveProperties(nil, "db1", []string{"http://example.com/ssn"})
+	})
+}
+
+func TestSecurityService_MaskingFunction(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var set map[string]any
+	handleSensitiveOptions(t, mux, "db1", `{"security.masking.function": "sha256"}`, &set)
+
+	ctx := context.Background()
+	got, _, err := client.Security.MaskingFunction(ctx, "db1")
+	if err != nil {
+		t.Fatalf("Security.MaskingFunction returned error: %v", err)
+	}
+	if want := "sha256"; got != want {
+		t.Errorf("Security.MaskingFunction = %q, want %q", got, want)
+	}
+
+	if _, err := client.Security.SetMaskingFunction(ctx, "db1", "md5"); err != nil {
+		t.Fatalf("Security.SetMaskingFunction returned error: %v", err)
+	}
+	if want := map[string]any{"security.masking.function": "md5"}; !cmp.Equal(set, want) {
+		t.Errorf("options set = %v, want %v", set, want)
+	}
+	if _, err := client.Security.SetMaskingFunction(ctx, "db1", ""); err == nil {
+		t.Errorf("Security.SetMaskingFunction returned nil error for an empty function")
+	}
+
+	const methodName = "SetMaskingFunction"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Security.SetMaskingFunction(nil, "db1", "md5")
+	})
+}
@@ -2,8 +2,10 @@ package stardog
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 // ServerAdminService provides access to the server admin related functions in the Stardog API.
@@ -42,6 +44,58 @@ func (s *ServerAdminService) IsAlive(ctx context.Context) (*bool, *Response, err
 	return &isAlive, resp, err
 }
 
+// IsHealthy returns whether the server is ready to serve queries. Unlike IsAlive, a cluster node is only healthy
+// once it has joined the cluster and is in sync with it.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Server-Admin/operation/healthCheck
+func (s *ServerAdminService) IsHealthy(ctx context.Context) (*bool, *Response, error) {
+	url := "admin/healthcheck"
+	request, err := s.client.NewRequest(http.MethodGet, url, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := s.client.Do(ctx, request, nil)
+	isHealthy, err := parseBoolResponse(err)
+	return &isHealthy, resp, err
+}
+
+// HealthStatus is the health of the server, returned by [ServerAdminService.Health], which distinguishes a server
+// whose process is up from one that's ready to serve queries, e.g. for Kubernetes liveness and readiness probes.
+type HealthStatus struct {
+	// Whether the server process is up and accepting requests (see [ServerAdminService.IsAlive])
+	Alive bool
+	// Whether the server is ready to serve queries, which for a cluster node means it has joined the cluster
+	// (see [ServerAdminService.IsHealthy])
+	Ready bool
+}
+
+// Health checks whether the server is alive and whether it's ready to serve queries. A server that responds
+// with an error status is reported as not alive or not ready rather than as an error: an error is only returned
+// if the server can't be reached, along with a HealthStatus that's neither alive nor ready.
+func (s *ServerAdminService) Health(ctx context.Context) (*HealthStatus, *Response, error) {
+	var status HealthStatus
+	alive, resp, err := s.IsAlive(ctx)
+	if err != nil && !isErrorResponse(err) {
+		return &status, resp, err
+	}
+	status.Alive = alive != nil && *alive
+	if !status.Alive {
+		return &status, resp, nil
+	}
+	ready, resp, err := s.IsHealthy(ctx)
+	if err != nil && !isErrorResponse(err) {
+		return &status, resp, err
+	}
+	status.Ready = ready != nil && *ready
+	return &status, resp, nil
+}
+
+// isErrorResponse reports whether err is the server responding with an error status.
+func isErrorResponse(err error) bool {
+	var errorResponse *ErrorResponse
+	return errors.As(err, &errorResponse)
+}
+
 // GetProcesses returns all server processes.
 //
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/Monitoring/operation/listProcesses
@@ -93,3 +147,227 @@ func (s *ServerAdminService) KillProcess(ctx context.Context, processID string)
 	}
 	return s.client.Do(ctx, request, nil)
 }
+
+// Job is a background server process, such as a database optimization, index rebuild or backup.
+type Job struct {
+	// The process ID, which can be passed to [ServerAdminService.GetProcess] and [ServerAdminService.KillProcess]
+	ID string
+	// The kind of job, as reported by the server
+	Type string
+	// The database the job operates on, if any
+	Database string
+	// The user who started the job
+	User string
+	// When the job started
+	StartTime time.Time
+	// The job's status, as reported by the server (e.g. "RUNNING")
+	Status string
+	// The job's progress
+	Progress ProcessProgress
+}
+
+// Percent returns the job's progress as a percentage from 0 to 100, or -1 if the server doesn't report
+// how much work the job has to do.
+func (j *Job) Percent() float64 {
+	if j.Progress.Max <= 0 {
+		return -1
+	}
+	return 100 * float64(j.Progress.Current) / float64(j.Progress.Max)
+}
+
+// Jobs returns the server's background jobs: the server processes other than open transactions.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Monitoring/operation/listProcesses
+func (s *ServerAdminService) Jobs(ctx context.Context) ([]Job, *Response, error) {
+	processes, resp, err := s.GetProcesses(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	if processes == nil {
+		return []Job{}, resp, nil
+	}
+	jobs := make([]Job, 0, len(*processes))
+	for _, process := range *processes {
+		if process.Type == processTypeTransaction {
+			continue
+		}
+		jobs = append(jobs, Job{
+			ID:        process.ID,
+			Type:      process.Type,
+			Database:  process.Db,
+			User:      process.User,
+			StartTime: time.UnixMilli(process.StartTime),
+			Status:    process.Status,
+			Progress:  process.Progress,
+		})
+	}
+	return jobs, resp, nil
+}
+
+// ShutdownOptions specifies the optional parameters to the [ServerAdminService.Shutdown] method.
+type ShutdownOptions struct {
+	// How long to wait for running queries and processes to finish before shutting down.
+	// If zero, the server is shut down immediately.
+	GracePeriod time.Duration
+	// Shut down even if queries or processes are still running once the GracePeriod has elapsed.
+	// If false, Shutdown returns [ErrServerBusy] instead and the server keeps running.
+	Force bool
+}
+
+// ErrServerBusy is returned by [ServerAdminService.Shutdown] when queries or processes are still running
+// after the ShutdownOptions.GracePeriod and ShutdownOptions.Force is false.
+var ErrServerBusy = errors.New("stardog: queries or processes still running after the shutdown grace period")
+
+// how often Shutdown checks for running queries and processes during the grace period
+var shutdownPollInterval = time.Second
+
+// Shutdown shuts down the server. If a ShutdownOptions.GracePeriod is provided, Shutdown first waits for running
+// queries (see [QueryManagementService.List]) and processes (see [ServerAdminService.GetProcesses]) to finish,
+// which lets automation restart nodes without interrupting work.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Server-Admin/operation/shutdownServer
+func (s *ServerAdminService) Shutdown(ctx context.Context, opts *ShutdownOptions) (*Response, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	if opts != nil && opts.GracePeriod > 0 {
+		resp, err := s.waitUntilIdle(ctx, opts.GracePeriod)
+		if err != nil && !(errors.Is(err, ErrServerBusy) && opts.Force) {
+			return resp, err
+		}
+	}
+
+	url := "admin/shutdown"
+	request, err := s.client.NewRequest(http.MethodPost, url, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, request, nil)
+}
+
+// waitUntilIdle waits until no queries or processes are running, returning ErrServerBusy
+// if some still are after the grace period.
+func (s *ServerAdminService) waitUntilIdle(ctx context.Context, gracePeriod time.Duration) (*Response, error) {
+	deadline := time.NewTimer(gracePeriod)
+	defer deadline.Stop()
+	ticker := time.NewTicker(shutdownPollInterval)
+	defer ticker.Stop()
+	for {
+		queries, resp, err := s.client.QueryManagement.List(ctx)
+		if err != nil {
+			return resp, err
+		}
+		processes, resp, err := s.GetProcesses(ctx)
+		if err != nil {
+			return resp, err
+		}
+		if len(queries) == 0 && (processes == nil || len(*processes) == 0) {
+			return resp, nil
+		}
+		select {
+		case <-ctx.Done():
+			return resp, ctx.Err()
+		case <-deadline.C:
+			return resp, ErrServerBusy
+		case <-ticker.C:
+		}
+	}
+}
+
+// GetProperties returns the server's configuration properties (the properties set in stardog.properties
+// along with their defaults). If names are provided, only those properties are returned.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Server-Admin/operation/getProperties
+func (s *ServerAdminService) GetProperties(ctx context.Context, names ...string) (map[string]string, *Response, error) {
+	opts := &getPropertiesOptions{Names: names}
+	url, err := addOptions("admin/properties", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	headerOpts := requestHeaderOptions{
+		Accept: mediaTypeApplicationJSON,
+	}
+	request, err := s.client.NewRequest(http.MethodGet, url, &headerOpts, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var properties map[string]string
+	resp, err := s.client.Do(ctx, request, &properties)
+	if err != nil {
+		return nil, resp, err
+	}
+	return properties, resp, nil
+}
+
+// SetProperties sets server configuration properties while the server is running. Only properties Stardog
+// allows to be changed at runtime can be set; the server rejects the request if any of them can't be. The
+// changes aren't written to stardog.properties, so they're lost when the server restarts.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Server-Admin/operation/setProperties
+func (s *ServerAdminService) SetProperties(ctx context.Context, properties map[string]string) (*Response, error) {
+	if len(properties) == 0 {
+		return nil, &ValidationError{Field: "properties", Value: properties, Constraint: "must not be empty"}
+	}
+	headerOpts := requestHeaderOptions{
+		ContentType: mediaTypeApplicationJSON,
+	}
+	request, err := s.client.NewRequest(http.MethodPost, "admin/properties", &headerOpts, properties)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, request, nil)
+}
+
+// SetProperty sets a single server configuration property while the server is running. See
+// [ServerAdminService.SetProperties].
+func (s *ServerAdminService) SetProperty(ctx context.Context, name string, value string) (*Response, error) {
+	if name == "" {
+		return nil, &ValidationError{Field: "name", Value: name, Constraint: "must not be empty"}
+	}
+	return s.SetProperties(ctx, map[string]string{name: value})
+}
+
+// metrics returns the server's metrics, keyed by name. Each metric is an object such as {"count": 1} or {"value": 2}.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Monitoring/operation/status
+func (s *ServerAdminService) metrics(ctx context.Context) (map[string]any, *Response, error) {
+	headerOpts := requestHeaderOptions{
+		Accept: mediaTypeApplicationJSON,
+	}
+	request, err := s.client.NewRequest(http.MethodGet, "admin/status", &headerOpts, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var metrics map[string]any
+	resp, err := s.client.Do(ctx, request, &metrics)
+	if err != nil {
+		return nil, resp, err
+	}
+	return metrics, resp, nil
+}
+
+// Version returns the version of Stardog the server is running, as reported by its dbms.version metric.
+// It's nil if the server doesn't report its version.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Monitoring/operation/status
+func (s *ServerAdminService) Version(ctx context.Context) (*ServerVersion, *Response, error) {
+	metrics, resp, err := s.metrics(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	metric, _ := metrics["dbms.version"].(map[string]any)
+	value, _ := metric["value"].(string)
+	if value == "" {
+		return nil, resp, nil
+	}
+	version, err := ParseServerVersion(value)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &version, resp, nil
+}
+
+// query parameters for GetProperties
+type getPropertiesOptions struct {
+	Names []string `url:"name,omitempty"`
+}
@@ -2,9 +2,12 @@ package stardog
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -36,6 +39,73 @@ func TestServerAdminService_IsAlive(t *testing.T) {
 	})
 }
 
+func TestServerAdminService_IsHealthy(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/healthcheck", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusOK)
+	})
+	ctx := context.Background()
+	got, _, err := client.ServerAdmin.IsHealthy(ctx)
+	if err != nil {
+		t.Errorf("ServerAdmin.IsHealthy returned error: %v", err)
+	}
+	if want := true; !cmp.Equal(*got, want) {
+		t.Errorf("ServerAdmin.IsHealthy = %+v, want %+v", *got, want)
+	}
+
+	const methodName = "IsHealthy"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.ServerAdmin.IsHealthy(nil)
+		if got != nil && *got != false {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want false", methodName, *got)
+		}
+		return resp, err
+	})
+}
+
+func TestServerAdminService_Health(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	aliveStatus, healthStatus := http.StatusOK, http.StatusServiceUnavailable
+	mux.HandleFunc("/admin/alive", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(aliveStatus)
+	})
+	mux.HandleFunc("/admin/healthcheck", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(healthStatus)
+	})
+
+	ctx := context.Background()
+	tests := []struct {
+		name                      string
+		aliveStatus, healthStatus int
+		want                      HealthStatus
+	}{
+		{name: "ready", aliveStatus: http.StatusOK, healthStatus: http.StatusOK, want: HealthStatus{Alive: true, Ready: true}},
+		{name: "joining cluster", aliveStatus: http.StatusOK, healthStatus: http.StatusServiceUnavailable, want: HealthStatus{Alive: true}},
+		{name: "down", aliveStatus: http.StatusServiceUnavailable, healthStatus: http.StatusOK, want: HealthStatus{}},
+	}
+	for _, tc := range tests {
+		aliveStatus, healthStatus = tc.aliveStatus, tc.healthStatus
+		got, _, err := client.ServerAdmin.Health(ctx)
+		if err != nil {
+			t.Errorf("%s: ServerAdmin.Health returned error: %v", tc.name, err)
+			continue
+		}
+		if !cmp.Equal(*got, tc.want) {
+			t.Errorf("%s: ServerAdmin.Health = %+v, want %+v", tc.name, *got, tc.want)
+		}
+	}
+
+	got, _, err := client.ServerAdmin.Health(nil)
+	if err == nil || got.Alive || got.Ready {
+		t.Errorf("ServerAdmin.Health with a nil context = %+v, %v, want an error", got, err)
+	}
+}
+
 func TestServerAdminService_GetProcesses(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -150,6 +220,72 @@ func TestServerAdminService_GetProcess(t *testing.T) {
 	})
 }
 
+func TestServerAdminService_Jobs(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/processes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Write([]byte(`[
+  {"type": "Transaction", "id": "tx", "db": "myDb", "user": "admin", "startTime": 1669949829376, "status": "RUNNING", "progress": {"max": 0, "current": 0, "stage": ""}},
+  {"type": "DB_OPTIMIZE", "id": "optimize", "db": "myDb", "user": "admin", "startTime": 1669949829376, "status": "RUNNING", "progress": {"max": 4, "current": 1, "stage": "Compacting"}}
+]`))
+	})
+
+	ctx := context.Background()
+	got, _, err := client.ServerAdmin.Jobs(ctx)
+	if err != nil {
+		t.Fatalf("ServerAdmin.Jobs returned error: %v", err)
+	}
+	want := []Job{{
+		ID:        "optimize",
+		Type:      "DB_OPTIMIZE",
+		Database:  "myDb",
+		User:      "admin",
+		StartTime: time.UnixMilli(1669949829376),
+		Status:    "RUNNING",
+		Progress:  ProcessProgress{Max: 4, Current: 1, Stage: "Compacting"},
+	}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("ServerAdmin.Jobs = %+v, want %+v", got, want)
+	}
+	if got, want := got[0].Percent(), 25.0; got != want {
+		t.Errorf("Job.Percent = %v, want %v", got, want)
+	}
+	if got := (&Job{}).Percent(); got != -1 {
+		t.Errorf("Job.Percent without a maximum = %v, want -1", got)
+	}
+
+	const methodName = "Jobs"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.ServerAdmin.Jobs(nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestServerAdminService_Jobs_emptyBody(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var body string
+	mux.HandleFunc("/admin/processes", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	})
+
+	for _, body = range []string{"", "null"} {
+		got, _, err := client.ServerAdmin.Jobs(context.Background())
+		if err != nil {
+			t.Fatalf("ServerAdmin.Jobs with body %q returned error: %v", body, err)
+		}
+		if got == nil || len(got) != 0 {
+			t.Errorf("ServerAdmin.Jobs with body %q = %#v, want an empty list", body, got)
+		}
+	}
+}
+
 func TestServerAdminService_KillProcess(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -172,3 +308,203 @@ func TestServerAdminService_KillProcess(t *testing.T) {
 		return client.ServerAdmin.KillProcess(nil, processID)
 	})
 }
+
+func TestServerAdminService_Shutdown(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	shutdown := 0
+	mux.HandleFunc("/admin/shutdown", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		shutdown++
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := context.Background()
+	if _, err := client.ServerAdmin.Shutdown(ctx, nil); err != nil {
+		t.Errorf("ServerAdmin.Shutdown returned error: %v", err)
+	}
+	if shutdown != 1 {
+		t.Errorf("shutdown requests = %d, want 1", shutdown)
+	}
+
+	const methodName = "Shutdown"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.ServerAdmin.Shutdown(ctx, &ShutdownOptions{GracePeriod: -time.Second})
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.ServerAdmin.Shutdown(nil, nil)
+	})
+}
+
+func TestServerAdminService_Shutdown_gracePeriod(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	defer func(interval time.Duration) { shutdownPollInterval = interval }(shutdownPollInterval)
+	shutdownPollInterval = time.Millisecond
+
+	// a query runs for the first two checks, and a process forever
+	queryChecks := 0
+	mux.HandleFunc("/admin/queries", func(w http.ResponseWriter, r *http.Request) {
+		queryChecks++
+		if queryChecks <= 2 {
+			w.Write([]byte(`{"queries": [{"id": "1"}]}`))
+			return
+		}
+		w.Write([]byte(`{"queries": []}`))
+	})
+	processRunning := true
+	mux.HandleFunc("/admin/processes", func(w http.ResponseWriter, r *http.Request) {
+		if processRunning {
+			w.Write([]byte(`[{"id": "p1"}]`))
+			return
+		}
+		w.Write([]byte(`[]`))
+	})
+	shutdown := 0
+	mux.HandleFunc("/admin/shutdown", func(w http.ResponseWriter, r *http.Request) {
+		shutdown++
+	})
+
+	ctx := context.Background()
+	_, err := client.ServerAdmin.Shutdown(ctx, &ShutdownOptions{GracePeriod: 20 * time.Millisecond})
+	if !errors.Is(err, ErrServerBusy) {
+		t.Errorf("ServerAdmin.Shutdown error = %v, want ErrServerBusy", err)
+	}
+	if shutdown != 0 {
+		t.Errorf("server was shut down while busy")
+	}
+
+	if _, err := client.ServerAdmin.Shutdown(ctx, &ShutdownOptions{GracePeriod: 20 * time.Millisecond, Force: true}); err != nil {
+		t.Errorf("ServerAdmin.Shutdown returned error: %v", err)
+	}
+	if shutdown != 1 {
+		t.Errorf("shutdown requests = %d, want 1 when forced", shutdown)
+	}
+
+	processRunning = false
+	queryChecks = 0
+	if _, err := client.ServerAdmin.Shutdown(ctx, &ShutdownOptions{GracePeriod: time.Minute}); err != nil {
+		t.Errorf("ServerAdmin.Shutdown returned error: %v", err)
+	}
+	if shutdown != 2 || queryChecks != 3 {
+		t.Errorf("shutdown requests = %d after %d checks, want 2 after 3", shutdown, queryChecks)
+	}
+}
+
+func TestServerAdminService_GetProperties(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/properties", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeApplicationJSON)
+		if got, want := r.URL.Query()["name"], []string{"query.timeout", "security.disable.password"}; !cmp.Equal(got, want) {
+			t.Errorf("name parameters = %v, want %v", got, want)
+		}
+		w.Write([]byte(`{"query.timeout": "5m", "security.disable.password": "false"}`))
+	})
+
+	ctx := context.Background()
+	got, _, err := client.ServerAdmin.GetProperties(ctx, "query.timeout", "security.disable.password")
+	if err != nil {
+		t.Errorf("ServerAdmin.GetProperties returned error: %v", err)
+	}
+	want := map[string]string{"query.timeout": "5m", "security.disable.password": "false"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("ServerAdmin.GetProperties = %+v, want %+v", got, want)
+	}
+
+	const methodName = "GetProperties"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.ServerAdmin.GetProperties(nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestServerAdminService_SetProperties(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var bodies []string
+	mux.HandleFunc("/admin/properties", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", mediaTypeApplicationJSON)
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := context.Background()
+	if _, err := client.ServerAdmin.SetProperties(ctx, map[string]string{"query.timeout": "10m", "query.plan.reuse": "always"}); err != nil {
+		t.Errorf("ServerAdmin.SetProperties returned error: %v", err)
+	}
+	if _, err := client.ServerAdmin.SetProperty(ctx, "query.timeout", "1h"); err != nil {
+		t.Errorf("ServerAdmin.SetProperty returned error: %v", err)
+	}
+	want := []string{
+		`{"query.plan.reuse":"always","query.timeout":"10m"}` + "\n",
+		`{"query.timeout":"1h"}` + "\n",
+	}
+	if !cmp.Equal(bodies, want) {
+		t.Errorf("request bodies = %q, want %q", bodies, want)
+	}
+
+	const methodName = "SetProperties"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.ServerAdmin.SetProperties(ctx, nil)
+		return err
+	})
+	testBadOptions(t, "SetProperty", func() (err error) {
+		_, err = client.ServerAdmin.SetProperty(ctx, "", "1h")
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.ServerAdmin.SetProperties(nil, map[string]string{"query.timeout": "1h"})
+	})
+}
+
+func TestServerAdminService_Version(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	version := "9.2.1"
+	mux.HandleFunc("/admin/status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"dbms.version": {"value": %q}}`, version)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.ServerAdmin.Version(ctx)
+	if err != nil {
+		t.Fatalf("ServerAdmin.Version returned error: %v", err)
+	}
+	if want := (&ServerVersion{Major: 9, Minor: 2, Patch: 1}); !cmp.Equal(got, want) {
+		t.Errorf("ServerAdmin.Version = %+v, want %+v", got, want)
+	}
+
+	version = ""
+	got, _, err = client.ServerAdmin.Version(ctx)
+	if err != nil || got != nil {
+		t.Errorf("ServerAdmin.Version = %+v, %v, want nil for a server that doesn't report its version", got, err)
+	}
+
+	version = "unknown"
+	if _, _, err := client.ServerAdmin.Version(ctx); err == nil {
+		t.Error("ServerAdmin.Version should return an error for a version that can't be parsed")
+	}
+
+	const methodName = "Version"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.ServerAdmin.Version(nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %+v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
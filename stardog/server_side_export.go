@@ -0,0 +1,108 @@
+package stardog
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ExportNamedGraphsOptions specifies the parameters to the [DatabaseAdminService.ExportNamedGraphs] method.
+type ExportNamedGraphsOptions struct {
+	// The named graphs to export. Each named graph is exported to its own file.
+	Graphs []NamedGraphExport
+
+	// The RDF format for the exported data
+	Format RDFFormat
+
+	// Compression format used for named graphs that don't specify their own
+	Compression Compression
+}
+
+// NamedGraphExport specifies a named graph to export with [DatabaseAdminService.ExportNamedGraphs].
+type NamedGraphExport struct {
+	// The named graph to export
+	NamedGraph string
+
+	// Compression format for the exported named graph. If unset, ExportNamedGraphsOptions.Compression is used.
+	Compression Compression
+}
+
+// ServerSideExport describes a file produced by a server side export, parsed from the message
+// returned by Stardog such as:
+//
+//	Exported 28 statements from db1 to /stardog-home/.exports/db1-2023-01-15.trig in 2.551 ms
+type ServerSideExport struct {
+	// The named graph that was exported
+	NamedGraph string
+	// Number of statements exported
+	Statements int
+	// Path of the produced file on the server
+	Path string
+	// How long the export took, as reported by the server (e.g. "2.551 ms")
+	Duration string
+	// The raw message returned by the server
+	Message string
+}
+
+var serverSideExportMessage = regexp.MustCompile(`^Exported (\d+) statements from \S+ to (.+) in (.+)$`)
+
+// parseServerSideExportMessage parses the message returned by Stardog for a server side export.
+// If the message isn't in the expected format only ServerSideExport.Message is populated.
+func parseServerSideExportMessage(message string) ServerSideExport {
+	message = strings.TrimSpace(message)
+	export := ServerSideExport{Message: message}
+	match := serverSideExportMessage.FindStringSubmatch(message)
+	if match == nil {
+		return export
+	}
+	statements, err := strconv.Atoi(match[1])
+	if err != nil {
+		return export
+	}
+	export.Statements = statements
+	export.Path = match[2]
+	export.Duration = match[3]
+	return export
+}
+
+// ExportNamedGraphs exports each named graph to its own file in the server's export directory,
+// compressing each one with its own compression format if provided. Stardog names the files it produces,
+// so the returned ServerSideExports report where each named graph was written.
+//
+// The named graphs are exported one at a time using [DatabaseAdminService.ExportData]. If an export
+// fails, the exports that completed before the failure are returned along with the error.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/exportDatabase
+func (s *DatabaseAdminService) ExportNamedGraphs(ctx context.Context, database string, opts *ExportNamedGraphsOptions) ([]ServerSideExport, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	exports := make([]ServerSideExport, 0, len(opts.Graphs))
+	var resp *Response
+	for _, graph := range opts.Graphs {
+		compression := graph.Compression
+		if compression == CompressionUnknown {
+			compression = opts.Compression
+		}
+		exportOpts := &ExportDataOptions{
+			NamedGraph:  []string{graph.NamedGraph},
+			Format:      opts.Format,
+			Compression: compression,
+			ServerSide:  true,
+		}
+		message, r, err := s.ExportData(ctx, database, exportOpts)
+		resp = r
+		if err != nil {
+			return exports, resp, err
+		}
+		export := parseServerSideExportMessage(message.String())
+		export.NamedGraph = graph.NamedGraph
+		exports = append(exports, export)
+	}
+	return exports, resp, nil
+}
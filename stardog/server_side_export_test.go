@@ -0,0 +1,120 @@
+package stardog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDatabaseAdminService_ExportNamedGraphs(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	files := map[string]string{
+		"urn:graph:people": "/stardog-home/.exports/db1-people.ttl.gz",
+		"urn:graph:movies": "/stardog-home/.exports/db1-movies.ttl.bz2",
+	}
+	wantCompression := map[string]string{
+		"urn:graph:people": "GZIP",
+		"urn:graph:movies": "BZ2",
+	}
+
+	mux.HandleFunc(fmt.Sprintf("/%s/export", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypePlainText)
+		q := r.URL.Query()
+		graph := q.Get("named-graph-uri")
+		if got, want := q.Get("compression"), wantCompression[graph]; got != want {
+			t.Errorf("compression for %s = %q, want %q", graph, got, want)
+		}
+		if got, want := q.Get("server-side"), "true"; got != want {
+			t.Errorf("server-side = %q, want %q", got, want)
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, "Exported 28 statements from %s to %s in 2.551 ms\n", db, files[graph])
+	})
+
+	opts := &ExportNamedGraphsOptions{
+		Graphs: []NamedGraphExport{
+			{NamedGraph: "urn:graph:people"},
+			{NamedGraph: "urn:graph:movies", Compression: CompressionBZ2},
+		},
+		Format:      RDFFormatTurtle,
+		Compression: CompressionGZIP,
+	}
+
+	ctx := context.Background()
+	got, _, err := client.DatabaseAdmin.ExportNamedGraphs(ctx, db, opts)
+	if err != nil {
+		t.Errorf("DatabaseAdmin.ExportNamedGraphs returned error: %v", err)
+	}
+	want := []ServerSideExport{
+		{
+			NamedGraph: "urn:graph:people",
+			Statements: 28,
+			Path:       files["urn:graph:people"],
+			Duration:   "2.551 ms",
+			Message:    fmt.Sprintf("Exported 28 statements from db1 to %s in 2.551 ms", files["urn:graph:people"]),
+		},
+		{
+			NamedGraph: "urn:graph:movies",
+			Statements: 28,
+			Path:       files["urn:graph:movies"],
+			Duration:   "2.551 ms",
+			Message:    fmt.Sprintf("Exported 28 statements from db1 to %s in 2.551 ms", files["urn:graph:movies"]),
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("DatabaseAdmin.ExportNamedGraphs = %+v, want %+v", got, want)
+	}
+
+	const methodName = "ExportNamedGraphs"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.DatabaseAdmin.ExportNamedGraphs(ctx, "\n", opts)
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.DatabaseAdmin.ExportNamedGraphs(nil, db, opts)
+		if len(got) != 0 {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want empty", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestDatabaseAdminService_ExportNamedGraphs_invalidOptions(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	tests := []struct {
+		name string
+		opts *ExportNamedGraphsOptions
+	}{
+		{name: "nil options", opts: nil},
+		{name: "no graphs", opts: &ExportNamedGraphsOptions{}},
+		{name: "empty named graph", opts: &ExportNamedGraphsOptions{Graphs: []NamedGraphExport{{}}}},
+		{name: "bad compression", opts: &ExportNamedGraphsOptions{Graphs: []NamedGraphExport{{NamedGraph: "urn:g", Compression: Compression(100)}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := client.DatabaseAdmin.ExportNamedGraphs(context.Background(), "db1", tt.opts)
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Errorf("DatabaseAdmin.ExportNamedGraphs error = %v, want *ValidationError", err)
+			}
+		})
+	}
+}
+
+func Test_parseServerSideExportMessage_unexpectedFormat(t *testing.T) {
+	got := parseServerSideExportMessage("  something else  ")
+	want := ServerSideExport{Message: "something else"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("parseServerSideExportMessage = %+v, want %+v", got, want)
+	}
+}
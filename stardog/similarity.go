@@ -0,0 +1,181 @@
+package stardog
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// SimilarityService handles [similarity search] with Stardog's predictive analytics models. Stardog
+// manages models with SPARQL against the spa:model graph rather than dedicated endpoints; this service
+// builds those queries and updates so callers don't have to.
+//
+// [similarity search]: https://docs.stardog.com/machine-learning/similarity-search
+type SimilarityService service
+
+const (
+	// the namespace of Stardog's predictive analytics vocabulary
+	spaNamespace = "tag:stardog:api:analytics:"
+	spaPrefix    = "PREFIX spa: <" + spaNamespace + ">\n"
+)
+
+// the variables a similarity query binds the similar items and their confidences to
+const (
+	similarItemVariable       = "_similar"
+	similarConfidenceVariable = "_confidence"
+)
+
+// sparqlVariableName matches a variable name, without its leading ? or $.
+var sparqlVariableName = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// SimilarityModelSpec specifies the similarity model created by [SimilarityService.CreateModel].
+type SimilarityModelSpec struct {
+	// The IRI of the model
+	Model string
+	// The variables, bound by Where, holding the features items are compared by, such as sets
+	// aggregated with spa:set. A leading ? is optional.
+	Arguments []string
+	// The variable, bound by Where, holding the item each solution describes. A leading ? is optional.
+	Predict string
+	// The body of the WHERE clause, yielding one solution per item with Arguments and Predict bound,
+	// e.g. a subquery grouping by the item.
+	Where string
+	// Replace the model if it already exists
+	Overwrite bool
+}
+
+// SimilarityQuery specifies the items [SimilarityService.FindSimilar] finds similar items to.
+type SimilarityQuery struct {
+	// The IRI of the model
+	Model string
+	// The variables, bound by Where, holding the features of the item to compare. They must be in the
+	// same order as the model's arguments. A leading ? is optional.
+	Arguments []string
+	// The body of the WHERE clause binding Arguments, typically the model's Where restricted to one item
+	Where string
+	// The maximum number of similar items to return. If zero, the server's default is used.
+	Limit int
+}
+
+// SimilarItem is an item found by [SimilarityService.FindSimilar].
+type SimilarItem struct {
+	// The item
+	Item BindingValue
+	// How similar the item is, from 0 to 1
+	Confidence float64
+}
+
+// CreateModel trains a similarity model over the items selected by spec.Where and stores it in the database.
+//
+// Stardog API: https://docs.stardog.com/machine-learning/similarity-search#model-creation
+func (s *SimilarityService) CreateModel(ctx context.Context, database string, spec *SimilarityModelSpec) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	if err := spec.validate(); err != nil {
+		return nil, err
+	}
+	var b strings.Builder
+	b.WriteString(spaPrefix)
+	b.WriteString("INSERT {\n  GRAPH spa:model {\n")
+	fmt.Fprintf(&b, "    %s a spa:SimilarityModel ;\n", quoteIRI(spec.Model))
+	fmt.Fprintf(&b, "      spa:arguments %s ;\n", sparqlVariableList(spec.Arguments))
+	if spec.Overwrite {
+		b.WriteString("      spa:overwrite true ;\n")
+	}
+	fmt.Fprintf(&b, "      spa:predict ?%s .\n  }\n}\n", strings.TrimLeft(spec.Predict, "?$"))
+	fmt.Fprintf(&b, "WHERE {\n%s\n}", spec.Where)
+	return s.client.Sparql.Update(ctx, database, b.String(), nil)
+}
+
+// ListModels returns the IRIs of the predictive analytics models, of any kind, stored in the database.
+//
+// Stardog API: https://docs.stardog.com/machine-learning/predictive-analytics#model-management
+func (s *SimilarityService) ListModels(ctx context.Context, database string) ([]string, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
+	query := spaPrefix + "SELECT DISTINCT ?model { GRAPH spa:model { ?model a spa:Model } }"
+	results, resp, err := s.client.Sparql.SelectResultSet(withoutSelectLimit(ctx), database, query, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+	models := make([]string, 0, len(results.Bindings))
+	for _, binding := range results.Bindings {
+		if model, ok := binding["model"]; ok {
+			models = append(models, model.Value)
+		}
+	}
+	return models, resp, nil
+}
+
+// DeleteModel removes the model from the database.
+//
+// Stardog API: https://docs.stardog.com/machine-learning/predictive-analytics#model-management
+func (s *SimilarityService) DeleteModel(ctx context.Context, database string, model string) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	if model == "" {
+		return nil, &ValidationError{Field: "model", Value: model, Constraint: "must not be empty"}
+	}
+	update := spaPrefix + fmt.Sprintf("DELETE DATA { GRAPH spa:model { %s a spa:Model } }", quoteIRI(model))
+	return s.client.Sparql.Update(ctx, database, update, nil)
+}
+
+// FindSimilar returns the items most similar to the one described by query, most similar first.
+//
+// Stardog API: https://docs.stardog.com/machine-learning/similarity-search#similarity-queries
+func (s *SimilarityService) FindSimilar(ctx context.Context, database string, query *SimilarityQuery) ([]SimilarItem, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
+	if err := query.validate(); err != nil {
+		return nil, nil, err
+	}
+	results, resp, err := s.client.Sparql.SelectResultSet(ctx, database, query.sparql(), nil)
+	if err != nil {
+		return nil, resp, err
+	}
+	items := make([]SimilarItem, 0, len(results.Bindings))
+	for _, binding := range results.Bindings {
+		item, ok := binding[similarItemVariable]
+		if !ok {
+			continue
+		}
+		similar := SimilarItem{Item: item}
+		if confidence, ok := binding[similarConfidenceVariable]; ok {
+			if similar.Confidence, err = strconv.ParseFloat(confidence.Value, 64); err != nil {
+				return nil, resp, fmt.Errorf("parsing confidence of %s: %w", item.Value, err)
+			}
+		}
+		items = append(items, similar)
+	}
+	return items, resp, nil
+}
+
+// sparql returns the SELECT query for the similar items.
+func (q *SimilarityQuery) sparql() string {
+	var b strings.Builder
+	b.WriteString(spaPrefix)
+	fmt.Fprintf(&b, "SELECT ?%s ?%s\nWHERE {\n  GRAPH spa:model {\n", similarItemVariable, similarConfidenceVariable)
+	fmt.Fprintf(&b, "    %s spa:arguments %s ;\n", quoteIRI(q.Model), sparqlVariableList(q.Arguments))
+	fmt.Fprintf(&b, "      spa:confidence ?%s ;\n", similarConfidenceVariable)
+	if q.Limit > 0 {
+		fmt.Fprintf(&b, "      spa:parameters [ spa:limit %d ] ;\n", q.Limit)
+	}
+	fmt.Fprintf(&b, "      spa:predict ?%s .\n  }\n", similarItemVariable)
+	fmt.Fprintf(&b, "  {\n%s\n  }\n}\nORDER BY DESC(?%s)", q.Where, similarConfidenceVariable)
+	return b.String()
+}
+
+// sparqlVariableList returns the variables as a SPARQL collection, e.g. (?a ?b).
+func sparqlVariableList(variables []string) string {
+	quoted := make([]string, len(variables))
+	for i, variable := range variables {
+		quoted[i] = "?" + strings.TrimLeft(variable, "?$")
+	}
+	return "(" + strings.Join(quoted, " ") + ")"
+}
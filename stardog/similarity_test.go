@@ -0,0 +1,205 @@
+package stardog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSimilarityService_CreateModel(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var got string
+	mux.HandleFunc("/db1/update", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		got = r.URL.Query().Get("query")
+	})
+
+	ctx := context.Background()
+	spec := &SimilarityModelSpec{
+		Model:     "urn:models:movies",
+		Arguments: []string{"?genres", "directors"},
+		Predict:   "?movie",
+		Where:     "SELECT (spa:set(?genre) AS ?genres) (spa:set(?director) AS ?directors) ?movie { ?movie :genre ?genre ; :director ?director } GROUP BY ?movie",
+		Overwrite: true,
+	}
+	if _, err := client.Similarity.CreateModel(ctx, "db1", spec); err != nil {
+		t.Fatalf("Similarity.CreateModel returned error: %v", err)
+	}
+	want := `PREFIX spa: <tag:stardog:api:analytics:>
+INSERT {
+  GRAPH spa:model {
+    <urn:models:movies> a spa:SimilarityModel ;
+      spa:arguments (?genres ?directors) ;
+      spa:overwrite true ;
+      spa:predict ?movie .
+  }
+}
+WHERE {
+` + spec.Where + `
+}`
+	if got != want {
+		t.Errorf("Similarity.CreateModel update = %s, want %s", got, want)
+	}
+
+	const methodName = "CreateModel"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Similarity.CreateModel(nil, "db1", spec)
+	})
+}
+
+func TestSimilarityService_CreateModel_invalid(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	valid := SimilarityModelSpec{Model: "urn:m", Arguments: []string{"a"}, Predict: "item", Where: "?item :a ?a"}
+	tests := map[string]func(*SimilarityModelSpec){
+		"no model":           func(s *SimilarityModelSpec) { s.Model = "" },
+		"no where":           func(s *SimilarityModelSpec) { s.Where = "" },
+		"no arguments":       func(s *SimilarityModelSpec) { s.Arguments = nil },
+		"invalid argument":   func(s *SimilarityModelSpec) { s.Arguments = []string{"?a) ; :b (?c"} },
+		"invalid prediction": func(s *SimilarityModelSpec) { s.Predict = "" },
+	}
+	for name, modify := range tests {
+		t.Run(name, func(t *testing.T) {
+			spec := valid
+			modify(&spec)
+			_, err := client.Similarity.CreateModel(context.Background(), "db1", &spec)
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Errorf("Similarity.CreateModel error = %v, want *ValidationError", err)
+			}
+		})
+	}
+	if _, err := client.Similarity.CreateModel(context.Background(), "db1", nil); err == nil {
+		t.Errorf("Similarity.CreateModel returned nil error for a nil spec")
+	}
+}
+
+func TestSimilarityService_ListModels(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/db1/query", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if query := r.URL.Query().Get("query"); !strings.Contains(query, "GRAPH spa:model { ?model a spa:Model }") {
+			t.Errorf("query = %s, want models of the spa:model graph", query)
+		}
+		fmt.Fprint(w, `{"head":{"vars":["model"]},"results":{"bindings":[
+			{"model":{"type":"uri","value":"urn:models:movies"}},
+			{"model":{"type":"uri","value":"urn:models:books"}}
+		]}}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Similarity.ListModels(ctx, "db1")
+	if err != nil {
+		t.Fatalf("Similarity.ListModels returned error: %v", err)
+	}
+	if want := []string{"urn:models:movies", "urn:models:books"}; !cmp.Equal(got, want) {
+		t.Errorf("Similarity.ListModels = %v, want %v", got, want)
+	}
+
+	const methodName = "ListModels"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Similarity.ListModels(nil, "db1")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSimilarityService_DeleteModel(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/db1/update", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		want := "PREFIX spa: <tag:stardog:api:analytics:>\nDELETE DATA { GRAPH spa:model { <urn:models:movies> a spa:Model } }"
+		if got := r.URL.Query().Get("query"); got != want {
+			t.Errorf("update = %s, want %s", got, want)
+		}
+	})
+
+	ctx := context.Background()
+	if _, err := client.Similarity.DeleteModel(ctx, "db1", "urn:models:movies"); err != nil {
+		t.Errorf("Similarity.DeleteModel returned error: %v", err)
+	}
+	if _, err := client.Similarity.DeleteModel(ctx, "db1", ""); err == nil {
+		t.Errorf("Similarity.DeleteModel returned nil error for an empty model")
+	}
+
+	const methodName = "DeleteModel"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Similarity.DeleteModel(nil, "db1", "urn:models:movies")
+	})
+}
+
+func TestSimilarityService_FindSimilar(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	query := &SimilarityQuery{
+		Model:     "urn:models:movies",
+		Arguments: []string{"genres", "directors"},
+		Where:     "SELECT (spa:set(?genre) AS ?genres) (spa:set(?director) AS ?directors) { :Star_Wars :genre ?genre ; :director ?director }",
+		Limit:     2,
+	}
+	mux.HandleFunc("/db1/query", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		want := `PREFIX spa: <tag:stardog:api:analytics:>
+SELECT ?_similar ?_confidence
+WHERE {
+  GRAPH spa:model {
+    <urn:models:movies> spa:arguments (?genres ?directors) ;
+      spa:confidence ?_confidence ;
+      spa:parameters [ spa:limit 2 ] ;
+      spa:predict ?_similar .
+  }
+  {
+` + query.Where + `
+  }
+}
+ORDER BY DESC(?_confidence)`
+		if got := r.URL.Query().Get("query"); got != want {
+			t.Errorf("query = %s, want %s", got, want)
+		}
+		fmt.Fprint(w, `{"head":{"vars":["_similar","_confidence"]},"results":{"bindings":[
+			{"_similar":{"type":"uri","value":"urn:movies:empire"},"_confidence":{"type":"literal","value":"0.9","datatype":"http://www.w3.org/2001/XMLSchema#double"}},
+			{"_similar":{"type":"uri","value":"urn:movies:jedi"},"_confidence":{"type":"literal","value":"0.75","datatype":"http://www.w3.org/2001/XMLSchema#double"}}
+		]}}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Similarity.FindSimilar(ctx, "db1", query)
+	if err != nil {
+		t.Fatalf("Similarity.FindSimilar returned error: %v", err)
+	}
+	want := []SimilarItem{
+		{Item: BindingValue{Type: "uri", Value: "urn:movies:empire"}, Confidence: 0.9},
+		{Item: BindingValue{Type: "uri", Value: "urn:movies:jedi"}, Confidence: 0.75},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Similarity.FindSimilar = %+v, want %+v", got, want)
+	}
+
+	if _, _, err := client.Similarity.FindSimilar(ctx, "db1", &SimilarityQuery{Model: "urn:m", Where: "?s ?p ?o", Limit: -1, Arguments: []string{"o"}}); err == nil {
+		t.Errorf("Similarity.FindSimilar returned nil error for a negative limit")
+	}
+
+	const methodName = "FindSimilar"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Similarity.FindSimilar(nil, "db1", query)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
@@ -0,0 +1,47 @@
+package stardog
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// SPARQLEndpoints are the standard SPARQL protocol endpoints of a database, for use with generic
+// SPARQL tooling that speaks the protocols directly.
+type SPARQLEndpoints struct {
+	// URL of the [SPARQL 1.1 Protocol] query endpoint
+	//
+	// [SPARQL 1.1 Protocol]: https://www.w3.org/TR/sparql11-protocol/
+	Query string
+	// URL of the SPARQL 1.1 Protocol update endpoint
+	Update string
+	// URL of the [SPARQL 1.1 Graph Store HTTP Protocol] endpoint. Identify the graph with the
+	// "graph" query parameter, or "default" for the default graph.
+	//
+	// [SPARQL 1.1 Graph Store HTTP Protocol]: https://www.w3.org/TR/sparql11-http-rdf-update/
+	GraphStore string
+	// An http.Client that authenticates requests to the endpoints the same way the Client does
+	HTTPClient *http.Client
+}
+
+// SPARQLEndpoints returns the SPARQL protocol endpoints of the database, resolved against the Client's
+// server or the database's endpoint override (see [Client.SetDatabaseEndpoint]), along with the http.Client
+// that sends the Client's requests to them.
+func (c *Client) SPARQLEndpoints(database string) (*SPARQLEndpoints, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	dbPath := url.PathEscape(database)
+	baseURL := c.baseURLFor(dbPath + forwardSlash)
+	resolve := func(path string) *url.URL {
+		return baseURL.ResolveReference(&url.URL{Path: path})
+	}
+
+	graphStore := resolve(dbPath)
+	httpClient := *c.httpClientFor(&http.Request{URL: graphStore})
+	return &SPARQLEndpoints{
+		Query:      resolve(dbPath + "/query").String(),
+		Update:     resolve(dbPath + "/update").String(),
+		GraphStore: graphStore.String(),
+		HTTPClient: &httpClient,
+	}, nil
+}
@@ -0,0 +1,49 @@
+package stardog
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestClient_SPARQLEndpoints(t *testing.T) {
+	transport := &BasicAuthTransport{Username: "admin", Password: "admin"}
+	client, _ := NewClient("http://localhost:5820/stardog", transport.Client())
+
+	got, err := client.SPARQLEndpoints("db1")
+	if err != nil {
+		t.Fatalf("Client.SPARQLEndpoints returned error: %v", err)
+	}
+	if got.Query != "http://localhost:5820/stardog/db1/query" {
+		t.Errorf("SPARQLEndpoints.Query = %v", got.Query)
+	}
+	if got.Update != "http://localhost:5820/stardog/db1/update" {
+		t.Errorf("SPARQLEndpoints.Update = %v", got.Update)
+	}
+	if got.GraphStore != "http://localhost:5820/stardog/db1" {
+		t.Errorf("SPARQLEndpoints.GraphStore = %v", got.GraphStore)
+	}
+	if got.HTTPClient.Transport != transport {
+		t.Errorf("SPARQLEndpoints.HTTPClient does not use the Client's transport")
+	}
+
+	replicaClient := &http.Client{Transport: &BearerAuthTransport{BearerToken: "token"}}
+	if err := client.SetDatabaseEndpoint("db2", DatabaseEndpoint{ServerURL: "https://replica:5820", HTTPClient: replicaClient}); err != nil {
+		t.Fatalf("Client.SetDatabaseEndpoint returned error: %v", err)
+	}
+	got, err = client.SPARQLEndpoints("db2")
+	if err != nil {
+		t.Fatalf("Client.SPARQLEndpoints returned error: %v", err)
+	}
+	if got.Query != "https://replica:5820/db2/query" {
+		t.Errorf("SPARQLEndpoints.Query = %v, want the replica", got.Query)
+	}
+	if got.HTTPClient.Transport != replicaClient.Transport {
+		t.Errorf("SPARQLEndpoints.HTTPClient does not use the endpoint's transport")
+	}
+
+	var validationErr *ValidationError
+	if _, err := client.SPARQLEndpoints(""); !errors.As(err, &validationErr) {
+		t.Errorf("Client.SPARQLEndpoints error = %v, want *ValidationError", err)
+	}
+}
@@ -0,0 +1,154 @@
+package stardog
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// literalEscaper escapes the characters that can't appear unescaped in a quoted SPARQL or Turtle string,
+// using the escape sequences of the ECHAR production.
+var literalEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	`'`, `\'`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+	"\b", `\b`,
+	"\f", `\f`,
+)
+
+// EscapeLiteral escapes s so it can be placed between the quotes of a SPARQL string literal, whether it's
+// enclosed in single or double quotes. Backslashes, quotes, and line breaks and other whitespace control
+// characters are escaped; other Unicode characters are left as is, since queries are UTF-8.
+//
+//	query := `SELECT ?s { ?s rdfs:label "` + stardog.EscapeLiteral(label) + `" }`
+func EscapeLiteral(s string) string {
+	return literalEscaper.Replace(s)
+}
+
+func quoteLiteral(s string) string {
+	return `"` + EscapeLiteral(s) + `"`
+}
+
+// EscapeIRI escapes iri so it can be placed between the angle brackets of a SPARQL IRI reference.
+// Characters that aren't allowed in an IRI reference (spaces and other control characters, and
+// <>"{}|^`\) are percent-encoded.
+func EscapeIRI(iri string) string {
+	var b strings.Builder
+	for i := 0; i < len(iri); i++ {
+		c := iri[i]
+		if c <= ' ' || c == 0x7f || strings.IndexByte("<>\"{}|^`\\", c) >= 0 {
+			fmt.Fprintf(&b, "%%%02X", c)
+			continue
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+func quoteIRI(iri string) string {
+	return "<" + EscapeIRI(iri) + ">"
+}
+
+// FormatValue returns the SPARQL syntax of a Go value, for placing values in queries without risking
+// syntax errors or injection:
+//
+//   - a string is a string literal
+//   - a *url.URL or url.URL is an IRI
+//   - a bool, integer or floating-point number is an xsd:boolean, xsd:integer or xsd:double literal
+//   - a time.Time is an xsd:dateTime literal
+//   - a BindingValue is the IRI, literal, blank node or quoted triple it represents
+//   - a [Term] is itself
+//
+// An error is returned for values of any other type.
+func FormatValue(v any) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return quoteLiteral(v), nil
+	case *url.URL:
+		if v == nil {
+			return "", fmt.Errorf("unable to format a nil *url.URL")
+		}
+		return quoteIRI(v.String()), nil
+	case url.URL:
+		return quoteIRI(v.String()), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int8:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int16:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int32:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case uint:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint8:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint16:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint64:
+		return strconv.FormatUint(v, 10), nil
+	case float32:
+		return formatDouble(float64(v)), nil
+	case float64:
+		return formatDouble(v), nil
+	case time.Time:
+		return quoteLiteral(v.Format(time.RFC3339Nano)) + "^^" + quoteIRI(xsdNamespace+"dateTime"), nil
+	case Term:
+		return formatTerm(v)
+	case BindingValue:
+		return formatBindingValue(v)
+	case *BindingValue:
+		if v == nil {
+			return "", fmt.Errorf("unable to format a nil *BindingValue")
+		}
+		return formatBindingValue(*v)
+	default:
+		return "", fmt.Errorf("unable to format a value of type %T as SPARQL", v)
+	}
+}
+
+// formatDouble returns the SPARQL syntax of an xsd:double, which has no shorthand for NaN and infinities.
+func formatDouble(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return `"NaN"^^` + quoteIRI(xsdNamespace+"double")
+	case math.IsInf(f, 1):
+		return `"INF"^^` + quoteIRI(xsdNamespace+"double")
+	case math.IsInf(f, -1):
+		return `"-INF"^^` + quoteIRI(xsdNamespace+"double")
+	}
+	// the shorthand for doubles requires an exponent
+	return strconv.FormatFloat(f, 'E', -1, 64)
+}
+
+// formatTerm returns the syntax of a term, checking that a quoted triple's terms are allowed in their positions.
+func formatTerm(t Term) (string, error) {
+	if triple, ok := t.(Triple); ok {
+		if err := triple.validate(); err != nil {
+			return "", err
+		}
+	}
+	return t.String(), nil
+}
+
+// formatBindingValue returns the syntax of the term a BindingValue represents. It's written by the term's
+// String method, which sanitizes blank node labels and language tags, since they can't be escaped.
+func formatBindingValue(t BindingValue) (string, error) {
+	term, err := t.Term()
+	if err != nil {
+		return "", err
+	}
+	return formatTerm(term)
+}
@@ -0,0 +1,140 @@
+package stardog
+
+import (
+	"math"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEscapeLiteral(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"plain", "plain"},
+		{`say "hi"`, `say \"hi\"`},
+		{"O'Brien", `O\'Brien`},
+		{`C:\temp`, `C:\\temp`},
+		{"line1\nline2\r\n", `line1\nline2\r\n`},
+		{"tab\tbs\bff\f", `tab\tbs\bff\f`},
+		{"unicode: héllo 世界 🎉", "unicode: héllo 世界 🎉"},
+		{`" } ; DROP ALL #`, `\" } ; DROP ALL #`},
+	}
+	for _, tt := range tests {
+		if got := EscapeLiteral(tt.in); got != tt.want {
+			t.Errorf("EscapeLiteral(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestEscapeIRI(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"http://example.com/a#b", "http://example.com/a#b"},
+		{"http://example.com/a b", "http://example.com/a%20b"},
+		{"http://example.com/> } DROP ALL", "http://example.com/%3E%20%7D%20DROP%20ALL"},
+		{"http://example.com/{x}|^`\\\"<", "http://example.com/%7Bx%7D%7C%5E%60%5C%22%3C"},
+		{"http://example.com/\n\x7f", "http://example.com/%0A%7F"},
+		{"http://example.com/世界", "http://example.com/世界"},
+	}
+	for _, tt := range tests {
+		if got := EscapeIRI(tt.in); got != tt.want {
+			t.Errorf("EscapeIRI(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestFormatValue(t *testing.T) {
+	u, _ := url.Parse("http://example.com/a b")
+	tests := []struct {
+		name string
+		in   any
+		want string
+	}{
+		{"string", `a "quote"`, `"a \"quote\""`},
+		{"url pointer", u, "<http://example.com/a%20b>"},
+		{"url", *u, "<http://example.com/a%20b>"},
+		{"bool", true, "true"},
+		{"int", -42, "-42"},
+		{"int8", int8(8), "8"},
+		{"uint64", uint64(math.MaxUint64), "18446744073709551615"},
+		{"float64", 1.5, "1.5E+00"},
+		{"float32", float32(0.25), "2.5E-01"},
+		{"NaN", math.NaN(), `"NaN"^^<http://www.w3.org/2001/XMLSchema#double>`},
+		{"infinity", math.Inf(-1), `"-INF"^^<http://www.w3.org/2001/XMLSchema#double>`},
+		{"time", time.Date(2023, 4, 5, 6, 7, 8, 9, time.UTC), `"2023-04-05T06:07:08.000000009Z"^^<http://www.w3.org/2001/XMLSchema#dateTime>`},
+		{"uri binding", BindingValue{Type: "uri", Value: "http://example.com/x"}, "<http://example.com/x>"},
+		{"bnode binding", BindingValue{Type: "bnode", Value: "b0"}, "_:b0"},
+		{"lang binding", &BindingValue{Type: "literal", Value: "Paul", Lang: "en"}, `"Paul"@en`},
+		{"string binding", BindingValue{Type: "literal", Value: "x", Datatype: xsdNamespace + "string"}, `"x"`},
+		{"typed binding", BindingValue{Type: "literal", Value: "42", Datatype: xsdNamespace + "integer"}, `"42"^^<http://www.w3.org/2001/XMLSchema#integer>`},
+		{
+			"triple binding",
+			BindingValue{Type: "triple", Triple: &QuotedTriple{
+				Subject:   BindingValue{Type: "uri", Value: "http://example.com/s"},
+				Predicate: BindingValue{Type: "uri", Value: "http://example.com/p"},
+				Object:    BindingValue{Type: "literal", Value: "o"},
+			}},
+			`<< <http://example.com/s> <http://example.com/p> "o" >>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatValue(tt.in)
+			if err != nil {
+				t.Fatalf("FormatValue returned error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("FormatValue = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatValue_hostileBinding(t *testing.T) {
+	tests := []struct {
+		name string
+		in   BindingValue
+		want string
+	}{
+		{"bnode", BindingValue{Type: "bnode", Value: "x } ; DROP ALL #"}, "_:x_____DROP_ALL__"},
+		{"lang", BindingValue{Type: "literal", Value: "a", Lang: "en } DROP ALL # }"}, `"a"@enDROPALL`},
+		{
+			"quoted triple",
+			BindingValue{Type: "triple", Triple: &QuotedTriple{
+				Subject:   BindingValue{Type: "bnode", Value: "s>> } DROP ALL #"},
+				Predicate: BindingValue{Type: "uri", Value: "http://example.com/p"},
+				Object:    BindingValue{Type: "literal", Value: "o", Lang: "en\n} DROP ALL"},
+			}},
+			`<< _:s_____DROP_ALL__ <http://example.com/p> "o"@enDROPALL >>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatValue(tt.in)
+			if err != nil {
+				t.Fatalf("FormatValue returned error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("FormatValue = %s, want %s", got, tt.want)
+			}
+			query := "SELECT * { ?s ?p " + got + " }"
+			if strings.Contains(query, "} ;") || strings.Contains(query, "DROP ALL") {
+				t.Errorf("FormatValue = %s, which injects into %s", got, query)
+			}
+		})
+	}
+}
+
+func TestFormatValue_unsupported(t *testing.T) {
+	var nilURL *url.URL
+	for _, in := range []any{nil, []string{"a"}, struct{}{}, nilURL, BindingValue{Type: "triple"}, BindingValue{Type: "unknown"}} {
+		if got, err := FormatValue(in); err == nil {
+			t.Errorf("FormatValue(%#v) = %s, want error", in, got)
+		}
+	}
+}
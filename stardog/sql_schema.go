@@ -0,0 +1,87 @@
+package stardog
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+)
+
+// defaultSQLSchemaGraph is the graph in which Stardog's BI server looks for a database's SQL schema
+// mappings if the database's sql.schema.graph option isn't set.
+const defaultSQLSchemaGraph = "tag:stardog:api:sql:schema"
+
+// sqlSchemaGraphOption is the database option naming the graph the SQL schema mappings are stored in.
+const sqlSchemaGraphOption = "sql.schema.graph"
+
+// SQLSchemaGraph returns the graph storing the [SQL schema] mappings of a database that Stardog's BI server
+// exposes to SQL clients, from the database's sql.schema.graph option.
+//
+// [SQL schema]: https://docs.stardog.com/query-stardog/stardog-bi-sql-server#schema-mapping
+func (s *DatabaseAdminService) SQLSchemaGraph(ctx context.Context, database string) (string, *Response, error) {
+	metadata, resp, err := s.Metadata(ctx, database, []string{sqlSchemaGraphOption})
+	if err != nil {
+		return "", resp, err
+	}
+	if graph, ok := metadata[sqlSchemaGraphOption].(string); ok && graph != "" {
+		return graph, resp, nil
+	}
+	return defaultSQLSchemaGraph, resp, nil
+}
+
+// GenerateSQLSchema generates SQL schema mappings for a database from its reasoning model, as the BI server
+// does when no mappings are stored. The mappings are RDF (Turtle); edit them as needed and store them with
+// [DatabaseAdminService.SetSQLSchema], or use [DatabaseAdminService.RegenerateSQLSchema] to do both.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/DB-Admin/operation/generateModel
+func (s *DatabaseAdminService) GenerateSQLSchema(ctx context.Context, database string, reasoning bool) (*bytes.Buffer, *Response, error) {
+	return s.DataModel(ctx, database, &DataModelOptions{Reasoning: reasoning, OutputFormat: DataModelFormatSQL})
+}
+
+// SQLSchema returns the SQL schema mappings stored for a database, in the given RDF format. It's empty if
+// none are stored, in which case the BI server generates the schema.
+func (s *DatabaseAdminService) SQLSchema(ctx context.Context, database string, format RDFFormat) (*bytes.Buffer, *Response, error) {
+	graph, resp, err := s.SQLSchemaGraph(ctx, database)
+	if err != nil {
+		return nil, resp, err
+	}
+	return s.ExportData(ctx, database, &ExportDataOptions{NamedGraph: []string{graph}, Format: format})
+}
+
+// SetSQLSchema replaces the SQL schema mappings stored for a database with the RDF read from schema, in a
+// single transaction, so SQL clients never see a partial schema.
+func (s *DatabaseAdminService) SetSQLSchema(ctx context.Context, database string, schema io.Reader, format RDFFormat) (*Response, error) {
+	if !format.Valid() {
+		return nil, &ValidationError{Field: "format", Value: format, Constraint: "must be a known value"}
+	}
+	graph, resp, err := s.SQLSchemaGraph(ctx, database)
+	if err != nil {
+		return resp, err
+	}
+
+	txID, resp, err := s.client.Transaction.Begin(ctx, database)
+	if err != nil {
+		return resp, err
+	}
+	clearGraph := fmt.Sprintf("CLEAR SILENT GRAPH %s", quoteIRI(graph))
+	if resp, err := s.client.Sparql.Update(ctx, database, clearGraph, &UpdateOptions{TxID: txID}); err != nil {
+		s.client.Transaction.Rollback(ctx, database, txID)
+		return resp, err
+	}
+	if resp, err := s.client.Transaction.Add(ctx, database, txID, schema, format, &TransactionAddOptions{NamedGraph: graph}); err != nil {
+		s.client.Transaction.Rollback(ctx, database, txID)
+		return resp, err
+	}
+	return s.client.Transaction.Commit(ctx, database, txID)
+}
+
+// RegenerateSQLSchema generates SQL schema mappings for a database from its current reasoning model with
+// [DatabaseAdminService.GenerateSQLSchema] and stores them with [DatabaseAdminService.SetSQLSchema], e.g.
+// after the model has changed.
+func (s *DatabaseAdminService) RegenerateSQLSchema(ctx context.Context, database string, reasoning bool) (*Response, error) {
+	schema, resp, err := s.GenerateSQLSchema(ctx, database, reasoning)
+	if err != nil {
+		return resp, err
+	}
+	return s.SetSQLSchema(ctx, database, schema, RDFFormatTurtle)
+}
@@ -0,0 +1,213 @@
+package stardog
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const sqlSchemaTurtle = `@prefix sql: <tag:stardog:api:sql:> .
+<tag:stardog:api:sql:schema:person> a sql:TableMapping .
+`
+
+func handleSQLSchemaGraphOption(t *testing.T, mux *http.ServeMux, graph string) {
+	t.Helper()
+	mux.HandleFunc("/admin/databases/db1/options", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"sql.schema.graph":""}`+"\n")
+		fmt.Fprintf(w, `{"sql.schema.graph": %q}`, graph)
+	})
+}
+
+func TestDatabaseAdminService_SQLSchemaGraph(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleSQLSchemaGraphOption(t, mux, "urn:bi")
+
+	ctx := context.Background()
+	got, _, err := client.DatabaseAdmin.SQLSchemaGraph(ctx, "db1")
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.SQLSchemaGraph returned error: %v", err)
+	}
+	if want := "urn:bi"; got != want {
+		t.Errorf("DatabaseAdmin.SQLSchemaGraph = %q, want %q", got, want)
+	}
+
+	const methodName = "SQLSchemaGraph"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.DatabaseAdmin.SQLSchemaGraph(ctx, "")
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.DatabaseAdmin.SQLSchemaGraph(nil, "db1")
+		if got != "" {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want empty", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestDatabaseAdminService_SQLSchemaGraph_default(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleSQLSchemaGraphOption(t, mux, "")
+
+	got, _, err := client.DatabaseAdmin.SQLSchemaGraph(context.Background(), "db1")
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.SQLSchemaGraph returned error: %v", err)
+	}
+	if got != defaultSQLSchemaGraph {
+		t.Errorf("DatabaseAdmin.SQLSchemaGraph = %q, want %q", got, defaultSQLSchemaGraph)
+	}
+}
+
+func TestDatabaseAdminService_GenerateSQLSchema(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/db1/model", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.URL.RawQuery; got != "output=sql&reasoning=true" {
+			t.Errorf("query parameters = %q, want %q", got, "output=sql&reasoning=true")
+		}
+		w.Write([]byte(sqlSchemaTurtle))
+	})
+
+	got, _, err := client.DatabaseAdmin.GenerateSQLSchema(context.Background(), "db1", true)
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.GenerateSQLSchema returned error: %v", err)
+	}
+	if got.String() != sqlSchemaTurtle {
+		t.Errorf("DatabaseAdmin.GenerateSQLSchema = %q, want %q", got.String(), sqlSchemaTurtle)
+	}
+}
+
+func TestDatabaseAdminService_SQLSchema(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleSQLSchemaGraphOption(t, mux, "urn:bi")
+	mux.HandleFunc("/db1/export", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", RDFFormatTurtle.String())
+		if got := r.URL.Query().Get("named-graph-uri"); got != "urn:bi" {
+			t.Errorf("named-graph-uri = %q, want %q", got, "urn:bi")
+		}
+		w.Write([]byte(sqlSchemaTurtle))
+	})
+
+	ctx := context.Background()
+	got, _, err := client.DatabaseAdmin.SQLSchema(ctx, "db1", RDFFormatTurtle)
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.SQLSchema returned error: %v", err)
+	}
+	if got.String() != sqlSchemaTurtle {
+		t.Errorf("DatabaseAdmin.SQLSchema = %q, want %q", got.String(), sqlSchemaTurtle)
+	}
+
+	testNewRequestAndDoFailure(t, "SQLSchema", client, func() (*Response, error) {
+		_, resp, err := client.DatabaseAdmin.SQLSchema(nil, "db1", RDFFormatTurtle)
+		return resp, err
+	})
+}
+
+// handleSQLSchemaTransaction records the requests made to replace the SQL schema in a transaction.
+func handleSQLSchemaTransaction(t *testing.T, mux *http.ServeMux, calls *[]string, failAdd bool) {
+	t.Helper()
+	mux.HandleFunc("/db1/transaction/begin", func(w http.ResponseWriter, r *http.Request) {
+		*calls = append(*calls, "begin")
+		w.Write([]byte("tx1"))
+	})
+	mux.HandleFunc("/db1/tx1/update", func(w http.ResponseWriter, r *http.Request) {
+		*calls = append(*calls, "update "+r.URL.Query().Get("query"))
+	})
+	mux.HandleFunc("/db1/tx1/add", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Content-Type", RDFFormatTurtle.String())
+		body, _ := io.ReadAll(r.Body)
+		*calls = append(*calls, fmt.Sprintf("add %s %d", r.URL.Query().Get("graph-uri"), len(body)))
+		if failAdd {
+			http.Error(w, `{"message": "parse error"}`, http.StatusBadRequest)
+		}
+	})
+	mux.HandleFunc("/db1/transaction/commit/tx1", func(w http.ResponseWriter, r *http.Request) {
+		*calls = append(*calls, "commit")
+	})
+	mux.HandleFunc("/db1/transaction/rollback/tx1", func(w http.ResponseWriter, r *http.Request) {
+		*calls = append(*calls, "rollback")
+	})
+}
+
+func TestDatabaseAdminService_SetSQLSchema(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleSQLSchemaGraphOption(t, mux, "urn:bi")
+	var calls []string
+	handleSQLSchemaTransaction(t, mux, &calls, false)
+
+	ctx := context.Background()
+	_, err := client.DatabaseAdmin.SetSQLSchema(ctx, "db1", strings.NewReader(sqlSchemaTurtle), RDFFormatTurtle)
+	if err != nil {
+		t.Fatalf("DatabaseAdmin.SetSQLSchema returned error: %v", err)
+	}
+	want := []string{"begin", "update CLEAR SILENT GRAPH <urn:bi>", fmt.Sprintf("add urn:bi %d", len(sqlSchemaTurtle)), "commit"}
+	if !cmp.Equal(calls, want) {
+		t.Errorf("DatabaseAdmin.SetSQLSchema requests = %q, want %q", calls, want)
+	}
+
+	testBadOptions(t, "SetSQLSchema", func() (err error) {
+		_, err = client.DatabaseAdmin.SetSQLSchema(ctx, "db1", strings.NewReader(""), RDFFormatUnknown)
+		return err
+	})
+}
+
+func TestDatabaseAdminService_SetSQLSchema_rollback(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleSQLSchemaGraphOption(t, mux, "urn:bi")
+	var calls []string
+	handleSQLSchemaTransaction(t, mux, &calls, true)
+
+	_, err := client.DatabaseAdmin.SetSQLSchema(context.Background(), "db1", strings.NewReader(sqlSchemaTurtle), RDFFormatTurtle)
+	if err == nil {
+		t.Fatalf("DatabaseAdmin.SetSQLSchema returned no error, want the add's")
+	}
+	if got := calls[len(calls)-1]; got != "rollback" {
+		t.Errorf("DatabaseAdmin.SetSQLSchema last request = %q, want rollback", got)
+	}
+}
+
+func TestDatabaseAdminService_RegenerateSQLSchema(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleSQLSchemaGraphOption(t, mux, "urn:bi")
+	mux.HandleFunc("/db1/model", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("output"); got != "sql" {
+			t.Errorf("output parameter = %q, want sql", got)
+		}
+		w.Write([]byte(sqlSchemaTurtle))
+	})
+	var calls []string
+	handleSQLSchemaTransaction(t, mux, &calls, false)
+
+	ctx := context.Background()
+	if _, err := client.DatabaseAdmin.RegenerateSQLSchema(ctx, "db1", false); err != nil {
+		t.Fatalf("DatabaseAdmin.RegenerateSQLSchema returned error: %v", err)
+	}
+	if want := fmt.Sprintf("add urn:bi %d", len(sqlSchemaTurtle)); !cmp.Equal(calls[2], want) {
+		t.Errorf("DatabaseAdmin.RegenerateSQLSchema added %q, want %q", calls[2], want)
+	}
+
+	testNewRequestAndDoFailure(t, "RegenerateSQLSchema", client, func() (*Response, error) {
+		return client.DatabaseAdmin.RegenerateSQLSchema(nil, "db1", false)
+	})
+}
@@ -10,38 +10,169 @@ import (
 	"net/http"
 	"net/url"
 	"reflect"
+	"runtime/debug"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
 
 const (
-	// Version of go-stardog
+	// Version of go-stardog. It's only used when the version can't be read from the build info
+	// of the program, see [Client.Version].
 	Version = "v0.8.0"
 
 	defaultServerURL = "http://localhost:5820/"
-	defaultUserAgent = "stardog-go" + forwardSlash + Version
 	forwardSlash     = "/"
+	modulePath       = "github.com/noahgorstein/go-stardog"
 )
 
+var (
+	libraryVersion   = readLibraryVersion()
+	defaultUserAgent = "stardog-go" + forwardSlash + libraryVersion
+)
+
+// readLibraryVersion returns the version of go-stardog the running program was built with.
+func readLibraryVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return Version
+	}
+	return versionFromBuildInfo(info)
+}
+
+// versionFromBuildInfo returns the version of go-stardog recorded in the build info, or [Version] if
+// go-stardog isn't a versioned dependency (e.g. in its own tests or when replaced by a local directory).
+func versionFromBuildInfo(info *debug.BuildInfo) string {
+	module := &info.Main
+	for _, dep := range info.Deps {
+		if dep.Path == modulePath {
+			module = dep
+			break
+		}
+	}
+	if module.Path != modulePath {
+		return Version
+	}
+	if module.Replace != nil {
+		module = module.Replace
+	}
+	if module.Version == "" || module.Version == "(devel)" {
+		return Version
+	}
+	return module.Version
+}
+
 var errNonNilContext = errors.New("context must be non-nil")
 
+// ErrClientClosed is returned when a request is attempted on a Client after [Client.Close] has been called.
+var ErrClientClosed = errors.New("stardog: client is closed")
+
 // Client manages communications with the Stardog API
 type Client struct {
 	client    *http.Client
 	UserAgent string
 	baseURL   *url.URL
 
+	// Logger receives warnings from the Client, such as when a large response is buffered in memory.
+	// If nil, warnings are discarded. A *log.Logger can be used.
+	Logger Logger
+
+	// LargeResponseThreshold is the size in bytes above which a response buffered in memory by Do
+	// is logged as a warning, suggesting the streaming methods (e.g. [DatabaseAdminService.ExportDataStream]) instead.
+	// If zero, 64 MiB is used. If negative, no warnings are logged.
+	LargeResponseThreshold int64
+
+	// Retry configures retrying requests that fail with a transient error.
+	// If nil, requests are not retried.
+	Retry *RetryPolicy
+
+	// RateLimiter limits the rate of requests sent by the Client, including retries.
+	// If nil, requests are not rate limited.
+	RateLimiter RateLimiter
+
+	// OnRequest, if not nil, is called before every request is sent, including retries. It may modify the
+	// request's headers, e.g. to add tracing headers.
+	OnRequest func(req *http.Request)
+
+	// OnResponse, if not nil, is called after every request is sent, including retries, e.g. to log
+	// requests or record their latency.
+	OnResponse func(attempt RequestAttempt)
+
+	// DefaultReasoning enables reasoning for every SPARQL query and update made by the Client, as if their
+	// options enabled it. Use [WithReasoning] to override it for a request.
+	DefaultReasoning bool
+
+	// MaxSelectResults, if positive, is the most results a SELECT query made by the Client may return. It's
+	// sent as the limit parameter of queries with no SelectOptions.Limit or a larger one, and the server
+	// applies it on top of any LIMIT in the query itself, protecting shared servers from unbounded queries. It
+	// doesn't apply to the queries the Client makes itself, e.g. to list the graphs exported by ExportChunked.
+	MaxSelectResults int
+
+	// DisableDeadlineTimeout stops the Client from sending the remaining time of a context's deadline as the
+	// timeout of SPARQL queries and updates. By default it's sent, so the server stops a query once the
+	// Client has given up on it instead of leaving it running. Use [WithoutDeadlineTimeout] to opt out
+	// for a single request.
+	DisableDeadlineTimeout bool
+
+	// KillCanceledQueries makes the Client kill a SPARQL query or update on the server if its context is canceled
+	// or times out before the response arrives, rather than only dropping the connection and leaving the query
+	// running. The query is found by its database and query string with [QueryManagementService.Find], and only
+	// killed if there's a single match, so the user needs permission to list and kill queries.
+	KillCanceledQueries bool
+
+	// CheckCapabilities makes the Client check the server's version before calling a method that needs a newer
+	// server than MinimumServerVersion, such as [DataSourceService.RefreshCounts], returning an
+	// [UnsupportedVersionError] rather than the 404 an older server responds with. The server's version is
+	// requested once, the first time it's needed.
+	CheckCapabilities bool
+
+	// Codec used to encode JSON request bodies and decode JSON responses.
+	// If nil, encoding/json is used.
+	Codec Codec
+
+	// serverVersionMu guards serverVersion, the server's version once it's been requested by RequireCapability,
+	// which is nil if the server doesn't report it. serverVersionFetched is set once it's been requested.
+	serverVersionMu      sync.Mutex
+	serverVersion        *ServerVersion
+	serverVersionFetched bool
+
+	// endpointsMu guards endpoints, the per-database endpoint overrides.
+	endpointsMu sync.RWMutex
+	endpoints   map[string]databaseEndpoint
+
+	// closeMu guards closed and ensures no new in-flight requests are
+	// registered once Close has started waiting on inFlight.
+	closeMu  sync.Mutex
+	closed   bool
+	inFlight sync.WaitGroup
+
 	common service
 
 	// Services for talking to different parts of the Stardog API
-	DataSource    *DataSourceService
-	DatabaseAdmin *DatabaseAdminService
-	Role          *RoleService
-	ServerAdmin   *ServerAdminService
-	Sparql        *SPARQLService
-	Transaction   *TransactionService
-	User          *UserService
+	Cache           *CacheService
+	DataSource      *DataSourceService
+	DatabaseAdmin   *DatabaseAdminService
+	Docs            *DocsService
+	GraphQL         *GraphQLService
+	ICV             *ICVService
+	QueryManagement *QueryManagementService
+	Reasoning       *ReasoningService
+	Role            *RoleService
+	Security        *SecurityService
+	ServerAdmin     *ServerAdminService
+	Similarity      *SimilarityService
+	Sparql          *SPARQLService
+	Transaction     *TransactionService
+	User            *UserService
+	Virtual         *VirtualGraphService
+}
+
+// Version returns the version of go-stardog in use, as read from the build info of the program.
+// It's the version reported in the default UserAgent.
+func (c *Client) Version() string {
+	return libraryVersion
 }
 
 // Client returns the http.Client used by this Stardog client.
@@ -76,8 +207,9 @@ type BearerAuthTransport struct {
 }
 
 type requestHeaderOptions struct {
-	ContentType string
-	Accept      string
+	ContentType     string
+	ContentEncoding string
+	Accept          string
 }
 
 // NewClient returns a new Stardog API client. If a nil httpClient is provided, a new http.Client will be used.
@@ -97,23 +229,33 @@ func NewClient(serverURL string, httpClient *http.Client) (*Client, error) {
 
 	c := &Client{client: httpClient, baseURL: serverEndpoint, UserAgent: defaultUserAgent}
 	c.common.client = c
+	c.Cache = (*CacheService)(&c.common)
 	c.DataSource = (*DataSourceService)(&c.common)
 	c.DatabaseAdmin = (*DatabaseAdminService)(&c.common)
+	c.Docs = (*DocsService)(&c.common)
+	c.GraphQL = (*GraphQLService)(&c.common)
+	c.ICV = (*ICVService)(&c.common)
+	c.QueryManagement = (*QueryManagementService)(&c.common)
+	c.Reasoning = (*ReasoningService)(&c.common)
 	c.Role = (*RoleService)(&c.common)
+	c.Security = (*SecurityService)(&c.common)
 	c.ServerAdmin = (*ServerAdminService)(&c.common)
+	c.Similarity = (*SimilarityService)(&c.common)
 	c.Sparql = (*SPARQLService)(&c.common)
 	c.Transaction = (*TransactionService)(&c.common)
 	c.User = (*UserService)(&c.common)
+	c.Virtual = (*VirtualGraphService)(&c.common)
 	return c, nil
 }
 
 func (c *Client) NewMultipartFormDataRequest(method string, urlStr string, headerOpts *requestHeaderOptions, body any) (*http.Request, error) {
-	if !strings.HasSuffix(c.baseURL.Path, forwardSlash) {
+	baseURL := c.baseURLFor(urlStr)
+	if !strings.HasSuffix(baseURL.Path, forwardSlash) {
 		//revive:disable-next-line:error-strings
-		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", c.baseURL)
+		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", baseURL)
 	}
 
-	u, err := c.baseURL.Parse(urlStr)
+	u, err := baseURL.Parse(urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -130,38 +272,49 @@ func (c *Client) NewMultipartFormDataRequest(method string, urlStr string, heade
 				}
 				return req, err
 			}
+			if reader, ok := body.(io.Reader); ok {
+				req, err := http.NewRequest(method, u.String(), reader)
+				if err != nil {
+					return nil, err
+				}
+				req.Header.Set("Content-Type", headerOpts.ContentType)
+				if headerOpts.Accept != "" {
+					req.Header.Set("Accept", headerOpts.Accept)
+				}
+				return req, nil
+			}
 		}
 	}
 	return nil, errors.New("Missing 'Content-Type multipart/form-data' header")
 }
 
 func (c *Client) NewRequest(method string, urlStr string, headerOpts *requestHeaderOptions, body any) (*http.Request, error) {
-	if !strings.HasSuffix(c.baseURL.Path, forwardSlash) {
+	baseURL := c.baseURLFor(urlStr)
+	if !strings.HasSuffix(baseURL.Path, forwardSlash) {
 		//revive:disable-next-line:error-strings
-		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", c.baseURL)
+		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", baseURL)
 	}
 
-	u, err := c.baseURL.Parse(urlStr)
+	u, err := baseURL.Parse(urlStr)
 	if err != nil {
 		return nil, err
 	}
 
-	var buf io.ReadWriter
+	var buf io.Reader
 	if body != nil {
 		buf = &bytes.Buffer{}
 		if headerOpts != nil {
 			switch headerOpts.ContentType {
 			case mediaTypeApplicationJSON:
-				enc := json.NewEncoder(buf)
-				enc.SetEscapeHTML(false)
-				err := enc.Encode(body)
+				encoded, err := c.codec().Marshal(body)
 				if err != nil {
 					return nil, err
 				}
+				buf = bytes.NewBuffer(encoded)
 			default:
-				bodyBuf, ok := body.(*bytes.Buffer)
-				if ok {
-					buf = bodyBuf
+				// other bodies are sent as is, and streamed if they aren't a *bytes.Buffer
+				if bodyReader, ok := body.(io.Reader); ok {
+					buf = bodyReader
 				}
 			}
 		}
@@ -174,6 +327,9 @@ func (c *Client) NewRequest(method string, urlStr string, headerOpts *requestHea
 
 	if body != nil && headerOpts != nil {
 		req.Header.Set("Content-Type", headerOpts.ContentType)
+		if headerOpts.ContentEncoding != "" {
+			req.Header.Set("Content-Encoding", headerOpts.ContentEncoding)
+		}
 	}
 
 	if headerOpts != nil {
@@ -188,24 +344,93 @@ func (c *Client) NewRequest(method string, urlStr string, headerOpts *requestHea
 	return req, nil
 }
 
-// Response is a Stardog API response. This wraps the standard http.Response
+// Response is a Stardog API response. This wraps the standard http.Response. Its metadata fields are set
+// when it's created and never modified, so it can be read from multiple goroutines.
 type Response struct {
 	*http.Response
 
 	// the raw response body
 	RawBody []byte
+
+	// the number of bytes of the response body buffered in memory by Do. Zero for streamed responses.
+	Size int64
+
+	// ID of the query the request ran, from the SD-Query-Id header, if Stardog reported one. It can be passed to
+	// [QueryManagementService.Kill] to stop a query that is still running.
+	QueryID string
+
+	// ID of the request, from the SD-Request-Id or X-Request-Id header, for correlating with the server log
+	RequestID string
+
+	// version of the Stardog server, from the SD-Version header, if present
+	ServerVersion string
 }
 
-// newResponse creates a new Response for the provided http.Response.
-// r must not be nil.
+// queryIDHeader is the header in which Stardog reports the ID of the query a request ran.
+const queryIDHeader = "SD-Query-Id"
+
+// serverVersionHeader is the header in which Stardog reports its version.
+const serverVersionHeader = "SD-Version"
+
+// newResponse creates a new Response for the provided http.Response, populated with the metadata in its
+// headers. r must not be nil.
 func newResponse(r *http.Response) *Response {
-	response := &Response{Response: r}
+	response := &Response{
+		Response:      r,
+		QueryID:       r.Header.Get(queryIDHeader),
+		RequestID:     requestID(r.Header),
+		ServerVersion: r.Header.Get(serverVersionHeader),
+	}
 	return response
 }
 
+// Close stops the Client from accepting new requests, waits for in-flight requests to
+// complete and then closes any idle connections held by the underlying http.Client.
+//
+// If ctx is canceled or times out before all in-flight requests complete, idle connections
+// are still closed and ctx.Err() is returned. Requests made after Close has been called
+// return [ErrClientClosed].
+func (c *Client) Close(ctx context.Context) error {
+	if ctx == nil {
+		return errNonNilContext
+	}
+	c.closeMu.Lock()
+	c.closed = true
+	c.closeMu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		c.inFlight.Wait()
+		close(done)
+	}()
+
+	var err error
+	select {
+	case <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	c.client.CloseIdleConnections()
+	c.closeEndpointIdleConnections()
+	return err
+}
+
+// beginRequest registers an in-flight request. The returned function must be called
+// once the request has completed.
+func (c *Client) beginRequest() (func(), error) {
+	c.closeMu.Lock()
+	defer c.closeMu.Unlock()
+	if c.closed {
+		return nil, ErrClientClosed
+	}
+	c.inFlight.Add(1)
+	return c.inFlight.Done, nil
+}
+
 // BareDo sends an API request and lets you handle the api response. If an error
 // or API Error occurs, the error will contain more information. Otherwise you
-// are supposed to read and close the response's Body.
+// are supposed to read and close the response's Body. The request is in flight,
+// so [Client.Close] waits for it, until the Body is closed.
 //
 // The provided ctx must be non-nil, if it is nil an error is returned. If it is
 // canceled or times out, ctx.Err() will be returned.
@@ -213,9 +438,69 @@ func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, erro
 	if ctx == nil {
 		return nil, errNonNilContext
 	}
-	req = req.WithContext(ctx)
+	done, err := c.beginRequest()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.bareDo(ctx, req)
+	// the body of an error response has already been read by CheckResponse
+	if err != nil || resp == nil || resp.Body == nil {
+		done()
+		return resp, err
+	}
+	resp.Body = &inFlightBody{ReadCloser: resp.Body, done: done}
+	return resp, nil
+}
 
-	resp, err := c.client.Do(req)
+// inFlightBody is the body of a response returned by BareDo, which ends the
+// request's in-flight tracking when it's closed.
+type inFlightBody struct {
+	io.ReadCloser
+	once sync.Once
+	done func()
+}
+
+func (b *inFlightBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.done)
+	return err
+}
+
+// bareDo is BareDo without in-flight request tracking.
+func (c *Client) bareDo(ctx context.Context, req *http.Request) (*Response, error) {
+	deadlineTimeout, _ := req.Context().Value(deadlineTimeoutRequestKey{}).(bool)
+	req = withContextHeaders(ctx, req.WithContext(ctx))
+	rawQuery := req.URL.RawQuery
+	if deadlineTimeout {
+		// copy the URL, which is shared with the caller's request, before setting its timeout
+		u := *req.URL
+		req.URL = &u
+	}
+
+	var resp *http.Response
+	var err error
+	for attempt := 0; ; attempt++ {
+		if err := c.waitForRateLimit(ctx); err != nil {
+			return nil, err
+		}
+		if deadlineTimeout {
+			setDeadlineTimeout(ctx, req, rawQuery)
+		}
+		c.onRequest(req)
+		start := time.Now()
+		resp, err = c.httpClientFor(req).Do(req)
+		c.onResponse(RequestAttempt{Request: req, Response: resp, Err: err, Attempt: attempt, Duration: time.Since(start)})
+		delay, retry := c.Retry.retryDelay(ctx, req, resp, err, attempt)
+		if !retry {
+			break
+		}
+		if err := prepareRetry(req, resp); err != nil {
+			return nil, err
+		}
+		if err := sleepContext(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
 	if err != nil {
 		// If we got an error, and the context has been canceled,
 		// the context's error is probably more useful.
@@ -244,7 +529,16 @@ func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, erro
 // The provided ctx must be non-nil, if it is nil an error is returned. If it
 // is canceled or times out, ctx.Err() will be returned.
 func (c *Client) Do(ctx context.Context, req *http.Request, v any) (*Response, error) {
-	resp, err := c.BareDo(ctx, req)
+	if ctx == nil {
+		return nil, errNonNilContext
+	}
+	done, err := c.beginRequest()
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	resp, err := c.bareDo(ctx, req)
 	if err != nil {
 		return resp, err
 	}
@@ -255,17 +549,16 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v any) (*Response, e
 		return resp, err
 	}
 	resp.RawBody = rawBody
+	resp.Size = int64(len(rawBody))
+	c.warnIfLarge(req, resp)
 	switch v := v.(type) {
 	case nil:
 	case io.Writer:
 		_, err = io.Copy(v, bytes.NewReader(rawBody))
 	default:
-		decErr := json.NewDecoder(bytes.NewReader(rawBody)).Decode(v)
-		if decErr == io.EOF {
-			decErr = nil // ignore EOF errors caused by empty response body
-		}
-		if decErr != nil {
-			err = decErr
+		// ignore empty response bodies
+		if len(rawBody) > 0 {
+			err = c.codec().Unmarshal(rawBody, v)
 		}
 	}
 	return resp, err
@@ -333,36 +626,100 @@ type ErrorResponse struct {
 	Response *http.Response // HTTP response that caused this error
 	Message  string         `json:"message"` // error message
 	Code     string         `json:"code"`    // Stardog error code
+	// ID of the failed request, from the response's SD-Request-Id or X-Request-Id header, for correlating
+	// the error with server and proxy logs. Empty if the response has neither header.
+	RequestID string `json:"-"`
+	// The response body, if it isn't a Stardog JSON error (e.g. an HTML error page from a proxy), in which
+	// case Message is its text
+	Body []byte `json:"-"`
+}
+
+// requestIDHeaders are the response headers the ID of a request is read from, in order of preference.
+var requestIDHeaders = []string{"SD-Request-Id", "X-Request-Id"}
+
+// errorCodeHeader is the response header Stardog reports the error code of a failed request in.
+const errorCodeHeader = "SD-Error-Code"
+
+// requestID returns the first request ID found in h, or "" if there is none.
+func requestID(h http.Header) string {
+	for _, header := range requestIDHeaders {
+		if id := h.Get(header); id != "" {
+			return id
+		}
+	}
+	return ""
 }
 
 func (r *ErrorResponse) Error() string {
-	return fmt.Sprintf("[%v - %v] | [%v - %v]",
+	msg := fmt.Sprintf("[%v - %v] | [%v - %v]",
 		r.Response.Request.Method,
 		r.Response.Status, r.Message, r.Code)
+	if r.RequestID != "" {
+		msg += " | [request " + r.RequestID + "]"
+	}
+	return msg
 }
 
 // CheckResponse checks the API response for errors, and returns them if
 // present. A response is considered an error if it has a status code outside
 // the 200 range.
 // API error responses are expected to have response
-// body, and a JSON response body that maps to ErrorResponse.
+// body, and a JSON response body that maps to ErrorResponse. Any other body is
+// kept in ErrorResponse.Body.
 func CheckResponse(r *http.Response) error {
 	//revive:disable-next-line:add-constant
 	if c := r.StatusCode; 200 <= c && c <= 299 {
 		return nil
 	}
 
-	errorResponse := &ErrorResponse{Response: r}
+	errorResponse := &ErrorResponse{Response: r, RequestID: requestID(r.Header)}
 	data, err := io.ReadAll(r.Body)
 	if err == nil && len(data) > 0 {
-		err := json.Unmarshal(data, errorResponse)
-		if err != nil {
-			return errors.New(string(data))
+		if err := json.Unmarshal(data, errorResponse); err != nil {
+			errorResponse.Body = data
+			errorResponse.Message = strings.TrimSpace(string(data))
 		}
 	}
+	if errorResponse.Code == "" {
+		errorResponse.Code = r.Header.Get(errorCodeHeader)
+	}
 	return errorResponse
 }
 
+// statusCode returns the HTTP status code of the ErrorResponse in err's chain, or 0 if there isn't one.
+func statusCode(err error) int {
+	var errorResponse *ErrorResponse
+	if errors.As(err, &errorResponse) && errorResponse.Response != nil {
+		return errorResponse.Response.StatusCode
+	}
+	return 0
+}
+
+// IsNotFound reports whether err is an [ErrorResponse] for a resource, such as a database or user, that
+// doesn't exist (404 Not Found).
+func IsNotFound(err error) bool {
+	return statusCode(err) == http.StatusNotFound
+}
+
+// IsConflict reports whether err is an [ErrorResponse] for a request that conflicts with the state of the
+// server, such as creating a database that already exists (409 Conflict).
+func IsConflict(err error) bool {
+	return statusCode(err) == http.StatusConflict
+}
+
+// IsAuthError reports whether err is an [ErrorResponse] for a request whose credentials were rejected
+// (401 Unauthorized) or whose user isn't permitted to make it (403 Forbidden).
+func IsAuthError(err error) bool {
+	code := statusCode(err)
+	return code == http.StatusUnauthorized || code == http.StatusForbidden
+}
+
+// IsRetryable reports whether err is an [ErrorResponse] for a transient failure that may succeed if the
+// request is retried, the same failures a [RetryPolicy] retries (429, 502, 503 and 504).
+func IsRetryable(err error) bool {
+	return retryableStatusCodes[statusCode(err)]
+}
+
 // Is returns whether the provided error equals this error.
 func (r *ErrorResponse) Is(target error) bool {
 	v, ok := target.(*ErrorResponse)
@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"os"
 	"reflect"
+	"runtime/debug"
 	"strings"
 	"testing"
 	"time"
@@ -89,6 +90,65 @@ func TestNewClient(t *testing.T) {
 	}
 }
 
+func TestClient_Version(t *testing.T) {
+	c, _ := NewClient(defaultServerURL, nil)
+	// tests run as the main module, which has no version in its build info
+	if got, want := c.Version(), Version; got != want {
+		t.Errorf("Client.Version is %v, want %v", got, want)
+	}
+	if got, want := c.UserAgent, "stardog-go/"+Version; got != want {
+		t.Errorf("NewClient UserAgent is %v, want %v", got, want)
+	}
+}
+
+func Test_versionFromBuildInfo(t *testing.T) {
+	tests := []struct {
+		name string
+		info *debug.BuildInfo
+		want string
+	}{
+		{
+			name: "dependency",
+			info: &debug.BuildInfo{
+				Main: debug.Module{Path: "example.com/app", Version: "v1.0.0"},
+				Deps: []*debug.Module{{Path: modulePath, Version: "v0.9.1"}},
+			},
+			want: "v0.9.1",
+		},
+		{
+			name: "replaced dependency",
+			info: &debug.BuildInfo{
+				Deps: []*debug.Module{{Path: modulePath, Version: "v0.9.1", Replace: &debug.Module{Path: "example.com/fork", Version: "v0.9.2"}}},
+			},
+			want: "v0.9.2",
+		},
+		{
+			name: "local replacement",
+			info: &debug.BuildInfo{
+				Deps: []*debug.Module{{Path: modulePath, Version: "v0.9.1", Replace: &debug.Module{Path: "../go-stardog"}}},
+			},
+			want: Version,
+		},
+		{
+			name: "main module",
+			info: &debug.BuildInfo{Main: debug.Module{Path: modulePath, Version: "(devel)"}},
+			want: Version,
+		},
+		{
+			name: "not a dependency",
+			info: &debug.BuildInfo{Main: debug.Module{Path: "example.com/app", Version: "v1.0.0"}},
+			want: Version,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := versionFromBuildInfo(tt.info); got != tt.want {
+				t.Errorf("versionFromBuildInfo = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestNewClient_trailingSlashServerURL(t *testing.T) {
 	serverURL := "http://localhost:5821"
 	c, _ := NewClient(serverURL, nil)
@@ -405,6 +465,85 @@ func TestCheckResponse(t *testing.T) {
 	}
 }
 
+func TestCheckResponse_nonJSONBody(t *testing.T) {
+	res := &http.Response{
+		Request:    &http.Request{},
+		StatusCode: http.StatusBadGateway,
+		Header:     http.Header{"X-Request-Id": []string{"abc123"}},
+		Body:       io.NopCloser(strings.NewReader("<html>Bad Gateway</html>\n")),
+	}
+	err, ok := CheckResponse(res).(*ErrorResponse)
+	if !ok {
+		t.Fatalf("CheckResponse returned %T, want *ErrorResponse", err)
+	}
+	if got, want := string(err.Body), "<html>Bad Gateway</html>\n"; got != want {
+		t.Errorf("ErrorResponse.Body = %q, want %q", got, want)
+	}
+	if got, want := err.Message, "<html>Bad Gateway</html>"; got != want {
+		t.Errorf("ErrorResponse.Message = %q, want %q", got, want)
+	}
+	if got, want := err.RequestID, "abc123"; got != want {
+		t.Errorf("ErrorResponse.RequestID = %q, want %q", got, want)
+	}
+	if !strings.Contains(err.Error(), "abc123") {
+		t.Errorf("ErrorResponse.Error() = %q, want it to include the request ID", err.Error())
+	}
+}
+
+func TestCheckResponse_headers(t *testing.T) {
+	res := &http.Response{
+		Request:    &http.Request{},
+		StatusCode: http.StatusNotFound,
+		Header:     http.Header{"Sd-Request-Id": []string{"sd-1"}, "X-Request-Id": []string{"proxy-1"}, "Sd-Error-Code": []string{"0D0DU2"}},
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+	err := CheckResponse(res).(*ErrorResponse)
+	if got, want := err.RequestID, "sd-1"; got != want {
+		t.Errorf("ErrorResponse.RequestID = %q, want %q", got, want)
+	}
+	if got, want := err.Code, "0D0DU2"; got != want {
+		t.Errorf("ErrorResponse.Code = %q, want %q", got, want)
+	}
+	if err.Body != nil {
+		t.Errorf("ErrorResponse.Body = %q, want nil", err.Body)
+	}
+}
+
+func TestErrorPredicates(t *testing.T) {
+	errorWithStatus := func(code int) error {
+		return fmt.Errorf("wrapped: %w", &ErrorResponse{Response: &http.Response{StatusCode: code}})
+	}
+	tests := []struct {
+		err                                        error
+		notFound, conflict, authError, isRetryable bool
+	}{
+		{err: errorWithStatus(http.StatusNotFound), notFound: true},
+		{err: errorWithStatus(http.StatusConflict), conflict: true},
+		{err: errorWithStatus(http.StatusUnauthorized), authError: true},
+		{err: errorWithStatus(http.StatusForbidden), authError: true},
+		{err: errorWithStatus(http.StatusServiceUnavailable), isRetryable: true},
+		{err: errorWithStatus(http.StatusTooManyRequests), isRetryable: true},
+		{err: errorWithStatus(http.StatusBadRequest)},
+		{err: errors.New("not an ErrorResponse")},
+		{err: &ErrorResponse{}},
+		{err: nil},
+	}
+	for _, tt := range tests {
+		if got := IsNotFound(tt.err); got != tt.notFound {
+			t.Errorf("IsNotFound(%v) = %v, want %v", tt.err, got, tt.notFound)
+		}
+		if got := IsConflict(tt.err); got != tt.conflict {
+			t.Errorf("IsConflict(%v) = %v, want %v", tt.err, got, tt.conflict)
+		}
+		if got := IsAuthError(tt.err); got != tt.authError {
+			t.Errorf("IsAuthError(%v) = %v, want %v", tt.err, got, tt.authError)
+		}
+		if got := IsRetryable(tt.err); got != tt.isRetryable {
+			t.Errorf("IsRetryable(%v) = %v, want %v", tt.err, got, tt.isRetryable)
+		}
+	}
+}
+
 func TestSetCredentialsAsHeaders(t *testing.T) {
 	req := new(http.Request)
 	username, password := "admin", "admin"
@@ -691,6 +830,33 @@ func TestDo(t *testing.T) {
 	}
 }
 
+func TestDo_responseMetadata(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("SD-Query-Id", "42")
+		w.Header().Set("X-Request-Id", "req-1")
+		w.Header().Set("SD-Version", "9.1.0")
+		fmt.Fprint(w, `{}`)
+	})
+
+	req, _ := client.NewRequest("GET", ".", nil, nil)
+	resp, err := client.Do(context.Background(), req, nil)
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if resp.QueryID != "42" {
+		t.Errorf("Response.QueryID = %q, want %q", resp.QueryID, "42")
+	}
+	if resp.RequestID != "req-1" {
+		t.Errorf("Response.RequestID = %q, want %q", resp.RequestID, "req-1")
+	}
+	if resp.ServerVersion != "9.1.0" {
+		t.Errorf("Response.ServerVersion = %q, want %q", resp.ServerVersion, "9.1.0")
+	}
+}
+
 func TestDo_nilContext(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -793,3 +959,129 @@ func TestDo_contextCancelledError(t *testing.T) {
 		t.Errorf("Error = %#v, want %#v", err, want)
 	}
 }
+
+func TestClient_Close(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := context.Background()
+	if err := client.Close(ctx); err != nil {
+		t.Fatalf("Close returned unexpected error: %v", err)
+	}
+
+	req, _ := client.NewRequest("GET", ".", nil, nil)
+	if _, err := client.Do(ctx, req, nil); !errors.Is(err, ErrClientClosed) {
+		t.Errorf("Do after Close error = %#v, want %#v", err, ErrClientClosed)
+	}
+	if _, err := client.BareDo(ctx, req); !errors.Is(err, ErrClientClosed) {
+		t.Errorf("BareDo after Close error = %#v, want %#v", err, ErrClientClosed)
+	}
+	if err := client.Close(nil); !errors.Is(err, errNonNilContext) {
+		t.Errorf("Close(nil) error = %#v, want %#v", err, errNonNilContext)
+	}
+}
+
+func TestClient_Close_waitsForInFlightRequests(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := context.Background()
+	requestErr := make(chan error, 1)
+	go func() {
+		req, _ := client.NewRequest("GET", ".", nil, nil)
+		_, err := client.Do(ctx, req, nil)
+		requestErr <- err
+	}()
+	<-started
+
+	closeErr := make(chan error, 1)
+	go func() {
+		closeErr <- client.Close(ctx)
+	}()
+
+	select {
+	case err := <-closeErr:
+		t.Fatalf("Close returned before in-flight request completed: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	if err := <-requestErr; err != nil {
+		t.Errorf("in-flight request returned unexpected error: %v", err)
+	}
+	if err := <-closeErr; err != nil {
+		t.Errorf("Close returned unexpected error: %v", err)
+	}
+}
+
+func TestClient_Close_waitsForBareDoBody(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("streamed export"))
+	})
+
+	ctx := context.Background()
+	req, _ := client.NewRequest("GET", ".", nil, nil)
+	resp, err := client.BareDo(ctx, req)
+	if err != nil {
+		t.Fatalf("BareDo returned unexpected error: %v", err)
+	}
+
+	closeErr := make(chan error, 1)
+	go func() {
+		closeErr <- client.Close(ctx)
+	}()
+	select {
+	case err := <-closeErr:
+		t.Fatalf("Close returned before the BareDo response body was closed: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if body, err := io.ReadAll(resp.Body); err != nil || string(body) != "streamed export" {
+		t.Errorf("BareDo response body = %q, %v, want %q", body, err, "streamed export")
+	}
+	resp.Body.Close()
+	resp.Body.Close()
+	if err := <-closeErr; err != nil {
+		t.Errorf("Close returned unexpected error: %v", err)
+	}
+}
+
+func TestClient_Close_contextDeadline(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+	})
+
+	go func() {
+		req, _ := client.NewRequest("GET", ".", nil, nil)
+		client.Do(context.Background(), req, nil)
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := client.Close(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Close error = %#v, want %#v", err, context.DeadlineExceeded)
+	}
+}
@@ -0,0 +1,136 @@
+package stardog
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// defaultRefreshBefore is how long before a token expires TokenRefreshTransport refreshes it by default.
+const defaultRefreshBefore = time.Minute
+
+// TokenRefreshTransport is an http.RoundTripper that authenticates all requests using Bearer Authentication
+// with a token it fetches and caches. The token is refreshed shortly before it expires, and when the server
+// rejects it with a 401 the token is refreshed and the request retried once. Use it instead of
+// [BearerAuthTransport] in long-running services that would otherwise break when their token expires.
+//
+// Tokens are fetched with Fetch if it's provided, and otherwise from the Stardog server at ServerURL
+// with [SecurityService.GetToken], authenticating with Username and Password.
+type TokenRefreshTransport struct {
+	// Fetch returns a new token. If nil, tokens are requested from ServerURL.
+	Fetch func(ctx context.Context) (*Token, error)
+
+	// The Stardog server to request tokens from, and the credentials to request them with, if Fetch is nil
+	ServerURL string
+	Username  string
+	Password  string
+
+	// How long before a token expires to refresh it (default 1 minute)
+	RefreshBefore time.Duration
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	mu    sync.Mutex
+	token *Token
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *TokenRefreshTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.currentToken(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.transport().RoundTrip(setBearerAuthHeaders(req, token.Token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// the request can only be retried if its body can be sent again
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
+	}
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return resp, nil
+		}
+	}
+	token, err = t.refreshToken(req.Context(), token)
+	if err != nil {
+		return resp, nil
+	}
+	resp.Body.Close()
+	return t.transport().RoundTrip(setBearerAuthHeaders(retry, token.Token))
+}
+
+// Client returns an *http.Client that makes requests that are authenticated
+// using a refreshing bearer token.
+func (t *TokenRefreshTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *TokenRefreshTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+func (t *TokenRefreshTransport) refreshBefore() time.Duration {
+	if t.RefreshBefore != 0 {
+		return t.RefreshBefore
+	}
+	return defaultRefreshBefore
+}
+
+// currentToken returns the cached token, fetching a new one if there is none or it's about to expire.
+func (t *TokenRefreshTransport) currentToken(ctx context.Context) (*Token, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token != nil && !t.token.Expired(t.refreshBefore()) {
+		return t.token, nil
+	}
+	return t.fetchLocked(ctx)
+}
+
+// refreshToken fetches a new token to replace the rejected one, unless another request already has.
+func (t *TokenRefreshTransport) refreshToken(ctx context.Context, rejected *Token) (*Token, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token != nil && t.token != rejected {
+		return t.token, nil
+	}
+	return t.fetchLocked(ctx)
+}
+
+// fetchLocked fetches and caches a new token. t.mu must be held.
+func (t *TokenRefreshTransport) fetchLocked(ctx context.Context) (*Token, error) {
+	fetch := t.Fetch
+	if fetch == nil {
+		fetch = t.fetchFromServer
+	}
+	token, err := fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if token == nil || token.Token == "" {
+		return nil, errors.New("stardog: token fetch returned no token")
+	}
+	t.token = token
+	return token, nil
+}
+
+// fetchFromServer requests a token from ServerURL using Username and Password.
+func (t *TokenRefreshTransport) fetchFromServer(ctx context.Context) (*Token, error) {
+	basicAuth := BasicAuthTransport{Username: t.Username, Password: t.Password, Transport: t.Transport}
+	client, err := NewClient(t.ServerURL, basicAuth.Client())
+	if err != nil {
+		return nil, err
+	}
+	token, _, err := client.Security.GetToken(ctx)
+	return token, err
+}
@@ -0,0 +1,148 @@
+package stardog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestTokenRefreshTransport_refreshOnUnauthorized(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/users", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "{\"username\":\"u\",\"password\":[\"p\"]}\n" {
+			t.Errorf("request body = %q, want the body to be sent on every attempt", body)
+		}
+		if r.Header.Get("Authorization") != "bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	fetches := 0
+	tp := &TokenRefreshTransport{
+		Fetch: func(ctx context.Context) (*Token, error) {
+			fetches++
+			return &Token{Token: fmt.Sprintf("token-%d", fetches)}, nil
+		},
+	}
+	refreshingClient, _ := NewClient(client.baseURL.String(), tp.Client())
+	ctx := context.Background()
+	if _, err := refreshingClient.User.Create(ctx, "u", "p"); err != nil {
+		t.Fatalf("User.Create returned error: %v", err)
+	}
+	if fetches != 2 {
+		t.Errorf("token fetches = %d, want 2", fetches)
+	}
+
+	// the refreshed token is cached
+	if _, err := refreshingClient.User.Create(ctx, "u", "p"); err != nil {
+		t.Fatalf("User.Create returned error: %v", err)
+	}
+	if fetches != 2 {
+		t.Errorf("token fetches = %d, want 2", fetches)
+	}
+}
+
+func TestTokenRefreshTransport_refreshBeforeExpiry(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var gotAuth string
+	mux.HandleFunc("/admin/alive", func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+	})
+
+	fetches := 0
+	tp := &TokenRefreshTransport{
+		Fetch: func(ctx context.Context) (*Token, error) {
+			fetches++
+			// expires within the default refresh window, so it's refreshed on every request
+			return &Token{Token: fmt.Sprintf("token-%d", fetches), ExpiresAt: time.Now().Add(30 * time.Second)}, nil
+		},
+	}
+	refreshingClient, _ := NewClient(client.baseURL.String(), tp.Client())
+	ctx := context.Background()
+	for i := 1; i <= 2; i++ {
+		if _, _, err := refreshingClient.ServerAdmin.IsAlive(ctx); err != nil {
+			t.Fatalf("ServerAdmin.IsAlive returned error: %v", err)
+		}
+		if want := fmt.Sprintf("bearer token-%d", i); gotAuth != want {
+			t.Errorf("Authorization = %q, want %q", gotAuth, want)
+		}
+	}
+
+	tp.RefreshBefore = time.Second
+	if _, _, err := refreshingClient.ServerAdmin.IsAlive(ctx); err != nil {
+		t.Fatalf("ServerAdmin.IsAlive returned error: %v", err)
+	}
+	if fetches != 2 {
+		t.Errorf("token fetches = %d, want the cached token to be reused", fetches)
+	}
+}
+
+func TestTokenRefreshTransport_credentials(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	jwt := newTestJWT(fmt.Sprintf(`{"exp":%d}`, time.Now().Add(time.Hour).Unix()))
+	mux.HandleFunc("/admin/token", func(w http.ResponseWriter, r *http.Request) {
+		if username, password, _ := r.BasicAuth(); username != "admin" || password != "admin" {
+			t.Errorf("basic auth = %q/%q, want admin/admin", username, password)
+		}
+		w.Write([]byte(`{"token": "` + jwt + `"}`))
+	})
+	mux.HandleFunc("/admin/alive", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Header.Get("Authorization"), "bearer "+jwt; got != want {
+			t.Errorf("Authorization = %q, want %q", got, want)
+		}
+	})
+
+	tp := &TokenRefreshTransport{ServerURL: client.baseURL.String(), Username: "admin", Password: "admin"}
+	refreshingClient, _ := NewClient(client.baseURL.String(), tp.Client())
+	if _, _, err := refreshingClient.ServerAdmin.IsAlive(context.Background()); err != nil {
+		t.Errorf("ServerAdmin.IsAlive returned error: %v", err)
+	}
+}
+
+func TestTokenRefreshTransport_fetchError(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	fetchErr := errors.New("identity provider unavailable")
+	tp := &TokenRefreshTransport{
+		Fetch: func(ctx context.Context) (*Token, error) { return nil, fetchErr },
+	}
+	refreshingClient, _ := NewClient(client.baseURL.String(), tp.Client())
+	if _, _, err := refreshingClient.ServerAdmin.IsAlive(context.Background()); !errors.Is(err, fetchErr) {
+		t.Errorf("ServerAdmin.IsAlive error = %v, want %v", err, fetchErr)
+	}
+
+	tp.Fetch = func(ctx context.Context) (*Token, error) { return &Token{}, nil }
+	if _, _, err := refreshingClient.ServerAdmin.IsAlive(context.Background()); err == nil {
+		t.Errorf("ServerAdmin.IsAlive err = nil, want error for an empty token")
+	}
+}
+
+func TestTokenRefreshTransport_transport(t *testing.T) {
+	// default transport
+	tp := &TokenRefreshTransport{}
+	if tp.transport() != http.DefaultTransport {
+		t.Errorf("Expected http.DefaultTransport to be used.")
+	}
+
+	// custom transport
+	tp = &TokenRefreshTransport{
+		Transport: &http.Transport{},
+	}
+	if tp.transport() == http.DefaultTransport {
+		t.Errorf("Expected custom transport to be used.")
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 )
 
 // TransactionService provides access to the transaction related functions in the Stardog API.
@@ -15,6 +17,9 @@ type TransactionService service
 //
 // Stardog API docs: https://stardog-union.github.io/http-docs/#tag/Transactions/operation/beginTransaction
 func (s *TransactionService) Begin(ctx context.Context, database string) (string, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return "", nil, err
+	}
 	u := fmt.Sprintf("%s/transaction/begin", database)
 	headerOpts := requestHeaderOptions{
 		Accept: mediaTypePlainText,
@@ -32,3 +37,86 @@ func (s *TransactionService) Begin(ctx context.Context, database string) (string
 
 	return buf.String(), resp, nil
 }
+
+// TransactionAddOptions specifies the optional parameters to the [TransactionService.Add] method
+type TransactionAddOptions struct {
+	// The named graph to add the data to. If empty, triples are added to the default graph and quads to their own graphs.
+	NamedGraph string `url:"graph-uri,omitempty"`
+	// The compression of the data, if any. Only gzipped data is sent compressed; bzip2 data is decompressed
+	// as it's sent. If unset, the compression is detected from the data.
+	Compression Compression `url:"-"`
+}
+
+// Add adds the RDF data read from data to the database within a transaction. The data is streamed to the
+// server, so it's never fully buffered in memory.
+//
+// Stardog API docs: https://stardog-union.github.io/http-docs/#tag/Transactions/operation/addToTransaction
+func (s *TransactionService) Add(ctx context.Context, database string, txID string, data io.Reader, format RDFFormat, opts *TransactionAddOptions) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	if txID == "" {
+		return nil, &ValidationError{Field: "txID", Value: txID, Constraint: "must not be empty"}
+	}
+	if !format.Valid() {
+		return nil, &ValidationError{Field: "format", Value: format, Constraint: "must be a known value"}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	var compression Compression
+	if opts != nil {
+		compression = opts.Compression
+	}
+	body, contentEncoding, err := uploadReader(data, "data", compression)
+	if err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("%s/%s/add", database, url.PathEscape(txID))
+	urlWithOptions, err := addOptions(u, opts)
+	if err != nil {
+		return nil, err
+	}
+	headerOpts := requestHeaderOptions{
+		ContentType:     format.String(),
+		ContentEncoding: contentEncoding,
+	}
+	req, err := s.client.NewRequest(http.MethodPost, urlWithOptions, &headerOpts, body)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// Commit commits a transaction, making its changes visible outside of it.
+//
+// Stardog API docs: https://stardog-union.github.io/http-docs/#tag/Transactions/operation/commitTransaction
+func (s *TransactionService) Commit(ctx context.Context, database string, txID string) (*Response, error) {
+	return s.end(ctx, database, "commit", txID)
+}
+
+// Rollback rolls back a transaction, discarding its changes. A superuser can roll back a transaction
+// begun by another user, e.g. to release the locks held by a stuck transaction. See
+// [DatabaseAdminService.Transactions] and [DatabaseAdminService.ForceRollback] for finding and ending them.
+//
+// Stardog API docs: https://stardog-union.github.io/http-docs/#tag/Transactions/operation/rollbackTransaction
+func (s *TransactionService) Rollback(ctx context.Context, database string, txID string) (*Response, error) {
+	return s.end(ctx, database, "rollback", txID)
+}
+
+// end commits or rolls back a transaction.
+func (s *TransactionService) end(ctx context.Context, database string, action string, txID string) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	if txID == "" {
+		return nil, &ValidationError{Field: "txID", Value: txID, Constraint: "must not be empty"}
+	}
+	u := fmt.Sprintf("%s/transaction/%s/%s", database, action, url.PathEscape(txID))
+	req, err := s.client.NewRequest(http.MethodPost, u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
@@ -3,7 +3,9 @@ package stardog
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -41,3 +43,89 @@ func TestTransactionService_Begin(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestTransactionService_Commit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	txID := "43FD6C7B-EE53-4618-A90D-7E45ADD8B433"
+	database := "myDatabase"
+
+	mux.HandleFunc(fmt.Sprintf("/%s/transaction/commit/%s", database, txID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Transaction.Commit(ctx, database, txID); err != nil {
+		t.Errorf("Transaction.Commit returned error: %v", err)
+	}
+
+	const methodName = "Commit"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Transaction.Commit(ctx, database, "")
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Transaction.Commit(nil, database, txID)
+	})
+}
+
+func TestTransactionService_Rollback(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	txID := "43FD6C7B-EE53-4618-A90D-7E45ADD8B433"
+	database := "myDatabase"
+
+	mux.HandleFunc(fmt.Sprintf("/%s/transaction/rollback/%s", database, txID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Transaction.Rollback(ctx, database, txID); err != nil {
+		t.Errorf("Transaction.Rollback returned error: %v", err)
+	}
+
+	const methodName = "Rollback"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Transaction.Rollback(ctx, "", txID)
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Transaction.Rollback(nil, database, txID)
+	})
+}
+
+func TestTransactionService_Add(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/db1/tx1/add", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", mediaTypeTextTurtle)
+		if got := r.URL.Query().Get("graph-uri"); got != "urn:g" {
+			t.Errorf("graph-uri = %q, want urn:g", got)
+		}
+		if body, _ := io.ReadAll(r.Body); string(body) != "<urn:s> <urn:p> <urn:o> ." {
+			t.Errorf("body = %q", body)
+		}
+	})
+
+	ctx := context.Background()
+	data := strings.NewReader("<urn:s> <urn:p> <urn:o> .")
+	if _, err := client.Transaction.Add(ctx, "db1", "tx1", data, RDFFormatTurtle, &TransactionAddOptions{NamedGraph: "urn:g"}); err != nil {
+		t.Errorf("Transaction.Add returned error: %v", err)
+	}
+
+	if _, err := client.Transaction.Add(ctx, "db1", "", data, RDFFormatTurtle, nil); err == nil {
+		t.Errorf("Transaction.Add without a transaction ID returned no error")
+	}
+	if _, err := client.Transaction.Add(ctx, "db1", "tx1", data, RDFFormatUnknown, nil); err == nil {
+		t.Errorf("Transaction.Add without a format returned no error")
+	}
+	if _, err := client.Transaction.Add(ctx, "db1", "tx1", strings.NewReader("PK\x03\x04"), RDFFormatTurtle, nil); err == nil {
+		t.Errorf("Transaction.Add with a ZIP archive returned no error")
+	}
+}
@@ -0,0 +1,242 @@
+package stardog
+
+import (
+	"bufio"
+	"compress/bzip2"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// uploadFile is a file uploaded in a multipart/form-data request.
+type uploadFile struct {
+	// The name of the file, whose base name is used as its filename and, unless field is set, its form field name
+	name string
+	// The form field name of the file, if not the base name of name
+	field string
+	r     io.Reader
+	// The number of bytes that will be read from r, or -1 if unknown
+	size int64
+	// Closes r once it's uploaded, for files opened by this package
+	closer io.Closer
+}
+
+// uploadName returns the name the dataset is uploaded with, which the server uses to determine its format
+// and compression. It's the dataset's Path or, for a Reader without one, a name built from its Format.
+func (d Dataset) uploadName(i int) string {
+	if d.Path != "" || d.Reader == nil {
+		return d.Path
+	}
+	name := fmt.Sprintf("dataset-%d.%s", i, d.Format.extension())
+	if d.Compression.Valid() {
+		name += "." + d.Compression.extension()
+	}
+	return name
+}
+
+// UploadProgress reports the progress of uploading a dataset, for methods such as [DatabaseAdminService.Create]
+// and [DatabaseAdminService.ImportData] that stream datasets to the server.
+type UploadProgress struct {
+	// The index of the dataset being uploaded
+	Dataset int
+	// The name the dataset is uploaded with: its Path or, for a Reader without one, a generated name
+	Name string
+	// The number of bytes of the dataset sent so far
+	Sent int64
+	// The size of the dataset in bytes, or -1 if unknown
+	Size int64
+}
+
+// Done reports whether the whole dataset has been sent. It's always false if the size of the dataset is unknown.
+func (p UploadProgress) Done() bool {
+	return p.Size >= 0 && p.Sent >= p.Size
+}
+
+// progressReader calls report with the progress of an upload as it's read.
+type progressReader struct {
+	r        io.Reader
+	progress UploadProgress
+	report   func(UploadProgress)
+}
+
+// withProgress returns r, or r wrapped to call report as it's read if report isn't nil.
+func withProgress(r io.Reader, progress UploadProgress, report func(UploadProgress)) io.Reader {
+	if report == nil {
+		return r
+	}
+	return &progressReader{r: r, progress: progress, report: report}
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if n > 0 {
+		r.progress.Sent += int64(n)
+		r.report(r.progress)
+	}
+	return n, err
+}
+
+// openDatasets returns the files to upload for the datasets, opening the files of datasets without a Reader.
+// If a file can't be opened, the files already opened are closed. If progress isn't nil, it's called as each
+// file is read.
+func openDatasets(datasets []Dataset, progress func(UploadProgress)) ([]uploadFile, error) {
+	files := make([]uploadFile, 0, len(datasets))
+	for i, dataset := range datasets {
+		if dataset.Reader != nil {
+			size := dataset.Size
+			if size <= 0 {
+				size = -1
+			}
+			name := dataset.uploadName(i)
+			r := withProgress(dataset.Reader, UploadProgress{Dataset: i, Name: name, Size: size}, progress)
+			files = append(files, uploadFile{name: name, r: r, size: size})
+			continue
+		}
+		file, err := os.Open(dataset.Path)
+		if err != nil {
+			closeUploadFiles(files)
+			return nil, err
+		}
+		stat, err := file.Stat()
+		if err != nil {
+			file.Close()
+			closeUploadFiles(files)
+			return nil, err
+		}
+		r := withProgress(file, UploadProgress{Dataset: i, Name: dataset.Path, Size: stat.Size()}, progress)
+		files = append(files, uploadFile{name: dataset.Path, r: r, size: stat.Size(), closer: file})
+	}
+	return files, nil
+}
+
+func closeUploadFiles(files []uploadFile) {
+	for _, file := range files {
+		if file.closer != nil {
+			file.closer.Close()
+		}
+	}
+}
+
+// multipartUpload is a multipart/form-data request body that's streamed from its fields and files as it's
+// read, so the files are never fully buffered in memory. It must be closed once the request is done, which
+// also closes the files.
+type multipartUpload struct {
+	*io.PipeReader
+	boundary string
+	size     int64
+}
+
+// newMultipartUpload starts streaming a multipart/form-data body with the fields, in order of their names,
+// followed by the files.
+func newMultipartUpload(fields map[string]string, files []uploadFile) *multipartUpload {
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+	upload := &multipartUpload{PipeReader: pr, boundary: writer.Boundary(), size: multipartSize(writer.Boundary(), fields, files)}
+	go func() {
+		err := writeMultipart(writer, fields, files, true)
+		closeUploadFiles(files)
+		pw.CloseWithError(err)
+	}()
+	return upload
+}
+
+// ContentType returns the Content-Type of the body, including its boundary.
+func (u *multipartUpload) ContentType() string {
+	return "multipart/form-data; boundary=" + u.boundary
+}
+
+// Size returns the length of the body, or -1 if the size of any of its files is unknown.
+func (u *multipartUpload) Size() int64 {
+	return u.size
+}
+
+// multipartSize computes the length of the body with the given boundary by writing it without the contents
+// of the files, or returns -1 if the size of any of the files is unknown.
+func multipartSize(boundary string, fields map[string]string, files []uploadFile) int64 {
+	var counter countingWriter
+	for _, file := range files {
+		if file.size < 0 {
+			return -1
+		}
+		counter.n += file.size
+	}
+	writer := multipart.NewWriter(&counter)
+	if err := writer.SetBoundary(boundary); err != nil {
+		return -1
+	}
+	if err := writeMultipart(writer, fields, files, false); err != nil {
+		return -1
+	}
+	return counter.n
+}
+
+// writeMultipart writes the fields and files to the writer and closes it. The contents of the files are only
+// written if copyFiles is set.
+func writeMultipart(writer *multipart.Writer, fields map[string]string, files []uploadFile, copyFiles bool) error {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := writer.WriteField(name, fields[name]); err != nil {
+			return err
+		}
+	}
+	for _, file := range files {
+		name := filepath.Base(file.name)
+		field := file.field
+		if field == "" {
+			field = name
+		}
+		part, err := writer.CreateFormFile(field, name)
+		if err != nil {
+			return err
+		}
+		if !copyFiles {
+			continue
+		}
+		n, err := io.Copy(part, file.r)
+		if err != nil {
+			return err
+		}
+		if file.size >= 0 && n != file.size {
+			return fmt.Errorf("read %d bytes from %s, but its size is %d", n, file.name, file.size)
+		}
+	}
+	return writer.Close()
+}
+
+// countingWriter counts the bytes written to it.
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
+
+// uploadReader prepares the RDF data read from r, the parameter named field, for upload, returning the reader to upload and the
+// Content-Encoding to upload it with. If compression is CompressionUnknown it's detected from the data. Gzipped
+// data is uploaded as is, and bzip2 data, which has no content coding, is decompressed. ZIP archives aren't supported.
+func uploadReader(r io.Reader, field string, compression Compression) (io.Reader, string, error) {
+	if compression == CompressionUnknown {
+		buffered := bufio.NewReader(r)
+		// the header is shorter than a magic number if the data is, which detectCompression handles
+		header, _ := buffered.Peek(4)
+		compression = detectCompression(header)
+		r = buffered
+	}
+	switch compression {
+	case CompressionBZ2:
+		return bzip2.NewReader(r), "", nil
+	case CompressionZIP:
+		return nil, "", &ValidationError{Field: field, Value: compression, Constraint: "must not be a ZIP archive"}
+	default:
+		return r, compression.contentEncoding(), nil
+	}
+}
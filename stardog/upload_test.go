@@ -0,0 +1,192 @@
+package stardog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDatabaseAdminService_Create_streamedDatasets(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	beatles, err := os.ReadFile("./test-resources/beatles.ttl")
+	if err != nil {
+		t.Fatalf("unexpected error reading test resource: %v", err)
+	}
+	quads := "<urn:s> <urn:p> <urn:o> <urn:g> .\n"
+
+	var gotContentLength int64
+	var gotRoot createDatabaseRequest
+	gotFiles := make(map[string]string)
+	mux.HandleFunc("/admin/databases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		gotContentLength = r.ContentLength
+		reader, err := r.MultipartReader()
+		if err != nil {
+			t.Fatalf("request is not multipart: %v", err)
+		}
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("reading multipart body: %v", err)
+			}
+			data, _ := io.ReadAll(part)
+			if part.FormName() == "root" {
+				json.Unmarshal(data, &gotRoot)
+				continue
+			}
+			gotFiles[part.FileName()] = string(data)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"message":"Successfully created database 'db1'."}`))
+	})
+
+	opts := &CreateDatabaseOptions{
+		CopyToServer: true,
+		Datasets: []Dataset{
+			{Path: "./test-resources/beatles.ttl", NamedGraph: "urn:beatles"},
+			{Reader: strings.NewReader(quads), Format: RDFFormatNQuads, Size: int64(len(quads))},
+			{Reader: strings.NewReader("gzipped"), Path: "remote/data.ttl.gz", Size: 7},
+		},
+	}
+	ctx := context.Background()
+	if _, _, err := client.DatabaseAdmin.Create(ctx, "db1", opts); err != nil {
+		t.Fatalf("DatabaseAdmin.Create returned error: %v", err)
+	}
+
+	wantFiles := []createDatabaseRequestFile{
+		{Filename: "./test-resources/beatles.ttl", Context: "urn:beatles"},
+		{Filename: "dataset-1.nq"},
+		{Filename: "remote/data.ttl.gz"},
+	}
+	if !cmp.Equal(gotRoot.Files, wantFiles) || !gotRoot.CopyToServer {
+		t.Errorf("root = %+v, want files %+v copied to the server", gotRoot, wantFiles)
+	}
+	wantContents := map[string]string{
+		"beatles.ttl":  string(beatles),
+		"dataset-1.nq": quads,
+		"data.ttl.gz":  "gzipped",
+	}
+	if !cmp.Equal(gotFiles, wantContents) {
+		t.Errorf("uploaded files = %v, want %v", gotFiles, wantContents)
+	}
+	if gotContentLength <= 0 {
+		t.Errorf("Content-Length = %d, want the length of the body since every size is known", gotContentLength)
+	}
+
+	// without a declared size the body is sent chunked
+	opts.Datasets = []Dataset{{Reader: strings.NewReader(quads), Format: RDFFormatNQuads, Compression: CompressionGZIP}}
+	if _, _, err := client.DatabaseAdmin.Create(ctx, "db1", opts); err != nil {
+		t.Fatalf("DatabaseAdmin.Create returned error: %v", err)
+	}
+	if gotContentLength != -1 {
+		t.Errorf("Content-Length = %d, want -1 (chunked)", gotContentLength)
+	}
+	if _, ok := gotFiles["dataset-0.nq.gz"]; !ok {
+		t.Errorf("uploaded files = %v, want dataset-0.nq.gz", gotFiles)
+	}
+}
+
+func TestDatabaseAdminService_Create_readerSizeMismatch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/databases", func(w http.ResponseWriter, r *http.Request) {
+		io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	opts := &CreateDatabaseOptions{
+		CopyToServer: true,
+		Datasets:     []Dataset{{Reader: strings.NewReader("short"), Format: RDFFormatTurtle, Size: 100}},
+	}
+	if _, _, err := client.DatabaseAdmin.Create(context.Background(), "db1", opts); err == nil {
+		t.Errorf("DatabaseAdmin.Create with a Reader shorter than its Size returned no error")
+	}
+}
+
+func TestCreateDatabaseOptions_validate_readers(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      *CreateDatabaseOptions
+		wantField string
+	}{
+		{
+			name:      "not copied to server",
+			opts:      &CreateDatabaseOptions{Datasets: []Dataset{{Reader: strings.NewReader(""), Format: RDFFormatTurtle}}},
+			wantField: "CreateDatabaseOptions.CopyToServer",
+		},
+		{
+			name:      "no format",
+			opts:      &CreateDatabaseOptions{CopyToServer: true, Datasets: []Dataset{{Reader: strings.NewReader("")}}},
+			wantField: "CreateDatabaseOptions.Datasets[0].Format",
+		},
+		{
+			name:      "bad compression",
+			opts:      &CreateDatabaseOptions{CopyToServer: true, Datasets: []Dataset{{Reader: strings.NewReader(""), Path: "a.ttl", Compression: Compression(9)}}},
+			wantField: "CreateDatabaseOptions.Datasets[0].Compression",
+		},
+	}
+	for _, tc := range tests {
+		var validationErr *ValidationError
+		if err := tc.opts.validate(); !errors.As(err, &validationErr) || validationErr.Field != tc.wantField {
+			t.Errorf("%s: validate() = %v, want a ValidationError for %s", tc.name, err, tc.wantField)
+		}
+	}
+}
+
+func TestDatabaseAdminService_Create_progress(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/databases", func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"message":"Successfully created database 'db1'."}`))
+	})
+
+	stat, err := os.Stat("./test-resources/beatles.ttl")
+	if err != nil {
+		t.Fatalf("unexpected error reading test resource: %v", err)
+	}
+	quads := "<urn:s> <urn:p> <urn:o> <urn:g> .\n"
+	last := make(map[int]UploadProgress)
+	opts := &CreateDatabaseOptions{
+		CopyToServer: true,
+		Datasets: []Dataset{
+			{Path: "./test-resources/beatles.ttl"},
+			{Reader: strings.NewReader(quads), Format: RDFFormatNQuads},
+		},
+		Progress: func(p UploadProgress) {
+			if previous, ok := last[p.Dataset]; ok && p.Sent <= previous.Sent {
+				t.Errorf("progress of dataset %d went from %d to %d bytes", p.Dataset, previous.Sent, p.Sent)
+			}
+			last[p.Dataset] = p
+		},
+	}
+	if _, _, err := client.DatabaseAdmin.Create(context.Background(), "db1", opts); err != nil {
+		t.Fatalf("DatabaseAdmin.Create returned error: %v", err)
+	}
+
+	want := map[int]UploadProgress{
+		0: {Dataset: 0, Name: "./test-resources/beatles.ttl", Sent: stat.Size(), Size: stat.Size()},
+		1: {Dataset: 1, Name: "dataset-1.nq", Sent: int64(len(quads)), Size: -1},
+	}
+	if !cmp.Equal(last, want) {
+		t.Errorf("last progress = %+v, want %+v", last, want)
+	}
+	if !last[0].Done() || last[1].Done() {
+		t.Errorf("Done() = %v, %v, want true for the file and false for the reader of unknown size", last[0].Done(), last[1].Done())
+	}
+}
@@ -50,10 +50,37 @@ type isEnabledResponse struct {
 	Enabled bool `json:"enabled"`
 }
 
+// RenameUserOptions specifies the optional parameters to the [UserService.Rename] method.
+type RenameUserOptions struct {
+	// Disable the old user once everything has been copied to the new user
+	DisableOld bool
+}
+
+// RenameUserSummary reports what [UserService.Rename] did.
+type RenameUserSummary struct {
+	// The user that was renamed
+	OldUsername string
+	// The user that was created
+	NewUsername string
+	// Whether the new user was created
+	Created bool
+	// Whether the new user is a superuser
+	Superuser bool
+	// The roles assigned to the new user
+	RolesCopied []string
+	// The explicit permissions granted to the new user
+	PermissionsCopied []Permission
+	// Whether the new user was disabled because the old user was disabled
+	NewUserDisabled bool
+	// Whether the old user was disabled
+	OldUserDisabled bool
+}
+
 // request for Create
 type createUserRequest struct {
-	Username string   `json:"username"`
-	Password []string `json:"password"`
+	Username  string   `json:"username"`
+	Password  []string `json:"password"`
+	Superuser bool     `json:"superuser,omitempty"`
 }
 
 // request for ChangePassword
@@ -250,11 +277,16 @@ func (s *UserService) IsEnabled(ctx context.Context, username string) (*bool, *R
 //
 // Stardog API: https://stardog-union.github.io/http-docs/#tag/Users/operation/addUser
 func (s *UserService) Create(ctx context.Context, username string, password string) (*Response, error) {
+	return s.create(ctx, username, password, false)
+}
+
+func (s *UserService) create(ctx context.Context, username string, password string, superuser bool) (*Response, error) {
 	u := "admin/users"
 
 	credentials := createUserRequest{
-		Username: username,
-		Password: strings.Split(password, ""),
+		Username:  username,
+		Password:  strings.Split(password, ""),
+		Superuser: superuser,
 	}
 	headerOpts := requestHeaderOptions{
 		ContentType: mediaTypeApplicationJSON,
@@ -455,3 +487,65 @@ func (s *UserService) Roles(ctx context.Context, username string) ([]string, *Re
 	}
 	return listRolesResponse.Roles, resp, err
 }
+
+// Rename "renames" a user. Stardog has no native rename for users, so Rename creates a new user with the given password,
+// copies the old user's superuser status, roles, explicit permissions and enabled status to the new user and,
+// if RenameUserOptions.DisableOld is true, disables the old user.
+//
+// Permissions whose resource is the old user itself (e.g. read on user:oldname) are copied as-is and
+// are not rewritten to refer to the new user.
+//
+// If an error occurs part way through, the returned summary reports the steps that completed so the
+// caller can finish or undo them.
+func (s *UserService) Rename(ctx context.Context, oldUsername string, newUsername string, newPassword string, opts *RenameUserOptions) (*RenameUserSummary, *Response, error) {
+	summary := &RenameUserSummary{OldUsername: oldUsername, NewUsername: newUsername}
+
+	oldUser, resp, err := s.Get(ctx, oldUsername)
+	if err != nil {
+		return summary, resp, err
+	}
+	permissions, resp, err := s.Permissions(ctx, oldUsername)
+	if err != nil {
+		return summary, resp, err
+	}
+
+	resp, err = s.create(ctx, newUsername, newPassword, oldUser.Superuser)
+	if err != nil {
+		return summary, resp, err
+	}
+	summary.Created = true
+	summary.Superuser = oldUser.Superuser
+
+	if len(oldUser.Roles) > 0 {
+		resp, err = s.OverwriteRoles(ctx, newUsername, oldUser.Roles)
+		if err != nil {
+			return summary, resp, err
+		}
+		summary.RolesCopied = oldUser.Roles
+	}
+
+	for _, permission := range permissions {
+		resp, err = s.GrantPermission(ctx, newUsername, permission)
+		if err != nil {
+			return summary, resp, err
+		}
+		summary.PermissionsCopied = append(summary.PermissionsCopied, permission)
+	}
+
+	if !oldUser.Enabled {
+		resp, err = s.Disable(ctx, newUsername)
+		if err != nil {
+			return summary, resp, err
+		}
+		summary.NewUserDisabled = true
+	}
+
+	if opts != nil && opts.DisableOld && oldUser.Enabled {
+		resp, err = s.Disable(ctx, oldUsername)
+		if err != nil {
+			return summary, resp, err
+		}
+		summary.OldUserDisabled = true
+	}
+	return summary, resp, nil
+}
@@ -796,3 +796,127 @@ func TestUserService_Roles(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestUserService_Rename(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	oldUsername := "frodo"
+	newUsername := "frodo.baggins"
+	permission := Permission{
+		Action:       PermissionActionRead,
+		ResourceType: PermissionResourceTypeDatabase,
+		Resource:     []string{"shire"},
+	}
+	var disabled []string
+
+	mux.HandleFunc(fmt.Sprintf("/admin/users/%s", oldUsername), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"enabled": false, "superuser": true, "roles": ["hobbit"], "permissions": []}`))
+	})
+	mux.HandleFunc(fmt.Sprintf("/admin/permissions/user/%s", oldUsername), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"permissions": [{"action":"READ","resource_type":"db","resource":["shire"]}]}`))
+	})
+	mux.HandleFunc("/admin/users", func(w http.ResponseWriter, r *http.Request) {
+		v := new(createUserRequest)
+		json.NewDecoder(r.Body).Decode(v)
+		testMethod(t, r, "POST")
+		want := &createUserRequest{Username: newUsername, Password: strings.Split("ring", ""), Superuser: true}
+		if !cmp.Equal(v, want) {
+			t.Errorf("Request body = %+v, want %+v", v, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+	mux.HandleFunc(fmt.Sprintf("/admin/users/%s/roles", newUsername), func(w http.ResponseWriter, r *http.Request) {
+		v := new(overwriteRolesRequest)
+		json.NewDecoder(r.Body).Decode(v)
+		testMethod(t, r, "PUT")
+		if want := []string{"hobbit"}; !cmp.Equal(v.Roles, want) {
+			t.Errorf("Request roles = %+v, want %+v", v.Roles, want)
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc(fmt.Sprintf("/admin/permissions/user/%s", newUsername), func(w http.ResponseWriter, r *http.Request) {
+		v := new(Permission)
+		json.NewDecoder(r.Body).Decode(v)
+		testMethod(t, r, "PUT")
+		if !cmp.Equal(v, &permission) {
+			t.Errorf("Request body = %+v, want %+v", v, permission)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+	enabledHandler := func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		disabled = append(disabled, strings.Split(r.URL.Path, "/")[3])
+		w.WriteHeader(http.StatusOK)
+	}
+	mux.HandleFunc(fmt.Sprintf("/admin/users/%s/enabled", newUsername), enabledHandler)
+	mux.HandleFunc(fmt.Sprintf("/admin/users/%s/enabled", oldUsername), enabledHandler)
+
+	ctx := context.Background()
+	got, _, err := client.User.Rename(ctx, oldUsername, newUsername, "ring", &RenameUserOptions{DisableOld: true})
+	if err != nil {
+		t.Errorf("User.Rename returned error: %v", err)
+	}
+	want := &RenameUserSummary{
+		OldUsername:       oldUsername,
+		NewUsername:       newUsername,
+		Created:           true,
+		Superuser:         true,
+		RolesCopied:       []string{"hobbit"},
+		PermissionsCopied: []Permission{permission},
+		NewUserDisabled:   true,
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("User.Rename = %+v, want %+v", got, want)
+	}
+	// the old user was already disabled, so only the new user should have been disabled
+	if want := []string{newUsername}; !cmp.Equal(disabled, want) {
+		t.Errorf("disabled users = %+v, want %+v", disabled, want)
+	}
+
+	const methodName = "Rename"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		_, resp, err := client.User.Rename(nil, oldUsername, newUsername, "ring", nil)
+		return resp, err
+	})
+}
+
+func TestUserService_Rename_partialFailure(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	oldUsername := "frodo"
+	newUsername := "frodo.baggins"
+
+	mux.HandleFunc(fmt.Sprintf("/admin/users/%s", oldUsername), func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"enabled": true, "superuser": false, "roles": ["hobbit"], "permissions": []}`))
+	})
+	mux.HandleFunc(fmt.Sprintf("/admin/permissions/user/%s", oldUsername), func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"permissions": []}`))
+	})
+	mux.HandleFunc("/admin/users", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	})
+	mux.HandleFunc(fmt.Sprintf("/admin/users/%s/roles", newUsername), func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "Role does not exist", "code": "SRE001"}`))
+	})
+
+	got, resp, err := client.User.Rename(context.Background(), oldUsername, newUsername, "ring", nil)
+	if err == nil {
+		t.Errorf("User.Rename err = nil, want error")
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("User.Rename resp = %#v, want status %d", resp, http.StatusNotFound)
+	}
+	want := &RenameUserSummary{OldUsername: oldUsername, NewUsername: newUsername, Created: true}
+	if !cmp.Equal(got, want) {
+		t.Errorf("User.Rename = %+v, want %+v", got, want)
+	}
+}
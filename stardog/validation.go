@@ -0,0 +1,358 @@
+package stardog
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidationError reports a parameter that failed client-side validation.
+// When a ValidationError is returned, no request was sent to the Stardog server.
+type ValidationError struct {
+	// The parameter that failed validation, named as in the method's signature (e.g. "database"), or the
+	// field of a struct parameter qualified by its type (e.g. "SelectOptions.Limit"). Elements of slices
+	// are indexed (e.g. "CreateDatabaseOptions.Datasets[1].Format").
+	Field string
+	// The value that was provided
+	Value any
+	// The constraint the value violated (e.g. "must not be negative")
+	Constraint string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s: %s, got %#v", e.Field, e.Constraint, e.Value)
+}
+
+// enum is implemented by the enumerated types in this package (e.g. [RDFFormat]) whose zero value
+// means "not specified".
+type enum interface {
+	comparable
+	Valid() bool
+}
+
+// validateDatabaseName checks that a database name was provided.
+func validateDatabaseName(database string) error {
+	return validateNotEmpty("database", database)
+}
+
+// validateNotEmpty checks that a required string parameter or field was provided.
+func validateNotEmpty(field string, value string) error {
+	if value == "" {
+		return &ValidationError{Field: field, Value: value, Constraint: "must not be empty"}
+	}
+	return nil
+}
+
+// validateNonNegative checks that an optional numeric option is not negative.
+func validateNonNegative(field string, value int) error {
+	if value < 0 {
+		return &ValidationError{Field: field, Value: value, Constraint: "must not be negative"}
+	}
+	return nil
+}
+
+// validateEnum checks that an optional enumerated option is either unset (its zero value) or valid.
+func validateEnum[T enum](field string, value T) error {
+	var unset T
+	if value != unset && !value.Valid() {
+		return &ValidationError{Field: field, Value: value, Constraint: "must be a known value"}
+	}
+	return nil
+}
+
+// firstError returns the first non-nil error.
+func firstError(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *SelectOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+	return firstError(
+		validateNonNegative("SelectOptions.Timeout", o.Timeout),
+		validateNonNegative("SelectOptions.Limit", o.Limit),
+		validateNonNegative("SelectOptions.Offset", o.Offset),
+		validateEnum("SelectOptions.ResultFormat", o.ResultFormat),
+	)
+}
+
+func (o *AskOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+	var resultFormatErr error
+	switch o.ResultFormat {
+	case QueryResultFormatUnknown, QueryResultFormatSparqlResultsJSON, QueryResultFormatSparqlResultsXML:
+	default:
+		resultFormatErr = &ValidationError{Field: "AskOptions.ResultFormat", Value: o.ResultFormat, Constraint: "must be QueryResultFormatSparqlResultsJSON or QueryResultFormatSparqlResultsXML"}
+	}
+	return firstError(
+		validateNonNegative("AskOptions.Timeout", o.Timeout),
+		resultFormatErr,
+	)
+}
+
+func (o *ConstructOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+	return firstError(
+		validateNonNegative("ConstructOptions.Timeout", o.Timeout),
+		validateNonNegative("ConstructOptions.Limit", o.Limit),
+		validateNonNegative("ConstructOptions.Offset", o.Offset),
+	)
+}
+
+func (o *DescribeOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+	return firstError(
+		validateNonNegative("DescribeOptions.Timeout", o.Timeout),
+		validateEnum("DescribeOptions.Strategy", o.Strategy),
+	)
+}
+
+func (o *UpdateOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+	return firstError(
+		validateNonNegative("UpdateOptions.Timeout", o.Timeout),
+		validateNonNegative("UpdateOptions.Limit", o.Limit),
+		validateNonNegative("UpdateOptions.Offset", o.Offset),
+	)
+}
+
+func (o *ExplainOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+	return validateEnum("ExplainOptions.QueryPlanFormat", o.QueryPlanFormat)
+}
+
+func (o *DataModelOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+	return validateEnum("DataModelOptions.OutputFormat", o.OutputFormat)
+}
+
+func (o *ExportDataOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+	return firstError(
+		validateEnum("ExportDataOptions.Format", o.Format),
+		validateEnum("ExportDataOptions.Compression", o.Compression),
+	)
+}
+
+func (o *ExportObfuscatedDataOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+	if o.ObfuscationConfig != nil && o.ObfuscationConfigReader != nil {
+		return &ValidationError{Field: "ExportObfuscatedDataOptions.ObfuscationConfigReader", Value: o.ObfuscationConfigReader, Constraint: "must not be set along with ObfuscationConfig"}
+	}
+	return firstError(
+		validateEnum("ExportObfuscatedDataOptions.Format", o.Format),
+		validateEnum("ExportObfuscatedDataOptions.Compression", o.Compression),
+	)
+}
+
+func (o *VirtualGraphOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+	return validateEnum("VirtualGraphOptions.MappingSyntax", o.MappingSyntax)
+}
+
+func (o *VirtualGraphMappingsOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+	return validateEnum("VirtualGraphMappingsOptions.Syntax", o.Syntax)
+}
+
+func (o *ImportVirtualGraphOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+	return firstError(
+		validateEnum("ImportVirtualGraphOptions.MappingSyntax", o.MappingSyntax),
+		validateEnum("ImportVirtualGraphOptions.InputFileType", o.InputFileType),
+	)
+}
+
+func (o *ExportNamedGraphsOptions) validate() error {
+	if o == nil || len(o.Graphs) == 0 {
+		return &ValidationError{Field: "ExportNamedGraphsOptions.Graphs", Value: nil, Constraint: "must not be empty"}
+	}
+	errs := []error{
+		validateEnum("ExportNamedGraphsOptions.Format", o.Format),
+		validateEnum("ExportNamedGraphsOptions.Compression", o.Compression),
+	}
+	for _, graph := range o.Graphs {
+		if graph.NamedGraph == "" {
+			errs = append(errs, &ValidationError{Field: "NamedGraphExport.NamedGraph", Value: graph.NamedGraph, Constraint: "must not be empty"})
+		}
+		errs = append(errs, validateEnum("NamedGraphExport.Compression", graph.Compression))
+	}
+	return firstError(errs...)
+}
+
+func (o *ICVReportOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+	return validateNonNegative("ICVReportOptions.CountLimit", o.CountLimit)
+}
+
+func (o *ChunkedExportOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+	return validateEnum("ChunkedExportOptions.Format", o.Format)
+}
+
+func (o *DropManyOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+	return validateNonNegative("DropManyOptions.Concurrency", o.Concurrency)
+}
+
+func (o *ShutdownOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+	if o.GracePeriod < 0 {
+		return &ValidationError{Field: "ShutdownOptions.GracePeriod", Value: o.GracePeriod, Constraint: "must not be negative"}
+	}
+	return nil
+}
+
+func (o *CreateDatabaseOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+	var errs []error
+	for i, dataset := range o.Datasets {
+		if dataset.Reader == nil {
+			continue
+		}
+		field := fmt.Sprintf("CreateDatabaseOptions.Datasets[%d]", i)
+		if !o.CopyToServer {
+			errs = append(errs, &ValidationError{Field: "CreateDatabaseOptions.CopyToServer", Value: o.CopyToServer, Constraint: "must be true to upload a Dataset with a Reader"})
+		}
+		if dataset.Path == "" && !dataset.Format.Valid() {
+			errs = append(errs, &ValidationError{Field: field + ".Format", Value: dataset.Format, Constraint: "must be set for a Reader without a Path"})
+		}
+		errs = append(errs,
+			validateEnum(field+".Format", dataset.Format),
+			validateEnum(field+".Compression", dataset.Compression),
+		)
+	}
+	return firstError(errs...)
+}
+
+func (o *TransactionAddOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+	return validateEnum("TransactionAddOptions.Compression", o.Compression)
+}
+
+// validate checks that the namespace can be stored in the database.namespaces option, whose values are "prefix=IRI".
+func (n Namespace) validate(field string) error {
+	if strings.ContainsAny(n.Prefix, ":= \t\n") {
+		return &ValidationError{Field: field + ".Prefix", Value: n.Prefix, Constraint: "must not contain ':', '=' or whitespace"}
+	}
+	if n.Name == "" {
+		return &ValidationError{Field: field + ".Name", Value: n.Name, Constraint: "must not be empty"}
+	}
+	return nil
+}
+
+// validateVariables checks that there's at least one variable and that each is a valid variable name.
+func validateVariables(field string, variables []string) error {
+	if len(variables) == 0 {
+		return &ValidationError{Field: field, Value: variables, Constraint: "must not be empty"}
+	}
+	for i, variable := range variables {
+		if !sparqlVariableName.MatchString(strings.TrimLeft(variable, "?$")) {
+			return &ValidationError{Field: fmt.Sprintf("%s[%d]", field, i), Value: variable, Constraint: "must be a SPARQL variable name"}
+		}
+	}
+	return nil
+}
+
+func (s *SimilarityModelSpec) validate() error {
+	if s == nil {
+		return &ValidationError{Field: "spec", Value: s, Constraint: "must not be nil"}
+	}
+	if s.Model == "" {
+		return &ValidationError{Field: "SimilarityModelSpec.Model", Value: s.Model, Constraint: "must not be empty"}
+	}
+	if s.Where == "" {
+		return &ValidationError{Field: "SimilarityModelSpec.Where", Value: s.Where, Constraint: "must not be empty"}
+	}
+	if !sparqlVariableName.MatchString(strings.TrimLeft(s.Predict, "?$")) {
+		return &ValidationError{Field: "SimilarityModelSpec.Predict", Value: s.Predict, Constraint: "must be a SPARQL variable name"}
+	}
+	return validateVariables("SimilarityModelSpec.Arguments", s.Arguments)
+}
+
+func (q *SimilarityQuery) validate() error {
+	if q == nil {
+		return &ValidationError{Field: "query", Value: q, Constraint: "must not be nil"}
+	}
+	if q.Model == "" {
+		return &ValidationError{Field: "SimilarityQuery.Model", Value: q.Model, Constraint: "must not be empty"}
+	}
+	if q.Where == "" {
+		return &ValidationError{Field: "SimilarityQuery.Where", Value: q.Where, Constraint: "must not be empty"}
+	}
+	return firstError(
+		validateNonNegative("SimilarityQuery.Limit", q.Limit),
+		validateVariables("SimilarityQuery.Arguments", q.Arguments),
+	)
+}
+
+func (t *NewCacheTarget) validate() error {
+	if t == nil {
+		return &ValidationError{Field: "target", Value: t, Constraint: "must not be nil"}
+	}
+	if t.Name == "" {
+		return &ValidationError{Field: "NewCacheTarget.Name", Value: t.Name, Constraint: "must not be empty"}
+	}
+	if t.Hostname == "" {
+		return &ValidationError{Field: "NewCacheTarget.Hostname", Value: t.Hostname, Constraint: "must not be empty"}
+	}
+	if t.Port <= 0 || t.Port > 65535 {
+		return &ValidationError{Field: "NewCacheTarget.Port", Value: t.Port, Constraint: "must be between 1 and 65535"}
+	}
+	return nil
+}
+
+func (c *NewCache) validate() error {
+	if c == nil {
+		return &ValidationError{Field: "cache", Value: c, Constraint: "must not be nil"}
+	}
+	if c.Name == "" {
+		return &ValidationError{Field: "NewCache.Name", Value: c.Name, Constraint: "must not be empty"}
+	}
+	if c.Target == "" {
+		return &ValidationError{Field: "NewCache.Target", Value: c.Target, Constraint: "must not be empty"}
+	}
+	if (c.Graph == "") == (c.Query == "") {
+		return &ValidationError{Field: "NewCache.Graph", Value: c.Graph, Constraint: "exactly one of Graph and Query must be set"}
+	}
+	return validateNotEmpty("NewCache.Database", c.Database)
+}
@@ -0,0 +1,169 @@
+package stardog
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValidationError_Error(t *testing.T) {
+	err := &ValidationError{Field: "SelectOptions.Limit", Value: -1, Constraint: "must not be negative"}
+	want := "invalid SelectOptions.Limit: must not be negative, got -1"
+	if got := err.Error(); got != want {
+		t.Errorf("ValidationError.Error() = %q, want %q", got, want)
+	}
+}
+
+func TestValidation_methods(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	dir, err := os.Open(os.TempDir())
+	if err != nil {
+		t.Fatalf("unable to open temp dir: %v", err)
+	}
+	defer dir.Close()
+
+	tests := []struct {
+		name string
+		call func() error
+		want *ValidationError
+	}{
+		{
+			name: "Sparql.Select empty database",
+			call: func() error {
+				_, _, err := client.Sparql.Select(ctx, "", "SELECT * {}", nil)
+				return err
+			},
+			want: &ValidationError{Field: "database", Value: "", Constraint: "must not be empty"},
+		},
+		{
+			name: "Sparql.Select negative limit",
+			call: func() error {
+				_, _, err := client.Sparql.Select(ctx, "db", "SELECT * {}", &SelectOptions{Limit: -1})
+				return err
+			},
+			want: &ValidationError{Field: "SelectOptions.Limit", Value: -1, Constraint: "must not be negative"},
+		},
+		{
+			name: "Sparql.Select unknown result format",
+			call: func() error {
+				_, _, err := client.Sparql.Select(ctx, "db", "SELECT * {}", &SelectOptions{ResultFormat: QueryResultFormat(100)})
+				return err
+			},
+			want: &ValidationError{Field: "SelectOptions.ResultFormat", Value: QueryResultFormat(100), Constraint: "must be a known value"},
+		},
+		{
+			name: "Sparql.Ask negative timeout",
+			call: func() error {
+				_, _, err := client.Sparql.Ask(ctx, "db", "ASK {}", &AskOptions{Timeout: -1})
+				return err
+			},
+			want: &ValidationError{Field: "AskOptions.Timeout", Value: -1, Constraint: "must not be negative"},
+		},
+		{
+			name: "Sparql.Update negative offset",
+			call: func() error {
+				_, err := client.Sparql.Update(ctx, "db", "CLEAR ALL", &UpdateOptions{Offset: -5})
+				return err
+			},
+			want: &ValidationError{Field: "UpdateOptions.Offset", Value: -5, Constraint: "must not be negative"},
+		},
+		{
+			name: "Sparql.Explain unknown plan format",
+			call: func() error {
+				_, _, err := client.Sparql.Explain(ctx, "db", "SELECT * {}", &ExplainOptions{QueryPlanFormat: QueryPlanFormat(7)})
+				return err
+			},
+			want: &ValidationError{Field: "ExplainOptions.QueryPlanFormat", Value: QueryPlanFormat(7), Constraint: "must be a known value"},
+		},
+		{
+			name: "Transaction.Begin empty database",
+			call: func() error {
+				_, _, err := client.Transaction.Begin(ctx, "")
+				return err
+			},
+			want: &ValidationError{Field: "database", Value: "", Constraint: "must not be empty"},
+		},
+		{
+			name: "DatabaseAdmin.Create empty name",
+			call: func() error {
+				_, _, err := client.DatabaseAdmin.Create(ctx, "", nil)
+				return err
+			},
+			want: &ValidationError{Field: "name", Value: "", Constraint: "must not be empty"},
+		},
+		{
+			name: "DatabaseAdmin.Rename empty new name",
+			call: func() error {
+				_, err := client.DatabaseAdmin.Rename(ctx, "db1", "")
+				return err
+			},
+			want: &ValidationError{Field: "newName", Value: "", Constraint: "must not be empty"},
+		},
+		{
+			name: "DiffDatabases nil target",
+			call: func() error {
+				_, err := DiffDatabases(ctx, client, "db1", nil, "db2", nil)
+				return err
+			},
+			want: &ValidationError{Field: "target", Value: (*Client)(nil), Constraint: "must not be nil"},
+		},
+		{
+			name: "DatabaseAdmin.DataModel unknown output format",
+			call: func() error {
+				_, _, err := client.DatabaseAdmin.DataModel(ctx, "db", &DataModelOptions{OutputFormat: DataModelFormat(42)})
+				return err
+			},
+			want: &ValidationError{Field: "DataModelOptions.OutputFormat", Value: DataModelFormat(42), Constraint: "must be a known value"},
+		},
+		{
+			name: "DatabaseAdmin.ExportData unknown format",
+			call: func() error {
+				_, _, err := client.DatabaseAdmin.ExportData(ctx, "db", &ExportDataOptions{Format: RDFFormat(100)})
+				return err
+			},
+			want: &ValidationError{Field: "ExportDataOptions.Format", Value: RDFFormat(100), Constraint: "must be a known value"},
+		},
+		{
+			name: "DatabaseAdmin.ExportObfuscatedData unknown compression",
+			call: func() error {
+				_, _, err := client.DatabaseAdmin.ExportObfuscatedData(ctx, "db", &ExportObfuscatedDataOptions{Compression: Compression(9)})
+				return err
+			},
+			want: &ValidationError{Field: "ExportObfuscatedDataOptions.Compression", Value: Compression(9), Constraint: "must be a known value"},
+		},
+		{
+			name: "DatabaseAdmin.ImportNamespaces directory",
+			call: func() error {
+				_, _, err := client.DatabaseAdmin.ImportNamespaces(ctx, "db", dir)
+				return err
+			},
+			want: &ValidationError{Field: "file", Value: dir.Name(), Constraint: "must not be a directory"},
+		},
+		{
+			name: "Virtual.Mappings unknown syntax",
+			call: func() error {
+				_, _, err := client.Virtual.Mappings(ctx, "vg", &VirtualGraphMappingsOptions{Syntax: MappingSyntax(9)})
+				return err
+			},
+			want: &ValidationError{Field: "VirtualGraphMappingsOptions.Syntax", Value: MappingSyntax(9), Constraint: "must be a known value"},
+		},
+	}
+
+	for _, test := range tests {
+		err := test.call()
+		var got *ValidationError
+		if !errors.As(err, &got) {
+			t.Errorf("%s err = %#v, want *ValidationError", test.name, err)
+			continue
+		}
+		if !cmp.Equal(got, test.want) {
+			t.Errorf("%s err = %+v, want %+v", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,717 @@
+package stardog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode/utf16"
+)
+
+// VirtualGraphService handles communication with the [virtual graph] related methods of the Stardog API.
+//
+// [virtual graph]: https://docs.stardog.com/virtual-graphs/
+type VirtualGraphService service
+
+// VirtualGraph represents a Stardog virtual graph.
+type VirtualGraph struct {
+	// Name of the virtual graph (e.g. virtual://movies)
+	Name string `json:"name"`
+	// Name of the data source the virtual graph uses
+	DataSource string `json:"data_source"`
+	// The database the virtual graph is associated with ("*" if associated with all databases)
+	Database string `json:"database"`
+	// Whether the virtual graph is available or not
+	Available bool `json:"available"`
+}
+
+// MappingSyntax represents the syntax of a virtual graph's mappings.
+// The zero value for a MappingSyntax is [MappingSyntaxUnknown]
+type MappingSyntax int
+
+// All available mapping syntaxes
+const (
+	MappingSyntaxUnknown MappingSyntax = iota
+	MappingSyntaxSMS2
+	MappingSyntaxR2RML
+)
+
+// mappingSyntaxValues maps each MappingSyntax to its string value
+var mappingSyntaxValues = [3]string{
+	MappingSyntaxUnknown: "",
+	MappingSyntaxSMS2:    "SMS2",
+	MappingSyntaxR2RML:   "R2RML",
+}
+
+// Valid returns if a given MappingSyntax is known (valid) or not.
+func (m MappingSyntax) Valid() bool {
+	return !(m <= MappingSyntaxUnknown || int(m) >= len(mappingSyntaxValues))
+}
+
+// String will return the string representation of the MappingSyntax
+func (m MappingSyntax) String() string {
+	if !m.Valid() {
+		return mappingSyntaxValues[MappingSyntaxUnknown]
+	}
+	return mappingSyntaxValues[m]
+}
+
+// VirtualGraphOptions specifies the optional parameters to the [VirtualGraphService.Create] and
+// [VirtualGraphService.Update] methods
+type VirtualGraphOptions struct {
+	// The mappings for the virtual graph. If empty, Stardog will generate default mappings.
+	Mappings string
+	// The syntax of Mappings
+	MappingSyntax MappingSyntax
+	// Virtual graph configuration options
+	Options map[string]any
+	// The name of an existing data source to use for the virtual graph
+	DataSource string
+	// The database to associate the virtual graph with. If empty, the virtual graph is accessible from all databases.
+	Database string
+}
+
+// VirtualGraphMappingsOptions specifies the optional parameters to the [VirtualGraphService.Mappings] method
+type VirtualGraphMappingsOptions struct {
+	// The syntax to return the mappings in
+	Syntax MappingSyntax `url:"syntax,omitempty"`
+}
+
+// VirtualGraphImportFileType is the type of input file imported by [VirtualGraphService.Import].
+// The zero value for a VirtualGraphImportFileType is [VirtualGraphImportFileTypeUnknown]
+type VirtualGraphImportFileType int
+
+// All available input file types for a virtual graph import
+const (
+	VirtualGraphImportFileTypeUnknown VirtualGraphImportFileType = iota
+	VirtualGraphImportFileTypeCSV
+	VirtualGraphImportFileTypeJSON
+)
+
+// virtualGraphImportFileTypeValues maps each VirtualGraphImportFileType to its string value
+var virtualGraphImportFileTypeValues = [3]string{
+	VirtualGraphImportFileTypeUnknown: "",
+	VirtualGraphImportFileTypeCSV:     "CSV",
+	VirtualGraphImportFileTypeJSON:    "JSON",
+}
+
+// Valid returns if a given VirtualGraphImportFileType is known (valid) or not.
+func (v VirtualGraphImportFileType) Valid() bool {
+	return !(v <= VirtualGraphImportFileTypeUnknown || int(v) >= len(virtualGraphImportFileTypeValues))
+}
+
+// String will return the string representation of the VirtualGraphImportFileType
+func (v VirtualGraphImportFileType) String() string {
+	if !v.Valid() {
+		return virtualGraphImportFileTypeValues[VirtualGraphImportFileTypeUnknown]
+	}
+	return virtualGraphImportFileTypeValues[v]
+}
+
+// ImportVirtualGraphOptions specifies the optional parameters to the [VirtualGraphService.Import] method
+type ImportVirtualGraphOptions struct {
+	// The mappings used to transform the source data into RDF
+	Mappings string
+	// The syntax of Mappings
+	MappingSyntax MappingSyntax
+	// Import configuration options (e.g. csv.separator)
+	Options map[string]any
+	// The name of an existing data source to import from. Ignored if InputFile is provided.
+	DataSource string
+	// The named graph to import the data into. If empty, data is imported into the default graph.
+	NamedGraph string
+	// Whether to remove all data from the target graph before importing
+	RemoveAll bool
+	// A client side CSV, TSV or JSON file to import instead of a data source
+	InputFile *os.File
+	// The type of InputFile. If not specified, it is determined from InputFile's extension, with .tsv files
+	// imported as CSV separated by tabs.
+	InputFileType VirtualGraphImportFileType
+	// How a CSV or TSV InputFile is parsed. Values set here take precedence over the csv.* entries of Options.
+	Delimited *DelimitedFileOptions
+	// If not nil, the mappings are read from MappingsReader (e.g. an SMS2 or R2RML file) instead of Mappings.
+	MappingsReader io.Reader
+}
+
+// DelimitedFileOptions specifies how a delimited (CSV or TSV) file imported with [VirtualGraphService.Import]
+// is parsed. Unset fields use Stardog's defaults.
+type DelimitedFileOptions struct {
+	// The character separating fields (default ',')
+	Separator rune
+	// The character used to quote fields (default '"')
+	Quote rune
+	// The character used to escape quotes within quoted fields
+	Escape rune
+	// Whether the file has no header row, in which case columns are named by their position
+	NoHeader bool
+}
+
+// importOptions returns the import configuration options for the delimited file settings, added to options.
+func (d *DelimitedFileOptions) importOptions(options map[string]any) map[string]any {
+	if d == nil {
+		return options
+	}
+	merged := make(map[string]any, len(options)+4)
+	for k, v := range options {
+		merged[k] = v
+	}
+	for key, char := range map[string]rune{"csv.separator": d.Separator, "csv.quote": d.Quote, "csv.escape": d.Escape} {
+		if char != 0 {
+			merged[key] = string(char)
+		}
+	}
+	if d.NoHeader {
+		merged["csv.header"] = false
+	}
+	return merged
+}
+
+// request for Import (from a data source)
+type importVirtualGraphRequest struct {
+	Database   string         `json:"db"`
+	Mappings   string         `json:"mappings"`
+	Options    map[string]any `json:"options"`
+	DataSource string         `json:"data_source,omitempty"`
+	NamedGraph string         `json:"named_graph,omitempty"`
+	RemoveAll  bool           `json:"remove_all"`
+}
+
+// response for ListNames
+type listVirtualGraphNamesResponse struct {
+	VirtualGraphs []string `json:"virtual_graphs"`
+}
+
+// response for List
+type listVirtualGraphsResponse struct {
+	VirtualGraphs []VirtualGraph `json:"virtual_graphs"`
+}
+
+// response for Options
+type virtualGraphOptionsResponse struct {
+	Options map[string]any `json:"options"`
+}
+
+// request for Create and Update
+type virtualGraphRequest struct {
+	Name       string         `json:"name,omitempty"`
+	Mappings   string         `json:"mappings"`
+	Options    map[string]any `json:"options"`
+	DataSource string         `json:"data_source,omitempty"`
+	Database   string         `json:"db,omitempty"`
+}
+
+// virtualGraphOptionMappingsSyntax is the virtual graph option specifying the syntax of the mappings
+const virtualGraphOptionMappingsSyntax = "mappings.syntax"
+
+// newVirtualGraphRequest builds the request body shared by Create and Update.
+func newVirtualGraphRequest(name string, mappings string, syntax MappingSyntax, options map[string]any, dataSource string, database string) *virtualGraphRequest {
+	return &virtualGraphRequest{
+		Name:       name,
+		Mappings:   mappings,
+		Options:    virtualGraphOptions(options, syntax),
+		DataSource: dataSource,
+		Database:   database,
+	}
+}
+
+// virtualGraphOptions returns a copy of options with the mapping syntax option set, if syntax is valid.
+func virtualGraphOptions(options map[string]any, syntax MappingSyntax) map[string]any {
+	vgOpts := make(map[string]any, len(options)+1)
+	for k, v := range options {
+		vgOpts[k] = v
+	}
+	if syntax.Valid() {
+		vgOpts[virtualGraphOptionMappingsSyntax] = syntax.String()
+	}
+	return vgOpts
+}
+
+// ListNames returns the names of all virtual graphs registered in the system
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Virtual-Graphs/operation/listVGs
+func (s *VirtualGraphService) ListNames(ctx context.Context) ([]string, *Response, error) {
+	u := "admin/virtual_graphs"
+	headerOpts := &requestHeaderOptions{
+		Accept: mediaTypeApplicationJSON,
+	}
+	req, err := s.client.NewRequest(http.MethodGet, u, headerOpts, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var listVirtualGraphNamesResponse listVirtualGraphNamesResponse
+	resp, err := s.client.Do(ctx, req, &listVirtualGraphNamesResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+	return listVirtualGraphNamesResponse.VirtualGraphs, resp, nil
+}
+
+// List returns all virtual graphs registered in the system
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Virtual-Graphs/operation/virtualGraphInfos
+func (s *VirtualGraphService) List(ctx context.Context) ([]VirtualGraph, *Response, error) {
+	u := "admin/virtual_graphs/list"
+	headerOpts := &requestHeaderOptions{
+		Accept: mediaTypeApplicationJSON,
+	}
+	req, err := s.client.NewRequest(http.MethodGet, u, headerOpts, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var listVirtualGraphsResponse listVirtualGraphsResponse
+	resp, err := s.client.Do(ctx, req, &listVirtualGraphsResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+	return listVirtualGraphsResponse.VirtualGraphs, resp, nil
+}
+
+// DataSource returns the data source a virtual graph uses. The virtual graph may be named with or without
+// its virtual:// prefix. [ErrDataSourceNotFound] is returned if the virtual graph doesn't exist or its data
+// source isn't registered.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Data-Sources/operation/dataSourceInfos
+func (s *VirtualGraphService) DataSource(ctx context.Context, name string) (*DataSource, *Response, error) {
+	virtualGraphs, resp, err := s.List(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	var dataSourceName string
+	for _, virtualGraph := range virtualGraphs {
+		if strings.TrimPrefix(virtualGraph.Name, virtualGraphPrefix) == strings.TrimPrefix(name, virtualGraphPrefix) {
+			dataSourceName = virtualGraph.DataSource
+			break
+		}
+	}
+	if dataSourceName == "" {
+		return nil, resp, ErrDataSourceNotFound
+	}
+	dataSources, resp, err := s.client.DataSource.List(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	for _, dataSource := range dataSources {
+		if sameDataSource(dataSource.Name, dataSourceName) {
+			return &dataSource, resp, nil
+		}
+	}
+	return nil, resp, ErrDataSourceNotFound
+}
+
+// ErrDataSourceNotFound is returned by [VirtualGraphService.DataSource] if there's no data source for the virtual graph.
+var ErrDataSourceNotFound = errors.New("stardog: no data source found for the virtual graph")
+
+// virtualGraphPrefix prefixes the names of virtual graphs in the responses of some endpoints.
+const virtualGraphPrefix = "virtual://"
+
+// Create adds a new virtual graph to the system
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Virtual-Graphs/operation/addVG
+func (s *VirtualGraphService) Create(ctx context.Context, name string, opts *VirtualGraphOptions) (*Response, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	u := "admin/virtual_graphs"
+	headerOpts := &requestHeaderOptions{
+		ContentType: mediaTypeApplicationJSON,
+	}
+	if opts == nil {
+		opts = &VirtualGraphOptions{}
+	}
+	reqBody := newVirtualGraphRequest(name, opts.Mappings, opts.MappingSyntax, opts.Options, opts.DataSource, opts.Database)
+	req, err := s.client.NewRequest(http.MethodPost, u, headerOpts, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// Update updates an existing virtual graph.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Virtual-Graphs/operation/updateVG
+func (s *VirtualGraphService) Update(ctx context.Context, name string, opts *VirtualGraphOptions) (*Response, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("admin/virtual_graphs/%s", name)
+	headerOpts := &requestHeaderOptions{
+		ContentType: mediaTypeApplicationJSON,
+	}
+	if opts == nil {
+		opts = &VirtualGraphOptions{}
+	}
+	reqBody := newVirtualGraphRequest(name, opts.Mappings, opts.MappingSyntax, opts.Options, opts.DataSource, opts.Database)
+	req, err := s.client.NewRequest(http.MethodPut, u, headerOpts, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// Delete deletes a virtual graph.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Virtual-Graphs/operation/removeVG
+func (s *VirtualGraphService) Delete(ctx context.Context, name string) (*Response, error) {
+	u := fmt.Sprintf("admin/virtual_graphs/%s", name)
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// Options returns all set options for the given virtual graph
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Virtual-Graphs/operation/getVGOptions
+func (s *VirtualGraphService) Options(ctx context.Context, name string) (map[string]any, *Response, error) {
+	u := fmt.Sprintf("admin/virtual_graphs/%s/options", name)
+	headerOpts := &requestHeaderOptions{
+		Accept: mediaTypeApplicationJSON,
+	}
+	req, err := s.client.NewRequest(http.MethodGet, u, headerOpts, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var virtualGraphOptionsResponse virtualGraphOptionsResponse
+	resp, err := s.client.Do(ctx, req, &virtualGraphOptionsResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+	return virtualGraphOptionsResponse.Options, resp, nil
+}
+
+// Mappings returns the mappings for the given virtual graph. If VirtualGraphMappingsOptions.Syntax
+// is not specified, Stardog will return the mappings in its default syntax.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Virtual-Graphs/operation/getMappings
+func (s *VirtualGraphService) Mappings(ctx context.Context, name string, opts *VirtualGraphMappingsOptions) (*bytes.Buffer, *Response, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("admin/virtual_graphs/%s/mappings", name)
+	urlWithOptions, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(http.MethodGet, urlWithOptions, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var buf bytes.Buffer
+	resp, err := s.client.Do(ctx, req, &buf)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &buf, resp, nil
+}
+
+// Online attempts to bring a virtual graph online. Stardog has no way to take a virtual graph offline.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Virtual-Graphs/operation/onlineVG
+func (s *VirtualGraphService) Online(ctx context.Context, name string) (*Response, error) {
+	u := fmt.Sprintf("admin/virtual_graphs/%s/online", name)
+	req, err := s.client.NewRequest(http.MethodPost, u, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// ExplainVirtualGraphQueryOptions specifies the optional parameters to the [VirtualGraphService.ExplainQuery] method
+type ExplainVirtualGraphQueryOptions struct {
+	// Enable reasoning
+	Reasoning bool `url:"reasoning,omitempty"`
+	// Run the query profiler
+	Profile bool `url:"profile,omitempty"`
+	// Name of a virtual graph to query, with or without the virtual:// prefix. If set, it's used as the
+	// query's default graph (equivalent to FROM <virtual://name>).
+	VirtualGraph string `url:"-"`
+}
+
+// QueryPlanNode is a single operator in a Stardog query plan.
+type QueryPlanNode struct {
+	// Label of the operator (e.g. "Projection(?s)" or "VirtualGraphSql<virtual://movies> [#10] {...}")
+	Label string `json:"label"`
+	// Estimated cardinality of the operator
+	Cardinality int64 `json:"cardinality"`
+	// Child operators
+	Children []QueryPlanNode `json:"children"`
+}
+
+// VirtualGraphPushdown is a portion of a query plan that Stardog pushes down to
+// the data source backing a virtual graph.
+type VirtualGraphPushdown struct {
+	// The plan operator that performs the pushdown (e.g. "VirtualGraphSql")
+	Operator string
+	// The virtual graph the pushdown is executed against (e.g. "virtual://movies")
+	VirtualGraph string
+	// The native query (e.g. SQL) that Stardog generated and sent to the data source.
+	// Any filters pushed down to the data source will be contained in this query.
+	Query string
+	// Estimated cardinality of the pushdown
+	Cardinality int64
+}
+
+// VirtualGraphQueryPlan is the query plan for a query that touches one or more virtual graphs.
+type VirtualGraphQueryPlan struct {
+	// The root of the query plan
+	Plan QueryPlanNode `json:"plan"`
+	// All portions of the plan pushed down to a virtual graph's data source, in plan order
+	Pushdowns []VirtualGraphPushdown `json:"-"`
+}
+
+// ExplainQuery retrieves the query plan for a query that touches virtual graphs and reports
+// the translation Stardog performs for each virtual graph (e.g. the generated SQL and any filters pushed down to the data source).
+// The query is explained as by [SPARQLService.Explain], and ExplainVirtualGraphQueryOptions.VirtualGraph scopes it
+// to a single virtual graph.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/SPARQL/operation/explainQueryPost
+func (s *VirtualGraphService) ExplainQuery(ctx context.Context, database string, query string, opts *ExplainVirtualGraphQueryOptions) (*VirtualGraphQueryPlan, *Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, nil, err
+	}
+	explainOpts := &ExplainOptions{QueryPlanFormat: QueryPlanFormatJSON}
+	if opts != nil {
+		explainOpts.Reasoning = opts.Reasoning
+		explainOpts.Profile = opts.Profile
+		if opts.VirtualGraph != "" {
+			explainOpts.DefaultGraphURI = virtualGraphPrefix + strings.TrimPrefix(opts.VirtualGraph, virtualGraphPrefix)
+		}
+	}
+	buf, resp, err := s.client.Sparql.Explain(ctx, database, query, explainOpts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var plan VirtualGraphQueryPlan
+	if err := json.Unmarshal(buf.Bytes(), &plan); err != nil {
+		return nil, resp, err
+	}
+	plan.Pushdowns = findVirtualGraphPushdowns(plan.Plan, nil)
+	return &plan, resp, nil
+}
+
+// findVirtualGraphPushdowns walks the plan depth-first and collects each virtual graph operator it encounters.
+func findVirtualGraphPushdowns(node QueryPlanNode, pushdowns []VirtualGraphPushdown) []VirtualGraphPushdown {
+	if pushdown, ok := parseVirtualGraphPushdown(node); ok {
+		pushdowns = append(pushdowns, pushdown)
+	}
+	for _, child := range node.Children {
+		pushdowns = findVirtualGraphPushdowns(child, pushdowns)
+	}
+	return pushdowns
+}
+
+// parseVirtualGraphPushdown parses a virtual graph operator label such as:
+//
+//	VirtualGraphSql<virtual://movies> [#10] {
+//	SELECT "id", "title" FROM "movies" WHERE "year" > 2000
+//	}
+func parseVirtualGraphPushdown(node QueryPlanNode) (VirtualGraphPushdown, bool) {
+	label := strings.TrimSpace(node.Label)
+	if !strings.HasPrefix(label, "VirtualGraph") {
+		return VirtualGraphPushdown{}, false
+	}
+	pushdown := VirtualGraphPushdown{Cardinality: node.Cardinality}
+
+	operatorEnd := strings.IndexAny(label, "<[{ \n")
+	if operatorEnd == -1 {
+		pushdown.Operator = label
+		return pushdown, true
+	}
+	pushdown.Operator = label[:operatorEnd]
+
+	if open := strings.Index(label, "<"); open != -1 {
+		if end := strings.Index(label[open:], ">"); end != -1 {
+			pushdown.VirtualGraph = label[open+1 : open+end]
+		}
+	}
+	if open := strings.Index(label, "{"); open != -1 {
+		if end := strings.LastIndex(label, "}"); end > open {
+			pushdown.Query = strings.TrimSpace(label[open+1 : end])
+		}
+	}
+	return pushdown, true
+}
+
+// Import materializes the contents of a virtual graph into a database, equivalent to `stardog-admin virtual import`.
+// Data is either imported from the data source given in ImportVirtualGraphOptions.DataSource or from a
+// client side CSV/TSV/JSON file given in ImportVirtualGraphOptions.InputFile, parsed as configured by
+// ImportVirtualGraphOptions.Delimited.
+//
+// Stardog API: https://stardog-union.github.io/http-docs/#tag/Virtual-Graphs/operation/importVirtualGraph
+func (s *VirtualGraphService) Import(ctx context.Context, database string, opts *ImportVirtualGraphOptions) (*Response, error) {
+	if err := validateDatabaseName(database); err != nil {
+		return nil, err
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &ImportVirtualGraphOptions{}
+	}
+	mappings := opts.Mappings
+	if opts.MappingsReader != nil {
+		b, err := io.ReadAll(opts.MappingsReader)
+		if err != nil {
+			return nil, err
+		}
+		mappings = string(b)
+	}
+
+	var req *http.Request
+	if opts.InputFile != nil {
+		body, err := newImportVirtualGraphFileRequestBody(database, mappings, opts)
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		headerOpts := &requestHeaderOptions{
+			ContentType: body.ContentType(),
+		}
+		req, err = s.client.NewMultipartFormDataRequest(http.MethodPost, "admin/virtual_graphs/import_file", headerOpts, body)
+		if err != nil {
+			return nil, err
+		}
+		req.ContentLength = body.Size()
+	} else {
+		headerOpts := &requestHeaderOptions{
+			ContentType: mediaTypeApplicationJSON,
+		}
+		reqBody := &importVirtualGraphRequest{
+			Database:   database,
+			Mappings:   mappings,
+			Options:    virtualGraphOptions(opts.Options, opts.MappingSyntax),
+			DataSource: opts.DataSource,
+			NamedGraph: opts.NamedGraph,
+			RemoveAll:  opts.RemoveAll,
+		}
+		var err error
+		req, err = s.client.NewRequest(http.MethodPost, "admin/virtual_graphs/import", headerOpts, reqBody)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// newImportVirtualGraphFileRequestBody creates the multipart request body needed to import a file for VirtualGraphService.Import.
+// The file is streamed from its current offset as the body is read rather than buffered in memory.
+func newImportVirtualGraphFileRequestBody(database string, mappings string, opts *ImportVirtualGraphOptions) (*multipartUpload, error) {
+	stat, err := opts.InputFile.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if stat.IsDir() {
+		return nil, &ValidationError{Field: "ImportVirtualGraphOptions.InputFile", Value: opts.InputFile.Name(), Constraint: "must not be a directory"}
+	}
+
+	fileType := opts.InputFileType
+	options := opts.Options
+	if !fileType.Valid() {
+		switch strings.ToLower(filepath.Ext(opts.InputFile.Name())) {
+		case ".csv":
+			fileType = VirtualGraphImportFileTypeCSV
+		case ".tsv":
+			fileType = VirtualGraphImportFileTypeCSV
+			if _, ok := options["csv.separator"]; !ok {
+				options = (&DelimitedFileOptions{Separator: '\t'}).importOptions(options)
+			}
+		case ".json":
+			fileType = VirtualGraphImportFileTypeJSON
+		default:
+			return nil, &ValidationError{Field: "ImportVirtualGraphOptions.InputFileType", Value: opts.InputFile.Name(), Constraint: "must be specified if the file extension is not .csv, .tsv or .json"}
+		}
+	}
+	options = opts.Delimited.importOptions(options)
+
+	size := stat.Size()
+	if offset, err := opts.InputFile.Seek(0, io.SeekCurrent); err == nil {
+		size -= offset
+	} else {
+		size = -1
+	}
+
+	fields := map[string]string{
+		"database":        database,
+		"mappings":        mappings,
+		"options":         toProperties(virtualGraphOptions(options, opts.MappingSyntax)),
+		"named_graph":     opts.NamedGraph,
+		"input_file_type": fileType.String(),
+		"remove_all":      fmt.Sprintf("%t", opts.RemoveAll),
+	}
+	file := uploadFile{name: opts.InputFile.Name(), field: "input_file", r: opts.InputFile, size: size}
+	return newMultipartUpload(fields, []uploadFile{file}), nil
+}
+
+// toProperties serializes options in the Java properties format Stardog expects, sorted by key. Keys and values
+// are escaped as java.util.Properties.store does, so values such as Windows paths and JDBC URLs are read back
+// unchanged.
+func toProperties(options map[string]any) string {
+	keys := make([]string, 0, len(options))
+	for k := range options {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	for _, k := range keys {
+		value, ok := options[k].(string)
+		if !ok {
+			encoded, err := json.Marshal(options[k])
+			if err != nil {
+				encoded = []byte(fmt.Sprint(options[k]))
+			}
+			value = string(encoded)
+		}
+		fmt.Fprintf(&sb, "%s=%s\n", escapeProperty(k, true), escapeProperty(value, false))
+	}
+	return sb.String()
+}
+
+// escapeProperty escapes a key or value of a Java properties file. Backslashes, line terminators, tabs and form
+// feeds are always escaped, as are characters outside printable ASCII. Spaces are escaped everywhere in keys but
+// only at the start of values, and the separators and comment characters =, :, # and ! only in keys.
+func escapeProperty(s string, key bool) string {
+	var sb strings.Builder
+	for i, r := range s {
+		switch r {
+		case '\\':
+			sb.WriteString(`\\`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\t':
+			sb.WriteString(`\t`)
+		case '\f':
+			sb.WriteString(`\f`)
+		case ' ':
+			if key || i == 0 {
+				sb.WriteByte('\\')
+			}
+			sb.WriteRune(r)
+		case '=', ':', '#', '!':
+			if key {
+				sb.WriteByte('\\')
+			}
+			sb.WriteRune(r)
+		default:
+			if r < 0x20 || r > 0x7e {
+				for _, unit := range utf16.Encode([]rune{r}) {
+					fmt.Fprintf(&sb, `\u%04x`, unit)
+				}
+				continue
+			}
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
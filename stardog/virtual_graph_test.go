@@ -0,0 +1,714 @@
+package stardog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestVirtualGraphService_ExplainQuery(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	query := `SELECT ?title { ?m :title ?title FILTER(?year > 2000) }`
+	planJSON := []byte(`{
+    "dataset": {"from": "ALL", "fromNamed": "ALL"},
+    "plan": {
+      "label": "Projection(?title) [#10]",
+      "cardinality": 10,
+      "children": [
+        {
+          "label": "VirtualGraphSql<virtual://movies> [#10] {\nSELECT \"title\"\nFROM \"movies\"\nWHERE \"year\" > 2000\n}",
+          "cardinality": 10,
+          "children": []
+        }
+      ]
+    }
+  }`)
+
+	mux.HandleFunc(fmt.Sprintf("/%s/explain", db), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", mediaTypeApplicationJSON)
+		if got, want := r.PostFormValue("query"), query; got != want {
+			t.Errorf("query = %v, want %v", got, want)
+		}
+		params := r.URL.Query()
+		if got, want := params.Get("reasoning"), "true"; got != want {
+			t.Errorf("reasoning query parameter = %v, want %v", got, want)
+		}
+		if got, want := params.Get("default-graph-uri"), "virtual://movies"; got != want {
+			t.Errorf("default-graph-uri query parameter = %v, want %v", got, want)
+		}
+		if !params.Has("timeout") {
+			t.Errorf("timeout query parameter missing with a ctx deadline")
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(planJSON)
+	})
+
+	// reasoning is enabled by the client's default, and the deadline sets the query's timeout
+	client.DefaultReasoning = true
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	opts := &ExplainVirtualGraphQueryOptions{VirtualGraph: "movies"}
+
+	got, _, err := client.Virtual.ExplainQuery(ctx, db, query, opts)
+	if err != nil {
+		t.Errorf("Virtual.ExplainQuery returned error: %v", err)
+	}
+	want := &VirtualGraphQueryPlan{
+		Plan: QueryPlanNode{
+			Label:       "Projection(?title) [#10]",
+			Cardinality: 10,
+			Children: []QueryPlanNode{
+				{
+					Label:       "VirtualGraphSql<virtual://movies> [#10] {\nSELECT \"title\"\nFROM \"movies\"\nWHERE \"year\" > 2000\n}",
+					Cardinality: 10,
+					Children:    []QueryPlanNode{},
+				},
+			},
+		},
+		Pushdowns: []VirtualGraphPushdown{
+			{
+				Operator:     "VirtualGraphSql",
+				VirtualGraph: "virtual://movies",
+				Query:        "SELECT \"title\"\nFROM \"movies\"\nWHERE \"year\" > 2000",
+				Cardinality:  10,
+			},
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Virtual.ExplainQuery = %+v, want %+v", got, want)
+	}
+
+	const methodName = "ExplainQuery"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Virtual.ExplainQuery(ctx, "\n", "\n", opts)
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Virtual.ExplainQuery(nil, db, query, nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestParseVirtualGraphPushdown(t *testing.T) {
+	tests := []struct {
+		label  string
+		want   VirtualGraphPushdown
+		wantOK bool
+	}{
+		{label: "Projection(?s)", wantOK: false},
+		{label: "VirtualGraphMongo", want: VirtualGraphPushdown{Operator: "VirtualGraphMongo"}, wantOK: true},
+		{
+			label:  "VirtualGraphSql<virtual://db> [#5] {\nSELECT 1\n}",
+			want:   VirtualGraphPushdown{Operator: "VirtualGraphSql", VirtualGraph: "virtual://db", Query: "SELECT 1"},
+			wantOK: true,
+		},
+	}
+	for _, test := range tests {
+		got, ok := parseVirtualGraphPushdown(QueryPlanNode{Label: test.label})
+		if ok != test.wantOK {
+			t.Errorf("parseVirtualGraphPushdown(%q) ok = %v, want %v", test.label, ok, test.wantOK)
+		}
+		if !cmp.Equal(got, test.want) {
+			t.Errorf("parseVirtualGraphPushdown(%q) = %+v, want %+v", test.label, got, test.want)
+		}
+	}
+}
+
+func TestVirtualGraphService_ListNames(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var vgNamesJSON = []byte(`{
+    "virtual_graphs": ["virtual://movies", "virtual://music"]
+  }`)
+	var wantVgNames = []string{"virtual://movies", "virtual://music"}
+
+	mux.HandleFunc("/admin/virtual_graphs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeApplicationJSON)
+		w.WriteHeader(http.StatusOK)
+		w.Write(vgNamesJSON)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Virtual.ListNames(ctx)
+	if err != nil {
+		t.Errorf("Virtual.ListNames returned error: %v", err)
+	}
+	if want := wantVgNames; !cmp.Equal(got, want) {
+		t.Errorf("Virtual.ListNames = %+v, want %+v", got, want)
+	}
+
+	const methodName = "Virtual.ListNames"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Virtual.ListNames(nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestVirtualGraphService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var vgsJSON = []byte(`{
+    "virtual_graphs": [
+      {
+        "name": "virtual://movies",
+        "data_source": "data-source://postgres",
+        "database": "*",
+        "available": true
+      }
+    ]
+  }`)
+	var wantVgs = []VirtualGraph{
+		{
+			Name:       "virtual://movies",
+			DataSource: "data-source://postgres",
+			Database:   "*",
+			Available:  true,
+		},
+	}
+
+	mux.HandleFunc("/admin/virtual_graphs/list", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeApplicationJSON)
+		w.WriteHeader(http.StatusOK)
+		w.Write(vgsJSON)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Virtual.List(ctx)
+	if err != nil {
+		t.Errorf("Virtual.List returned error: %v", err)
+	}
+	if want := wantVgs; !cmp.Equal(got, want) {
+		t.Errorf("Virtual.List = %+v, want %+v", got, want)
+	}
+
+	const methodName = "Virtual.List"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Virtual.List(nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestVirtualGraphService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	vgName := "movies"
+	opts := &VirtualGraphOptions{
+		Mappings:      "MAPPING FROM SQL { SELECT * FROM movies } TO { ?s a :Movie } WHERE { BIND(template(\"urn:{id}\") AS ?s) }",
+		MappingSyntax: MappingSyntaxSMS2,
+		Options: map[string]any{
+			"base": "http://example.com/",
+		},
+		DataSource: "postgres",
+		Database:   "db1",
+	}
+
+	mux.HandleFunc("/admin/virtual_graphs", func(w http.ResponseWriter, r *http.Request) {
+		v := new(virtualGraphRequest)
+		json.NewDecoder(r.Body).Decode(v)
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", mediaTypeApplicationJSON)
+
+		want := &virtualGraphRequest{
+			Name:     vgName,
+			Mappings: opts.Mappings,
+			Options: map[string]any{
+				"base":            "http://example.com/",
+				"mappings.syntax": "SMS2",
+			},
+			DataSource: "postgres",
+			Database:   "db1",
+		}
+		if !cmp.Equal(v, want) {
+			t.Errorf("Request body = %+v, want %+v", v, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	_, err := client.Virtual.Create(ctx, vgName, opts)
+	if err != nil {
+		t.Errorf("Virtual.Create returned error: %v", err)
+	}
+
+	const methodName = "Create"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Virtual.Create(nil, vgName, nil)
+	})
+}
+
+func TestVirtualGraphService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	vgName := "movies"
+	opts := &VirtualGraphOptions{
+		Mappings: "@prefix rr: <http://www.w3.org/ns/r2rml#> .",
+		Options: map[string]any{
+			"mappings.syntax": "R2RML",
+		},
+	}
+
+	mux.HandleFunc(fmt.Sprintf("/admin/virtual_graphs/%s", vgName), func(w http.ResponseWriter, r *http.Request) {
+		v := new(virtualGraphRequest)
+		json.NewDecoder(r.Body).Decode(v)
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Content-Type", mediaTypeApplicationJSON)
+
+		want := &virtualGraphRequest{
+			Name:     vgName,
+			Mappings: opts.Mappings,
+			Options:  map[string]any{"mappings.syntax": "R2RML"},
+		}
+		if !cmp.Equal(v, want) {
+			t.Errorf("Request body = %+v, want %+v", v, want)
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := context.Background()
+	_, err := client.Virtual.Update(ctx, vgName, opts)
+	if err != nil {
+		t.Errorf("Virtual.Update returned error: %v", err)
+	}
+
+	const methodName = "Update"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Virtual.Update(nil, vgName, nil)
+	})
+}
+
+func TestVirtualGraphService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	vgName := "movies"
+
+	mux.HandleFunc(fmt.Sprintf("/admin/virtual_graphs/%s", vgName), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Virtual.Delete(ctx, vgName)
+	if err != nil {
+		t.Errorf("Virtual.Delete returned error: %v", err)
+	}
+
+	const methodName = "Delete"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Virtual.Delete(nil, vgName)
+	})
+}
+
+func TestVirtualGraphService_Options(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	vgName := "movies"
+	optionsJSON := []byte(`{"options": {"base": "http://example.com/", "mappings.syntax": "SMS2"}}`)
+	wantOptions := map[string]any{
+		"base":            "http://example.com/",
+		"mappings.syntax": "SMS2",
+	}
+
+	mux.HandleFunc(fmt.Sprintf("/admin/virtual_graphs/%s/options", vgName), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeApplicationJSON)
+		w.WriteHeader(http.StatusOK)
+		w.Write(optionsJSON)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Virtual.Options(ctx, vgName)
+	if err != nil {
+		t.Errorf("Virtual.Options returned error: %v", err)
+	}
+	if want := wantOptions; !cmp.Equal(got, want) {
+		t.Errorf("Virtual.Options = %+v, want %+v", got, want)
+	}
+
+	const methodName = "Options"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Virtual.Options(nil, vgName)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestVirtualGraphService_Mappings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	vgName := "movies"
+	mappings := "@prefix rr: <http://www.w3.org/ns/r2rml#> ."
+
+	mux.HandleFunc(fmt.Sprintf("/admin/virtual_graphs/%s/mappings", vgName), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.Query().Get("syntax"), "R2RML"; got != want {
+			t.Errorf("syntax query parameter = %v, want %v", got, want)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(mappings))
+	})
+
+	ctx := context.Background()
+	opts := &VirtualGraphMappingsOptions{Syntax: MappingSyntaxR2RML}
+	got, _, err := client.Virtual.Mappings(ctx, vgName, opts)
+	if err != nil {
+		t.Errorf("Virtual.Mappings returned error: %v", err)
+	}
+	if want := mappings; !cmp.Equal(got.String(), want) {
+		t.Errorf("Virtual.Mappings = %+v, want %+v", got, want)
+	}
+
+	const methodName = "Mappings"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Virtual.Mappings(ctx, "\n", opts)
+		return err
+	})
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Virtual.Mappings(nil, vgName, nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestVirtualGraphService_Online(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	vgName := "movies"
+
+	mux.HandleFunc(fmt.Sprintf("/admin/virtual_graphs/%s/online", vgName), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := context.Background()
+	_, err := client.Virtual.Online(ctx, vgName)
+	if err != nil {
+		t.Errorf("Virtual.Online returned error: %v", err)
+	}
+
+	const methodName = "Online"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Virtual.Online(nil, vgName)
+	})
+}
+
+func TestMappingSyntax_String(t *testing.T) {
+	tests := []struct {
+		syntax MappingSyntax
+		want   string
+	}{
+		{MappingSyntaxUnknown, ""},
+		{MappingSyntaxSMS2, "SMS2"},
+		{MappingSyntaxR2RML, "R2RML"},
+		{MappingSyntax(100), ""},
+	}
+	for _, test := range tests {
+		if got := test.syntax.String(); got != test.want {
+			t.Errorf("MappingSyntax(%d).String() = %q, want %q", test.syntax, got, test.want)
+		}
+	}
+}
+
+func TestVirtualGraphService_Import(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	opts := &ImportVirtualGraphOptions{
+		Mappings:      "MAPPING FROM SQL { SELECT * FROM movies } TO { ?s a :Movie } WHERE { BIND(template(\"urn:{id}\") AS ?s) }",
+		MappingSyntax: MappingSyntaxSMS2,
+		DataSource:    "postgres",
+		NamedGraph:    "urn:movies",
+		RemoveAll:     true,
+	}
+
+	mux.HandleFunc("/admin/virtual_graphs/import", func(w http.ResponseWriter, r *http.Request) {
+		v := new(importVirtualGraphRequest)
+		json.NewDecoder(r.Body).Decode(v)
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", mediaTypeApplicationJSON)
+
+		want := &importVirtualGraphRequest{
+			Database:   db,
+			Mappings:   opts.Mappings,
+			Options:    map[string]any{"mappings.syntax": "SMS2"},
+			DataSource: "postgres",
+			NamedGraph: "urn:movies",
+			RemoveAll:  true,
+		}
+		if !cmp.Equal(v, want) {
+			t.Errorf("Request body = %+v, want %+v", v, want)
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := context.Background()
+	_, err := client.Virtual.Import(ctx, db, opts)
+	if err != nil {
+		t.Errorf("Virtual.Import returned error: %v", err)
+	}
+
+	const methodName = "Import"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Virtual.Import(nil, db, nil)
+	})
+}
+
+func TestVirtualGraphService_Import_file(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	inputFile, err := os.Open("./test-resources/movies.csv")
+	if err != nil {
+		t.Fatalf("unable to open input file: %v", err)
+	}
+	defer inputFile.Close()
+
+	opts := &ImportVirtualGraphOptions{
+		Mappings:   "MAPPING FROM CSV {} TO { ?s a :Movie } WHERE { BIND(template(\"urn:{id}\") AS ?s) }",
+		Options:    map[string]any{"csv.separator": ",", "csv.header": true},
+		NamedGraph: "urn:movies",
+		InputFile:  inputFile,
+	}
+
+	mux.HandleFunc("/admin/virtual_graphs/import_file", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("unable to parse multipart form: %v", err)
+		}
+		wantFields := map[string]string{
+			"database":        db,
+			"mappings":        opts.Mappings,
+			"options":         "csv.header=true\ncsv.separator=,\n",
+			"named_graph":     "urn:movies",
+			"input_file_type": "CSV",
+			"remove_all":      "false",
+		}
+		for field, want := range wantFields {
+			if got := r.FormValue(field); got != want {
+				t.Errorf("form field %s = %q, want %q", field, got, want)
+			}
+		}
+		file, header, err := r.FormFile("input_file")
+		if err != nil {
+			t.Fatalf("missing input_file: %v", err)
+		}
+		defer file.Close()
+		if header.Filename != "movies.csv" {
+			t.Errorf("input_file filename = %q, want %q", header.Filename, "movies.csv")
+		}
+		got, _ := io.ReadAll(file)
+		want, _ := os.ReadFile("./test-resources/movies.csv")
+		if !bytes.Equal(got, want) {
+			t.Errorf("input_file = %q, want %q", got, want)
+		}
+		if r.ContentLength <= 0 {
+			t.Errorf("Content-Length = %d, want the size of the body", r.ContentLength)
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := context.Background()
+	_, err = client.Virtual.Import(ctx, db, opts)
+	if err != nil {
+		t.Errorf("Virtual.Import returned error: %v", err)
+	}
+}
+
+func TestVirtualGraphService_Import_delimitedFile(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	db := "db1"
+	path := filepath.Join(t.TempDir(), "movies.tsv")
+	if err := os.WriteFile(path, []byte("id\ttitle\n1\t'The Matrix'\n"), 0o600); err != nil {
+		t.Fatalf("unable to write input file: %v", err)
+	}
+	inputFile, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("unable to open input file: %v", err)
+	}
+	defer inputFile.Close()
+
+	mappings := "MAPPING FROM CSV {} TO { ?s a :Movie } WHERE { BIND(template(\"urn:{id}\") AS ?s) }"
+	opts := &ImportVirtualGraphOptions{
+		MappingsReader: strings.NewReader(mappings),
+		Delimited:      &DelimitedFileOptions{Quote: '\'', Escape: '\\', NoHeader: true},
+		NamedGraph:     "urn:movies",
+		InputFile:      inputFile,
+	}
+
+	mux.HandleFunc("/admin/virtual_graphs/import_file", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("unable to parse multipart form: %v", err)
+		}
+		wantFields := map[string]string{
+			"mappings": mappings,
+			// a backslash escape character must itself be escaped, or Java reads it as a line continuation
+			"options":         "csv.escape=\\\\\ncsv.header=false\ncsv.quote='\ncsv.separator=\\t\n",
+			"input_file_type": "CSV",
+		}
+		for field, want := range wantFields {
+			if got := r.FormValue(field); got != want {
+				t.Errorf("form field %s = %q, want %q", field, got, want)
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := context.Background()
+	_, err = client.Virtual.Import(ctx, db, opts)
+	if err != nil {
+		t.Errorf("Virtual.Import returned error: %v", err)
+	}
+}
+
+func TestVirtualGraphService_Import_invalidFile(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+
+	directory, err := os.Open("./test-resources/")
+	if err != nil {
+		t.Fatalf("unable to open directory: %v", err)
+	}
+	defer directory.Close()
+	_, err = client.Virtual.Import(ctx, "db1", &ImportVirtualGraphOptions{InputFile: directory})
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Errorf("Virtual.Import err = %#v, want *ValidationError", err)
+	}
+
+	ttl, err := os.Open("./test-resources/beatles.ttl")
+	if err != nil {
+		t.Fatalf("unable to open file: %v", err)
+	}
+	defer ttl.Close()
+	_, err = client.Virtual.Import(ctx, "db1", &ImportVirtualGraphOptions{InputFile: ttl})
+	if !errors.As(err, &validationErr) {
+		t.Errorf("Virtual.Import err = %#v, want *ValidationError", err)
+	}
+
+	_, err = client.Virtual.Import(ctx, "", nil)
+	if !errors.As(err, &validationErr) {
+		t.Errorf("Virtual.Import err = %#v, want *ValidationError", err)
+	}
+}
+
+const virtualGraphDependenciesJSON = `{
+  "virtual_graphs": [
+    {"name": "virtual://movies", "data_source": "data-source://postgres", "database": "db1", "available": true},
+    {"name": "virtual://music", "data_source": "data-source://mysql", "database": "*", "available": true},
+    {"name": "virtual://actors", "data_source": "data-source://postgres", "database": "db2", "available": false}
+  ]
+}`
+
+func TestToProperties(t *testing.T) {
+	options := map[string]any{
+		"jdbc.url":      "jdbc:sqlserver://db;databaseName=movies",
+		"import.path":   `C:\data\movies.csv`,
+		"query.header":  "line one\nline two\r\n",
+		"csv.separator": "\t",
+		"prefix":        "  indented",
+		"label":         "café",
+		"key with:=":    "value # not a comment",
+		"csv.header":    false,
+	}
+	want := `csv.header=false
+csv.separator=\t
+import.path=C:\\data\\movies.csv
+jdbc.url=jdbc:sqlserver://db;databaseName=movies
+key\ with\:\==value # not a comment
+label=caf\u00e9
+prefix=\  indented
+query.header=line one\nline two\r\n
+`
+	if got := toProperties(options); got != want {
+		t.Errorf("toProperties = %q, want %q", got, want)
+	}
+}
+
+func TestVirtualGraphService_DataSource(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/virtual_graphs/list", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(virtualGraphDependenciesJSON))
+	})
+	var dataSourceLists int
+	mux.HandleFunc("/admin/data_sources/list", func(w http.ResponseWriter, r *http.Request) {
+		dataSourceLists++
+		w.Write([]byte(`{"data_sources": [{"entityName": "data-source://postgres", "sharable": true, "available": true}]}`))
+	})
+
+	ctx := context.Background()
+	want := &DataSource{Name: "data-source://postgres", Shareable: true, Available: true}
+	for _, name := range []string{"movies", "virtual://movies"} {
+		got, _, err := client.Virtual.DataSource(ctx, name)
+		if err != nil {
+			t.Fatalf("Virtual.DataSource returned error: %v", err)
+		}
+		if !cmp.Equal(got, want) {
+			t.Errorf("Virtual.DataSource(%q) = %+v, want %+v", name, got, want)
+		}
+	}
+
+	for _, name := range []string{"music", "missing"} {
+		if _, _, err := client.Virtual.DataSource(ctx, name); !errors.Is(err, ErrDataSourceNotFound) {
+			t.Errorf("Virtual.DataSource(%q) error = %v, want ErrDataSourceNotFound", name, err)
+		}
+	}
+	listed := dataSourceLists
+	if _, resp, _ := client.Virtual.DataSource(ctx, "missing"); dataSourceLists != listed || string(resp.RawBody) != virtualGraphDependenciesJSON {
+		t.Errorf("Virtual.DataSource of a missing virtual graph listed data sources or returned their response")
+	}
+
+	const methodName = "DataSource"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Virtual.DataSource(nil, "movies")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
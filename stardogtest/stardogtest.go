@@ -0,0 +1,218 @@
+// Package stardogtest provides a Stardog server for integration tests.
+//
+// [Start] connects to the server at the STARDOG_ENDPOINT environment variable if it's set, and otherwise
+// runs a Stardog Docker container for the duration of the tests. The following environment variables
+// configure the server:
+//
+//	STARDOG_ENDPOINT   URL of an existing Stardog server to use instead of a container
+//	STARDOG_USERNAME   username of a superuser (default "admin")
+//	STARDOG_PASSWORD   password of the superuser (default "admin")
+//	STARDOG_IMAGE      Docker image to run (default "stardog/stardog:latest")
+//	STARDOG_LICENSE    path to a Stardog license key, mounted into the container
+//
+// A typical TestMain looks like:
+//
+//	var server *stardogtest.Server
+//
+//	func TestMain(m *testing.M) {
+//		var err error
+//		server, err = stardogtest.Start(context.Background(), nil)
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		code := m.Run()
+//		server.Close()
+//		os.Exit(code)
+//	}
+package stardogtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/noahgorstein/go-stardog/stardog"
+)
+
+const (
+	defaultImage    = "stardog/stardog:latest"
+	defaultUsername = "admin"
+	defaultPassword = "admin"
+	// where the Stardog image expects the license key
+	containerLicensePath = "/var/opt/stardog/stardog-license-key.bin"
+	containerPort        = "5820/tcp"
+)
+
+// Options configures [Start]. Empty fields are read from the environment, falling back to the defaults.
+type Options struct {
+	// URL of an existing Stardog server. If empty, a container is started.
+	Endpoint string
+	// Username and password of a superuser
+	Username string
+	Password string
+	// Docker image to run
+	Image string
+	// Path to a Stardog license key to mount into the container
+	LicensePath string
+	// How long to wait for the server to accept requests (default 2 minutes)
+	StartupTimeout time.Duration
+}
+
+// Server is a running Stardog server.
+type Server struct {
+	// URL of the server
+	Endpoint string
+	// Username and password of a superuser
+	Username string
+	Password string
+	// A client authenticated as the superuser
+	Client *stardog.Client
+
+	containerID string
+}
+
+// Start connects to or starts a Stardog server and waits until it accepts requests.
+// The returned Server must be closed with [Server.Close].
+func Start(ctx context.Context, opts *Options) (*Server, error) {
+	o := withDefaults(opts)
+	s := &Server{Endpoint: o.Endpoint, Username: o.Username, Password: o.Password}
+	if s.Endpoint == "" {
+		if err := s.startContainer(ctx, o); err != nil {
+			return nil, err
+		}
+	}
+
+	var err error
+	s.Client, err = s.NewClient(s.Username, s.Password)
+	if err != nil {
+		s.Close()
+		return nil, err
+	}
+	if err := s.waitUntilAlive(ctx, o.StartupTimeout); err != nil {
+		s.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// withDefaults returns a copy of the options with empty fields read from the environment or set to their defaults.
+func withDefaults(opts *Options) Options {
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+	o.Endpoint = firstNonEmpty(o.Endpoint, os.Getenv("STARDOG_ENDPOINT"))
+	o.Username = firstNonEmpty(o.Username, os.Getenv("STARDOG_USERNAME"), defaultUsername)
+	o.Password = firstNonEmpty(o.Password, os.Getenv("STARDOG_PASSWORD"), defaultPassword)
+	o.Image = firstNonEmpty(o.Image, os.Getenv("STARDOG_IMAGE"), defaultImage)
+	o.LicensePath = firstNonEmpty(o.LicensePath, os.Getenv("STARDOG_LICENSE"))
+	if o.StartupTimeout == 0 {
+		o.StartupTimeout = 2 * time.Minute
+	}
+	return o
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// startContainer runs the Stardog image, publishing its port on a random local port.
+func (s *Server) startContainer(ctx context.Context, o Options) error {
+	args := []string{"run", "--detach", "--rm", "--publish", "127.0.0.1::" + containerPort}
+	if o.LicensePath != "" {
+		args = append(args, "--volume", o.LicensePath+":"+containerLicensePath+":ro")
+	}
+	args = append(args, o.Image)
+	out, err := docker(ctx, args...)
+	if err != nil {
+		return fmt.Errorf("starting Stardog container: %w", err)
+	}
+	s.containerID = out
+
+	address, err := docker(ctx, "port", s.containerID, containerPort)
+	if err != nil {
+		s.Close()
+		return fmt.Errorf("finding Stardog container port: %w", err)
+	}
+	// docker prints one line per published address, e.g. "127.0.0.1:49153"
+	address, _, _ = strings.Cut(address, "\n")
+	s.Endpoint = "http://" + address
+	return nil
+}
+
+func docker(ctx context.Context, args ...string) (string, error) {
+	out, err := exec.CommandContext(ctx, "docker", args...).CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("docker %s: %w: %s", args[0], err, strings.TrimSpace(string(out)))
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// waitUntilAlive polls the server until it reports it's alive or the timeout elapses.
+func (s *Server) waitUntilAlive(ctx context.Context, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		alive, _, err := s.Client.ServerAdmin.IsAlive(ctx)
+		if err == nil && alive != nil && *alive {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for Stardog at %s to start: %w", s.Endpoint, errors.Join(ctx.Err(), err))
+		case <-ticker.C:
+		}
+	}
+}
+
+// NewClient returns a client for the server authenticated as the given user.
+func (s *Server) NewClient(username string, password string) (*stardog.Client, error) {
+	transport := stardog.BasicAuthTransport{Username: username, Password: password}
+	return stardog.NewClient(s.Endpoint, transport.Client())
+}
+
+// Close stops the container started by [Start], if any. Servers provided with STARDOG_ENDPOINT are left running.
+func (s *Server) Close() error {
+	if s.Client != nil {
+		s.Client.Close(context.Background())
+	}
+	if s.containerID == "" {
+		return nil
+	}
+	_, err := docker(context.Background(), "stop", s.containerID)
+	s.containerID = ""
+	return err
+}
+
+// UniqueName returns a name with the given prefix that's unique to this run, for databases, users and
+// roles created by tests so that runs against a shared server don't collide.
+func UniqueName(prefix string) string {
+	return fmt.Sprintf("%s_%d", prefix, time.Now().UnixNano())
+}
+
+// statusCode returns the HTTP status code of an error returned by the client, or 0.
+func statusCode(err error) int {
+	var errResp *stardog.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil {
+		return errResp.Response.StatusCode
+	}
+	return 0
+}
+
+// IsForbidden reports whether err is a client error for a request the user isn't permitted to make.
+func IsForbidden(err error) bool {
+	code := statusCode(err)
+	return code == http.StatusForbidden || code == http.StatusUnauthorized
+}
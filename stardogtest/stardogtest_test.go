@@ -0,0 +1,75 @@
+package stardogtest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestStart_endpoint(t *testing.T) {
+	var calls atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/admin/alive" {
+			t.Errorf("request path = %q, want /admin/alive", r.URL.Path)
+		}
+		if username, password, _ := r.BasicAuth(); username != "admin" || password != "secret" {
+			t.Errorf("basic auth = %q/%q, want admin/secret", username, password)
+		}
+		// not alive on the first check
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	t.Setenv("STARDOG_PASSWORD", "secret")
+	server, err := Start(context.Background(), &Options{Endpoint: ts.URL, StartupTimeout: 10 * time.Second})
+	if err != nil {
+		t.Fatalf("Start returned error: %v", err)
+	}
+	if server.Endpoint != ts.URL || server.Username != "admin" || server.Password != "secret" {
+		t.Errorf("Start = %+v, want endpoint %s with admin/secret", server, ts.URL)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("alive checks = %d, want 2", got)
+	}
+	if err := server.Close(); err != nil {
+		t.Errorf("Close returned error: %v", err)
+	}
+}
+
+func TestStart_timeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	if _, err := Start(context.Background(), &Options{Endpoint: ts.URL, StartupTimeout: 100 * time.Millisecond}); err == nil {
+		t.Errorf("Start err = nil, want error for a server that never becomes alive")
+	}
+}
+
+func TestWithDefaults(t *testing.T) {
+	t.Setenv("STARDOG_ENDPOINT", "")
+	t.Setenv("STARDOG_USERNAME", "")
+	t.Setenv("STARDOG_PASSWORD", "")
+	t.Setenv("STARDOG_IMAGE", "stardog/stardog:9.0.0")
+	t.Setenv("STARDOG_LICENSE", "/tmp/license.bin")
+
+	got := withDefaults(&Options{Username: "ci"})
+	want := Options{
+		Username:       "ci",
+		Password:       defaultPassword,
+		Image:          "stardog/stardog:9.0.0",
+		LicensePath:    "/tmp/license.bin",
+		StartupTimeout: 2 * time.Minute,
+	}
+	if got != want {
+		t.Errorf("withDefaults = %+v, want %+v", got, want)
+	}
+}
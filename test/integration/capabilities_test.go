@@ -0,0 +1,125 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/noahgorstein/go-stardog/stardog"
+)
+
+// capabilityProbes are read-only calls of client methods, keyed by Capability.Method, used to check the
+// capability table against a real server.
+var capabilityProbes = map[string]func(ctx context.Context, client *stardog.Client) error{
+	"ServerAdminService.IsAlive": func(ctx context.Context, client *stardog.Client) error {
+		_, _, err := client.ServerAdmin.IsAlive(ctx)
+		return err
+	},
+	"ServerAdminService.GetProcesses": func(ctx context.Context, client *stardog.Client) error {
+		_, _, err := client.ServerAdmin.GetProcesses(ctx)
+		return err
+	},
+	"ServerAdminService.Jobs": func(ctx context.Context, client *stardog.Client) error {
+		_, _, err := client.ServerAdmin.Jobs(ctx)
+		return err
+	},
+	"DatabaseAdminService.ListDatabases": func(ctx context.Context, client *stardog.Client) error {
+		_, _, err := client.DatabaseAdmin.ListDatabases(ctx)
+		return err
+	},
+	"DataSourceService.ListNames": func(ctx context.Context, client *stardog.Client) error {
+		_, _, err := client.DataSource.ListNames(ctx)
+		return err
+	},
+	"QueryManagementService.List": func(ctx context.Context, client *stardog.Client) error {
+		_, _, err := client.QueryManagement.List(ctx)
+		return err
+	},
+	"RoleService.ListNames": func(ctx context.Context, client *stardog.Client) error {
+		_, _, err := client.Role.ListNames(ctx)
+		return err
+	},
+	"UserService.WhoAmI": func(ctx context.Context, client *stardog.Client) error {
+		_, _, err := client.User.WhoAmI(ctx)
+		return err
+	},
+	"SecurityService.GetToken": func(ctx context.Context, client *stardog.Client) error {
+		_, _, err := client.Security.GetToken(ctx)
+		return err
+	},
+}
+
+// TestCapabilities checks that the probed methods work if and only if the capability table says the
+// server's version supports them. Run it against each Stardog version of the support matrix by setting
+// STARDOG_IMAGE to the image of that version (stardog/stardog:<version>). If STARDOG_SUPPORT_REPORT is
+// set, a Markdown report of the results for the server's version is written to that file.
+func TestCapabilities(t *testing.T) {
+	ctx := context.Background()
+	client := server.Client
+	version, _, err := client.ServerAdmin.Version(ctx)
+	if err != nil {
+		t.Fatalf("ServerAdmin.Version returned error: %v", err)
+	}
+	if version == nil {
+		t.Fatal("ServerAdmin.Version = nil, want the server's version")
+	}
+	t.Logf("Stardog version %s", version)
+
+	var report strings.Builder
+	fmt.Fprintf(&report, "| Method | Minimum Stardog version | Stardog %s |\n| --- | --- | --- |\n", version)
+	for _, capability := range stardog.Capabilities() {
+		probe, ok := capabilityProbes[capability.Method]
+		if !ok {
+			continue
+		}
+		t.Run(capability.Method, func(t *testing.T) {
+			supported, err := client.Supports(ctx, capability.Method)
+			if err != nil {
+				t.Fatalf("Client.Supports returned error: %v", err)
+			}
+			probeErr := probe(ctx, client)
+			result := "ok"
+			if probeErr != nil {
+				result = "fails"
+			}
+			fmt.Fprintf(&report, "| `%s` | %s | %s |\n", capability.Method, capability.MinVersion, result)
+			if capability.MinVersion == stardog.VersionUnknown {
+				t.Logf("%s on Stardog %s: %s", capability.Method, version, result)
+				return
+			}
+			switch {
+			case supported && probeErr != nil:
+				t.Errorf("%s failed on supported Stardog %s: %v", capability.Method, version, probeErr)
+			case !supported && probeErr == nil:
+				t.Errorf("%s works on Stardog %s, older than its minimum version %s", capability.Method, version, capability.MinVersion)
+			}
+			var unsupported *stardog.UnsupportedVersionError
+			if err := client.RequireCapability(ctx, capability.Method); supported == errors.As(err, &unsupported) {
+				t.Errorf("Client.RequireCapability = %v, inconsistent with Client.Supports = %v", err, supported)
+			}
+		})
+	}
+
+	if path := os.Getenv("STARDOG_SUPPORT_REPORT"); path != "" {
+		if err := os.WriteFile(path, []byte(report.String()), 0o644); err != nil {
+			t.Errorf("writing support report: %v", err)
+		}
+	}
+}
+
+func TestCapabilityProbes_known(t *testing.T) {
+	methods := make(map[string]bool)
+	for _, capability := range stardog.Capabilities() {
+		methods[capability.Method] = true
+	}
+	for method := range capabilityProbes {
+		if !methods[method] {
+			t.Errorf("capabilityProbes has a probe for %s, which isn't a method of a service", method)
+		}
+	}
+}
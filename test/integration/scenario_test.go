@@ -0,0 +1,143 @@
+//go:build integration
+
+// Package integration contains end-to-end tests that run against a real Stardog server.
+// They only build with the integration build tag:
+//
+//	STARDOG_LICENSE=/path/to/stardog-license-key.bin go test -tags integration ./test/integration/...
+//
+// See the stardogtest package for the environment variables that configure the server.
+package integration
+
+import (
+	"context"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/noahgorstein/go-stardog/stardog"
+	"github.com/noahgorstein/go-stardog/stardogtest"
+)
+
+var server *stardogtest.Server
+
+func TestMain(m *testing.M) {
+	var err error
+	server, err = stardogtest.Start(context.Background(), nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	code := m.Run()
+	if err := server.Close(); err != nil {
+		log.Print(err)
+	}
+	os.Exit(code)
+}
+
+// TestScenario_databaseLifecycle creates a database, loads it in a transaction, queries it, restricts a
+// user to reading it, exports it and finally drops it.
+func TestScenario_databaseLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client := server.Client
+	db := stardogtest.UniqueName("scenario")
+
+	t.Run("create", func(t *testing.T) {
+		if _, _, err := client.DatabaseAdmin.Create(ctx, db, nil); err != nil {
+			t.Fatalf("DatabaseAdmin.Create returned error: %v", err)
+		}
+		t.Cleanup(func() {
+			// ignore the error if the drop step already ran
+			client.DatabaseAdmin.Drop(context.Background(), db)
+		})
+	})
+
+	t.Run("load in transaction", func(t *testing.T) {
+		txID, _, err := client.Transaction.Begin(ctx, db)
+		if err != nil {
+			t.Fatalf("Transaction.Begin returned error: %v", err)
+		}
+		update := `INSERT DATA { <urn:paul> <urn:memberOf> <urn:beatles> . <urn:john> <urn:memberOf> <urn:beatles> }`
+		if _, err := client.Sparql.Update(ctx, db, update, &stardog.UpdateOptions{TxID: txID}); err != nil {
+			t.Fatalf("Sparql.Update returned error: %v", err)
+		}
+		ask := `ASK { <urn:paul> <urn:memberOf> <urn:beatles> }`
+		inTx, _, err := client.Sparql.Ask(ctx, db, ask, &stardog.AskOptions{TxID: txID})
+		if err != nil || !*inTx {
+			t.Fatalf("Sparql.Ask in transaction = %v, %v, want true", inTx, err)
+		}
+		outsideTx, _, err := client.Sparql.Ask(ctx, db, ask, nil)
+		if err != nil || *outsideTx {
+			t.Fatalf("Sparql.Ask outside transaction = %v, %v, want false before commit", outsideTx, err)
+		}
+		if _, err := client.Transaction.Commit(ctx, db, txID); err != nil {
+			t.Fatalf("Transaction.Commit returned error: %v", err)
+		}
+	})
+
+	t.Run("query", func(t *testing.T) {
+		results, _, err := client.Sparql.SelectResultSet(ctx, db, `SELECT ?member { ?member <urn:memberOf> <urn:beatles> } ORDER BY ?member`, nil)
+		if err != nil {
+			t.Fatalf("Sparql.SelectResultSet returned error: %v", err)
+		}
+		var members []string
+		for _, binding := range results.Bindings {
+			members = append(members, binding["member"].Value)
+		}
+		if got, want := strings.Join(members, ","), "urn:john,urn:paul"; got != want {
+			t.Errorf("members = %s, want %s", got, want)
+		}
+	})
+
+	t.Run("secure", func(t *testing.T) {
+		username, password := stardogtest.UniqueName("reader"), "reader-password"
+		if _, err := client.User.Create(ctx, username, password); err != nil {
+			t.Fatalf("User.Create returned error: %v", err)
+		}
+		t.Cleanup(func() { client.User.Delete(context.Background(), username) })
+		read := stardog.Permission{
+			Action:       stardog.PermissionActionRead,
+			ResourceType: stardog.PermissionResourceTypeDatabase,
+			Resource:     []string{db},
+		}
+		if _, err := client.User.GrantPermission(ctx, username, read); err != nil {
+			t.Fatalf("User.GrantPermission returned error: %v", err)
+		}
+
+		reader, err := server.NewClient(username, password)
+		if err != nil {
+			t.Fatalf("NewClient returned error: %v", err)
+		}
+		if _, _, err := reader.Sparql.Select(ctx, db, `SELECT * { ?s ?p ?o }`, nil); err != nil {
+			t.Errorf("Sparql.Select as reader returned error: %v", err)
+		}
+		_, err = reader.Sparql.Update(ctx, db, `INSERT DATA { <urn:ringo> <urn:memberOf> <urn:beatles> }`, nil)
+		if !stardogtest.IsForbidden(err) {
+			t.Errorf("Sparql.Update as reader error = %v, want forbidden", err)
+		}
+	})
+
+	t.Run("export", func(t *testing.T) {
+		data, _, err := client.DatabaseAdmin.ExportData(ctx, db, &stardog.ExportDataOptions{Format: stardog.RDFFormatNTriples})
+		if err != nil {
+			t.Fatalf("DatabaseAdmin.ExportData returned error: %v", err)
+		}
+		if got, want := data.String(), "<urn:paul> <urn:memberOf> <urn:beatles> ."; !strings.Contains(got, want) {
+			t.Errorf("DatabaseAdmin.ExportData = %s, want it to contain %s", got, want)
+		}
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		if _, err := client.DatabaseAdmin.Drop(ctx, db); err != nil {
+			t.Fatalf("DatabaseAdmin.Drop returned error: %v", err)
+		}
+		databases, _, err := client.DatabaseAdmin.ListDatabases(ctx)
+		if err != nil {
+			t.Fatalf("DatabaseAdmin.ListDatabases returned error: %v", err)
+		}
+		for _, name := range databases {
+			if name == db {
+				t.Errorf("database %s still exists after Drop", db)
+			}
+		}
+	})
+}